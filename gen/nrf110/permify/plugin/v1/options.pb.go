@@ -0,0 +1,1344 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: nrf110/permify/plugin/v1/options.proto
+
+package pluginv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// When the parent of a resource is checked.
+type ParentCheck int32
+
+const (
+	// Check the parent instead of the resource when the resource has no id, as when it's being created.
+	ParentCheck_PARENT_CHECK_UNSPECIFIED ParentCheck = 0
+	// Check the parent as well as the resource.
+	ParentCheck_PARENT_CHECK_ALSO ParentCheck = 1
+)
+
+// Enum value maps for ParentCheck.
+var (
+	ParentCheck_name = map[int32]string{
+		0: "PARENT_CHECK_UNSPECIFIED",
+		1: "PARENT_CHECK_ALSO",
+	}
+	ParentCheck_value = map[string]int32{
+		"PARENT_CHECK_UNSPECIFIED": 0,
+		"PARENT_CHECK_ALSO":        1,
+	}
+)
+
+func (x ParentCheck) Enum() *ParentCheck {
+	p := new(ParentCheck)
+	*p = x
+	return p
+}
+
+func (x ParentCheck) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParentCheck) Descriptor() protoreflect.EnumDescriptor {
+	return file_nrf110_permify_plugin_v1_options_proto_enumTypes[0].Descriptor()
+}
+
+func (ParentCheck) Type() protoreflect.EnumType {
+	return &file_nrf110_permify_plugin_v1_options_proto_enumTypes[0]
+}
+
+func (x ParentCheck) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParentCheck.Descriptor instead.
+func (ParentCheck) EnumDescriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{0}
+}
+
+// How the generated code handles a resource held in a message field that isn't set.
+type MissingResourcePolicy int32
+
+const (
+	// Use the plugin's missing_resource parameter, which defaults to MISSING_RESOURCE_POLICY_CHECK.
+	MissingResourcePolicy_MISSING_RESOURCE_POLICY_UNSPECIFIED MissingResourcePolicy = 0
+	// Check the resource type with an empty id and the default tenant.
+	MissingResourcePolicy_MISSING_RESOURCE_POLICY_CHECK MissingResourcePolicy = 1
+	// Produce no check for the resource.
+	MissingResourcePolicy_MISSING_RESOURCE_POLICY_SKIP MissingResourcePolicy = 2
+	// Deny the request.
+	MissingResourcePolicy_MISSING_RESOURCE_POLICY_DENY MissingResourcePolicy = 3
+)
+
+// Enum value maps for MissingResourcePolicy.
+var (
+	MissingResourcePolicy_name = map[int32]string{
+		0: "MISSING_RESOURCE_POLICY_UNSPECIFIED",
+		1: "MISSING_RESOURCE_POLICY_CHECK",
+		2: "MISSING_RESOURCE_POLICY_SKIP",
+		3: "MISSING_RESOURCE_POLICY_DENY",
+	}
+	MissingResourcePolicy_value = map[string]int32{
+		"MISSING_RESOURCE_POLICY_UNSPECIFIED": 0,
+		"MISSING_RESOURCE_POLICY_CHECK":       1,
+		"MISSING_RESOURCE_POLICY_SKIP":        2,
+		"MISSING_RESOURCE_POLICY_DENY":        3,
+	}
+)
+
+func (x MissingResourcePolicy) Enum() *MissingResourcePolicy {
+	p := new(MissingResourcePolicy)
+	*p = x
+	return p
+}
+
+func (x MissingResourcePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissingResourcePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_nrf110_permify_plugin_v1_options_proto_enumTypes[1].Descriptor()
+}
+
+func (MissingResourcePolicy) Type() protoreflect.EnumType {
+	return &file_nrf110_permify_plugin_v1_options_proto_enumTypes[1]
+}
+
+func (x MissingResourcePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissingResourcePolicy.Descriptor instead.
+func (MissingResourcePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{1}
+}
+
+// The Permify attribute type an attribute field is converted to.
+type AttributeType int32
+
+const (
+	// Use the default type for the field's kind.
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	// A Permify boolean.
+	AttributeType_ATTRIBUTE_TYPE_BOOLEAN AttributeType = 1
+	// A Permify string.
+	AttributeType_ATTRIBUTE_TYPE_STRING AttributeType = 2
+	// A Permify integer, which is 32 bits wide. Only 32-bit signed integers and enums can be converted to it, since
+	// wider and unsigned integers could wrap.
+	AttributeType_ATTRIBUTE_TYPE_INTEGER AttributeType = 3
+	// A Permify double.
+	AttributeType_ATTRIBUTE_TYPE_DOUBLE AttributeType = 4
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_BOOLEAN",
+		2: "ATTRIBUTE_TYPE_STRING",
+		3: "ATTRIBUTE_TYPE_INTEGER",
+		4: "ATTRIBUTE_TYPE_DOUBLE",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_BOOLEAN":     1,
+		"ATTRIBUTE_TYPE_STRING":      2,
+		"ATTRIBUTE_TYPE_INTEGER":     3,
+		"ATTRIBUTE_TYPE_DOUBLE":      4,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_nrf110_permify_plugin_v1_options_proto_enumTypes[2].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_nrf110_permify_plugin_v1_options_proto_enumTypes[2]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{2}
+}
+
+// When an attribute is written to a check.
+type AttributePresence int32
+
+const (
+	// Use the plugin's attribute_presence parameter, which defaults to ATTRIBUTE_PRESENCE_IF_SET.
+	AttributePresence_ATTRIBUTE_PRESENCE_UNSPECIFIED AttributePresence = 0
+	// Write the attribute when its field is set. Optional fields and messages are written when present, arrays when
+	// they aren't empty, and other fields always.
+	AttributePresence_ATTRIBUTE_PRESENCE_IF_SET AttributePresence = 1
+	// Also leave the attribute out when it's the zero value of its Permify type.
+	AttributePresence_ATTRIBUTE_PRESENCE_OMIT_EMPTY AttributePresence = 2
+	// Always write the attribute, as the zero value of its Permify type or an empty array when its field is unset.
+	AttributePresence_ATTRIBUTE_PRESENCE_ALWAYS AttributePresence = 3
+)
+
+// Enum value maps for AttributePresence.
+var (
+	AttributePresence_name = map[int32]string{
+		0: "ATTRIBUTE_PRESENCE_UNSPECIFIED",
+		1: "ATTRIBUTE_PRESENCE_IF_SET",
+		2: "ATTRIBUTE_PRESENCE_OMIT_EMPTY",
+		3: "ATTRIBUTE_PRESENCE_ALWAYS",
+	}
+	AttributePresence_value = map[string]int32{
+		"ATTRIBUTE_PRESENCE_UNSPECIFIED": 0,
+		"ATTRIBUTE_PRESENCE_IF_SET":      1,
+		"ATTRIBUTE_PRESENCE_OMIT_EMPTY":  2,
+		"ATTRIBUTE_PRESENCE_ALWAYS":      3,
+	}
+)
+
+func (x AttributePresence) Enum() *AttributePresence {
+	p := new(AttributePresence)
+	*p = x
+	return p
+}
+
+func (x AttributePresence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributePresence) Descriptor() protoreflect.EnumDescriptor {
+	return file_nrf110_permify_plugin_v1_options_proto_enumTypes[3].Descriptor()
+}
+
+func (AttributePresence) Type() protoreflect.EnumType {
+	return &file_nrf110_permify_plugin_v1_options_proto_enumTypes[3]
+}
+
+func (x AttributePresence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributePresence.Descriptor instead.
+func (AttributePresence) EnumDescriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{3}
+}
+
+// How a method is authorized.
+type AccessMode int32
+
+const (
+	AccessMode_ACCESS_MODE_UNSPECIFIED AccessMode = 0
+	// Anyone may call the method, without authenticating.
+	AccessMode_ACCESS_MODE_PUBLIC AccessMode = 1
+	// Any authenticated caller may call the method, without a Permify check.
+	AccessMode_ACCESS_MODE_AUTHENTICATED AccessMode = 2
+	// Every call is denied, as for decommissioned methods.
+	AccessMode_ACCESS_MODE_DENY AccessMode = 3
+	// Only other services may call the method. Calls are denied unless the interceptor recognizes them as internal.
+	AccessMode_ACCESS_MODE_INTERNAL AccessMode = 4
+	// Callers must be granted the method's permissions on its resources.
+	AccessMode_ACCESS_MODE_CHECKED AccessMode = 5
+)
+
+// Enum value maps for AccessMode.
+var (
+	AccessMode_name = map[int32]string{
+		0: "ACCESS_MODE_UNSPECIFIED",
+		1: "ACCESS_MODE_PUBLIC",
+		2: "ACCESS_MODE_AUTHENTICATED",
+		3: "ACCESS_MODE_DENY",
+		4: "ACCESS_MODE_INTERNAL",
+		5: "ACCESS_MODE_CHECKED",
+	}
+	AccessMode_value = map[string]int32{
+		"ACCESS_MODE_UNSPECIFIED":   0,
+		"ACCESS_MODE_PUBLIC":        1,
+		"ACCESS_MODE_AUTHENTICATED": 2,
+		"ACCESS_MODE_DENY":          3,
+		"ACCESS_MODE_INTERNAL":      4,
+		"ACCESS_MODE_CHECKED":       5,
+	}
+)
+
+func (x AccessMode) Enum() *AccessMode {
+	p := new(AccessMode)
+	*p = x
+	return p
+}
+
+func (x AccessMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_nrf110_permify_plugin_v1_options_proto_enumTypes[4].Descriptor()
+}
+
+func (AccessMode) Type() protoreflect.EnumType {
+	return &file_nrf110_permify_plugin_v1_options_proto_enumTypes[4]
+}
+
+func (x AccessMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessMode.Descriptor instead.
+func (AccessMode) EnumDescriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{4}
+}
+
+// How the checks of several permissions combine.
+type PermissionCombinator int32
+
+const (
+	PermissionCombinator_PERMISSION_COMBINATOR_UNSPECIFIED PermissionCombinator = 0
+	// Every permission must be granted on each resource.
+	PermissionCombinator_PERMISSION_COMBINATOR_ALL_OF PermissionCombinator = 1
+	// Any one permission granted on a resource is enough for that resource.
+	PermissionCombinator_PERMISSION_COMBINATOR_ANY_OF PermissionCombinator = 2
+)
+
+// Enum value maps for PermissionCombinator.
+var (
+	PermissionCombinator_name = map[int32]string{
+		0: "PERMISSION_COMBINATOR_UNSPECIFIED",
+		1: "PERMISSION_COMBINATOR_ALL_OF",
+		2: "PERMISSION_COMBINATOR_ANY_OF",
+	}
+	PermissionCombinator_value = map[string]int32{
+		"PERMISSION_COMBINATOR_UNSPECIFIED": 0,
+		"PERMISSION_COMBINATOR_ALL_OF":      1,
+		"PERMISSION_COMBINATOR_ANY_OF":      2,
+	}
+)
+
+func (x PermissionCombinator) Enum() *PermissionCombinator {
+	p := new(PermissionCombinator)
+	*p = x
+	return p
+}
+
+func (x PermissionCombinator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionCombinator) Descriptor() protoreflect.EnumDescriptor {
+	return file_nrf110_permify_plugin_v1_options_proto_enumTypes[5].Descriptor()
+}
+
+func (PermissionCombinator) Type() protoreflect.EnumType {
+	return &file_nrf110_permify_plugin_v1_options_proto_enumTypes[5]
+}
+
+func (x PermissionCombinator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionCombinator.Descriptor instead.
+func (PermissionCombinator) EnumDescriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{5}
+}
+
+// The entity a resource belongs to, such as the folder holding a document.
+type ParentResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent's entity type.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The path of the parent's id from the resource message, a dot-separated list of proto field names such as
+	// "folder_id".
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The permission checked on the parent. Defaults to the method's permission.
+	Permission string `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	// When the parent is checked.
+	Check         ParentCheck `protobuf:"varint,4,opt,name=check,proto3,enum=nrf110.permify.plugin.v1.ParentCheck" json:"check,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParentResource) Reset() {
+	*x = ParentResource{}
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParentResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentResource) ProtoMessage() {}
+
+func (x *ParentResource) ProtoReflect() protoreflect.Message {
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParentResource.ProtoReflect.Descriptor instead.
+func (*ParentResource) Descriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParentResource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ParentResource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ParentResource) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ParentResource) GetCheck() ParentCheck {
+	if x != nil {
+		return x.Check
+	}
+	return ParentCheck_PARENT_CHECK_UNSPECIFIED
+}
+
+// Resolves a tenant or resource id from the context passed to GetChecksContext, when the request doesn't carry it.
+type Resolver struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Resolver_Name
+	//	*Resolver_ContextKey
+	Source        isResolver_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resolver) Reset() {
+	*x = Resolver{}
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resolver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resolver) ProtoMessage() {}
+
+func (x *Resolver) ProtoReflect() protoreflect.Message {
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resolver.ProtoReflect.Descriptor instead.
+func (*Resolver) Descriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Resolver) GetSource() isResolver_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Resolver) GetName() string {
+	if x != nil {
+		if x, ok := x.Source.(*Resolver_Name); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+func (x *Resolver) GetContextKey() string {
+	if x != nil {
+		if x, ok := x.Source.(*Resolver_ContextKey); ok {
+			return x.ContextKey
+		}
+	}
+	return ""
+}
+
+type isResolver_Source interface {
+	isResolver_Source()
+}
+
+type Resolver_Name struct {
+	// The name of a resolver registered with resolver.Register.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+type Resolver_ContextKey struct {
+	// The key of a value set on the context with resolver.WithValue.
+	ContextKey string `protobuf:"bytes,2,opt,name=context_key,json=contextKey,proto3,oneof"`
+}
+
+func (*Resolver_Name) isResolver_Source() {}
+
+func (*Resolver_ContextKey) isResolver_Source() {}
+
+// The membership checked on the tenant of a method's checks, such as tenant#member, ahead of the checks themselves.
+type TenantGuard struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tenant's entity type, such as "tenant".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The permission checked on the tenant, such as "member".
+	Permission    string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantGuard) Reset() {
+	*x = TenantGuard{}
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantGuard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantGuard) ProtoMessage() {}
+
+func (x *TenantGuard) ProtoReflect() protoreflect.Message {
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantGuard.ProtoReflect.Descriptor instead.
+func (*TenantGuard) Descriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TenantGuard) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TenantGuard) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// Maps the values of a request field to permissions.
+type PermissionField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Permissions by enum value name, such as "OPERATION_READ", or by string value. Enums must map every value that is
+	// checked, while strings without any mapping are checked as permission names themselves. Any other value, or an
+	// unset field, is denied.
+	Permissions   map[string]string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionField) Reset() {
+	*x = PermissionField{}
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionField) ProtoMessage() {}
+
+func (x *PermissionField) ProtoReflect() protoreflect.Message {
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionField.ProtoReflect.Descriptor instead.
+func (*PermissionField) Descriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PermissionField) GetPermissions() map[string]string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// The subject of a check.
+type Subject struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The subject's entity type, such as "user".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The subject's relation, such as "member" for the members of a group.
+	Relation      string `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subject) Reset() {
+	*x = Subject{}
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subject) ProtoMessage() {}
+
+func (x *Subject) ProtoReflect() protoreflect.Message {
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subject.ProtoReflect.Descriptor instead.
+func (*Subject) Descriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Subject) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Subject) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+// A relationship Permify treats as written while checking a request, such as a new document's parent folder before
+// the document is created. Ids are read from the request by field path, a dot-separated list of proto field names
+// such as "document.folder_id", and a tuple whose ids aren't all set is left out.
+type ContextualTuple struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the relationship's entity.
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// The field path of the entity's id.
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The relation between the entity and the subject.
+	Relation string `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`
+	// The type of the relationship's subject.
+	SubjectType string `protobuf:"bytes,4,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	// The field path of the subject's id.
+	SubjectId string `protobuf:"bytes,5,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// The subject's relation, for subject sets such as group:1#member. Optional.
+	SubjectRelation string `protobuf:"bytes,6,opt,name=subject_relation,json=subjectRelation,proto3" json:"subject_relation,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ContextualTuple) Reset() {
+	*x = ContextualTuple{}
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContextualTuple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextualTuple) ProtoMessage() {}
+
+func (x *ContextualTuple) ProtoReflect() protoreflect.Message {
+	mi := &file_nrf110_permify_plugin_v1_options_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextualTuple.ProtoReflect.Descriptor instead.
+func (*ContextualTuple) Descriptor() ([]byte, []int) {
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContextualTuple) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ContextualTuple) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ContextualTuple) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *ContextualTuple) GetSubjectType() string {
+	if x != nil {
+		return x.SubjectType
+	}
+	return ""
+}
+
+func (x *ContextualTuple) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ContextualTuple) GetSubjectRelation() string {
+	if x != nil {
+		return x.SubjectRelation
+	}
+	return ""
+}
+
+var file_nrf110_permify_plugin_v1_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3100,
+		Name:          "nrf110.permify.plugin.v1.strict",
+		Tag:           "varint,3100,opt,name=strict",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3101,
+		Name:          "nrf110.permify.plugin.v1.deduplicate_checks",
+		Tag:           "varint,3101,opt,name=deduplicate_checks",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3102,
+		Name:          "nrf110.permify.plugin.v1.single_tenant",
+		Tag:           "varint,3102,opt,name=single_tenant",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*TenantGuard)(nil),
+		Field:         3103,
+		Name:          "nrf110.permify.plugin.v1.tenant_guard",
+		Tag:           "bytes,3103,opt,name=tenant_guard",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3104,
+		Name:          "nrf110.permify.plugin.v1.sorted_maps",
+		Tag:           "varint,3104,opt,name=sorted_maps",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*ParentResource)(nil),
+		Field:         3100,
+		Name:          "nrf110.permify.plugin.v1.parent_resource",
+		Tag:           "bytes,3100,opt,name=parent_resource",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3101,
+		Name:          "nrf110.permify.plugin.v1.allow_empty_id",
+		Tag:           "varint,3101,opt,name=allow_empty_id",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*Resolver)(nil),
+		Field:         3102,
+		Name:          "nrf110.permify.plugin.v1.tenant_resolver",
+		Tag:           "bytes,3102,opt,name=tenant_resolver",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*Resolver)(nil),
+		Field:         3103,
+		Name:          "nrf110.permify.plugin.v1.id_resolver",
+		Tag:           "bytes,3103,opt,name=id_resolver",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3100,
+		Name:          "nrf110.permify.plugin.v1.oneof_required",
+		Tag:           "varint,3100,opt,name=oneof_required",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*MissingResourcePolicy)(nil),
+		Field:         3100,
+		Name:          "nrf110.permify.plugin.v1.missing_resource",
+		Tag:           "varint,3100,opt,name=missing_resource,enum=nrf110.permify.plugin.v1.MissingResourcePolicy",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3101,
+		Name:          "nrf110.permify.plugin.v1.map_key_resource_id",
+		Tag:           "varint,3101,opt,name=map_key_resource_id",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*AttributeType)(nil),
+		Field:         3102,
+		Name:          "nrf110.permify.plugin.v1.attribute_type",
+		Tag:           "varint,3102,opt,name=attribute_type,enum=nrf110.permify.plugin.v1.AttributeType",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         3103,
+		Name:          "nrf110.permify.plugin.v1.attribute_converter",
+		Tag:           "bytes,3103,opt,name=attribute_converter",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*AttributePresence)(nil),
+		Field:         3104,
+		Name:          "nrf110.permify.plugin.v1.attribute_presence",
+		Tag:           "varint,3104,opt,name=attribute_presence,enum=nrf110.permify.plugin.v1.AttributePresence",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         3105,
+		Name:          "nrf110.permify.plugin.v1.attribute_default",
+		Tag:           "bytes,3105,opt,name=attribute_default",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         3106,
+		Name:          "nrf110.permify.plugin.v1.context_attribute_name",
+		Tag:           "bytes,3106,opt,name=context_attribute_name",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*Subject)(nil),
+		Field:         3107,
+		Name:          "nrf110.permify.plugin.v1.subject",
+		Tag:           "bytes,3107,opt,name=subject",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         3108,
+		Name:          "nrf110.permify.plugin.v1.snap_token",
+		Tag:           "varint,3108,opt,name=snap_token",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*PermissionField)(nil),
+		Field:         3109,
+		Name:          "nrf110.permify.plugin.v1.permission_field",
+		Tag:           "bytes,3109,opt,name=permission_field",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: ([]*ContextualTuple)(nil),
+		Field:         3100,
+		Name:          "nrf110.permify.plugin.v1.contextual_tuple",
+		Tag:           "bytes,3100,rep,name=contextual_tuple",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         3101,
+		Name:          "nrf110.permify.plugin.v1.depth",
+		Tag:           "varint,3101,opt,name=depth",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         3102,
+		Name:          "nrf110.permify.plugin.v1.permissions",
+		Tag:           "bytes,3102,rep,name=permissions",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*PermissionCombinator)(nil),
+		Field:         3103,
+		Name:          "nrf110.permify.plugin.v1.permission_combinator",
+		Tag:           "varint,3103,opt,name=permission_combinator,enum=nrf110.permify.plugin.v1.PermissionCombinator",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*AccessMode)(nil),
+		Field:         3104,
+		Name:          "nrf110.permify.plugin.v1.access",
+		Tag:           "varint,3104,opt,name=access,enum=nrf110.permify.plugin.v1.AccessMode",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*uint32)(nil),
+		Field:         3105,
+		Name:          "nrf110.permify.plugin.v1.max_checks",
+		Tag:           "varint,3105,opt,name=max_checks",
+		Filename:      "nrf110/permify/plugin/v1/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// Overrides the strict plugin parameter for the services of this file.
+	//
+	// optional bool strict = 3100;
+	E_Strict = &file_nrf110_permify_plugin_v1_options_proto_extTypes[0]
+	// Overrides the deduplicate_checks plugin parameter for the services of this file.
+	//
+	// optional bool deduplicate_checks = 3101;
+	E_DeduplicateChecks = &file_nrf110_permify_plugin_v1_options_proto_extTypes[1]
+	// Overrides the single_tenant plugin parameter for the services of this file.
+	//
+	// optional bool single_tenant = 3102;
+	E_SingleTenant = &file_nrf110_permify_plugin_v1_options_proto_extTypes[2]
+	// Overrides the tenant_guard plugin parameter for the services of this file. Both fields must be set, or neither
+	// to turn the guard off.
+	//
+	// optional nrf110.permify.plugin.v1.TenantGuard tenant_guard = 3103;
+	E_TenantGuard = &file_nrf110_permify_plugin_v1_options_proto_extTypes[3]
+	// Overrides the sorted_maps plugin parameter for the services of this file.
+	//
+	// optional bool sorted_maps = 3104;
+	E_SortedMaps = &file_nrf110_permify_plugin_v1_options_proto_extTypes[4]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// Checks the parent of a resource, for operations such as creating it that can't be checked on the resource itself.
+	//
+	// optional nrf110.permify.plugin.v1.ParentResource parent_resource = 3100;
+	E_ParentResource = &file_nrf110_permify_plugin_v1_options_proto_extTypes[5]
+	// Lets the resource be checked without an id when the strict plugin parameter is on, for resources whose empty id
+	// is legitimate.
+	//
+	// optional bool allow_empty_id = 3101;
+	E_AllowEmptyId = &file_nrf110_permify_plugin_v1_options_proto_extTypes[6]
+	// Resolves the resource's tenant from the context when its tenant_id field is unset or it has none.
+	//
+	// optional nrf110.permify.plugin.v1.Resolver tenant_resolver = 3102;
+	E_TenantResolver = &file_nrf110_permify_plugin_v1_options_proto_extTypes[7]
+	// Resolves the resource's id from the context when its resource_id field is unset or it has none.
+	//
+	// optional nrf110.permify.plugin.v1.Resolver id_resolver = 3103;
+	E_IdResolver = &file_nrf110_permify_plugin_v1_options_proto_extTypes[8]
+)
+
+// Extension fields to descriptorpb.OneofOptions.
+var (
+	// When set, a request in which no case of the oneof is set is denied instead of producing no check for it.
+	//
+	// optional bool oneof_required = 3100;
+	E_OneofRequired = &file_nrf110_permify_plugin_v1_options_proto_extTypes[9]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Overrides the missing_resource plugin parameter for the resource held in this message field. Fields declared
+	// optional follow the parameter by default, while other message fields only have their presence checked when this
+	// is set.
+	//
+	// optional nrf110.permify.plugin.v1.MissingResourcePolicy missing_resource = 3100;
+	E_MissingResource = &file_nrf110_permify_plugin_v1_options_proto_extTypes[10]
+	// Marks a map of resources whose keys are the resources' ids. The key is used as the id of each check, in place
+	// of any resource_id field on the map's values. Keys must be strings or integers.
+	//
+	// optional bool map_key_resource_id = 3101;
+	E_MapKeyResourceId = &file_nrf110_permify_plugin_v1_options_proto_extTypes[11]
+	// Converts an attribute field to a Permify type other than its kind's default. Kinds without a default, such as
+	// 64-bit and unsigned integers, must set it. Enums are integers by default, and ATTRIBUTE_TYPE_STRING uses their
+	// value names instead.
+	//
+	// optional nrf110.permify.plugin.v1.AttributeType attribute_type = 3102;
+	E_AttributeType = &file_nrf110_permify_plugin_v1_options_proto_extTypes[12]
+	// Names a Go function converting each value of a message attribute field to a Permify attribute value, as either
+	// "Func" for a function in the generated package or "import/path.Func". Message attributes other than the
+	// well-known types must set it, as must map attributes, whose function is called with each key and value.
+	//
+	// optional string attribute_converter = 3103;
+	E_AttributeConverter = &file_nrf110_permify_plugin_v1_options_proto_extTypes[13]
+	// Overrides the attribute_presence plugin parameter for this attribute field.
+	//
+	// optional nrf110.permify.plugin.v1.AttributePresence attribute_presence = 3104;
+	E_AttributePresence = &file_nrf110_permify_plugin_v1_options_proto_extTypes[14]
+	// The value written in place of an attribute that would be left out, in the syntax of a Go literal of its Permify
+	// type, such as "true", "42" or "draft". Array attributes and attributes with an attribute_converter can't have
+	// one.
+	//
+	// optional string attribute_default = 3105;
+	E_AttributeDefault = &file_nrf110_permify_plugin_v1_options_proto_extTypes[15]
+	// Sends the field to Permify as check context data under this name, rather than as an attribute of the resource.
+	// It may be any field of the request, and is converted like an attribute.
+	//
+	// optional string context_attribute_name = 3106;
+	E_ContextAttributeName = &file_nrf110_permify_plugin_v1_options_proto_extTypes[16]
+	// Checks the subject identified by this request field in place of the caller, for RPCs made on behalf of another
+	// user. The field must be a string, an integer or a wrapper of one, reached from the request through singular
+	// message fields, and a method may have only one.
+	//
+	// optional nrf110.permify.plugin.v1.Subject subject = 3107;
+	E_Subject = &file_nrf110_permify_plugin_v1_options_proto_extTypes[17]
+	// Sends the field to Permify as the snap token of the checks, so they see the data as of a prior write. The field
+	// must be a string or a wrapper of one, reached from the request through singular message fields, and a method may
+	// have only one.
+	//
+	// optional bool snap_token = 3108;
+	E_SnapToken = &file_nrf110_permify_plugin_v1_options_proto_extTypes[18]
+	// Selects the permission the method checks from the value of this request field, in place of the method's
+	// permission option. The field must be a string or an enum, reached from the request through singular message
+	// fields, and a method may have only one.
+	//
+	// optional nrf110.permify.plugin.v1.PermissionField permission_field = 3109;
+	E_PermissionField = &file_nrf110_permify_plugin_v1_options_proto_extTypes[19]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// Relationships sent to Permify with every check of the method.
+	//
+	// repeated nrf110.permify.plugin.v1.ContextualTuple contextual_tuple = 3100;
+	E_ContextualTuple = &file_nrf110_permify_plugin_v1_options_proto_extTypes[20]
+	// The depth Permify checks the method's permission to, overriding the server's default. Permify requires at least 3.
+	//
+	// optional int32 depth = 3101;
+	E_Depth = &file_nrf110_permify_plugin_v1_options_proto_extTypes[21]
+	// Checks every resource of the method for each of these permissions, in place of the method's permission option.
+	// Methods with more than one must set permission_combinator.
+	//
+	// repeated string permissions = 3102;
+	E_Permissions = &file_nrf110_permify_plugin_v1_options_proto_extTypes[22]
+	// How the checks of a method's permissions combine.
+	//
+	// optional nrf110.permify.plugin.v1.PermissionCombinator permission_combinator = 3103;
+	E_PermissionCombinator = &file_nrf110_permify_plugin_v1_options_proto_extTypes[23]
+	// How the method is authorized. Defaults to ACCESS_MODE_PUBLIC for methods with the public option and to
+	// ACCESS_MODE_CHECKED for any other.
+	//
+	// optional nrf110.permify.plugin.v1.AccessMode access = 3104;
+	E_Access = &file_nrf110_permify_plugin_v1_options_proto_extTypes[24]
+	// Denies requests that would produce more checks than this, overriding the max_checks plugin parameter. Guards
+	// the repeated and map fields holding resources, so a request can't fan out into unbounded Permify calls.
+	//
+	// optional uint32 max_checks = 3105;
+	E_MaxChecks = &file_nrf110_permify_plugin_v1_options_proto_extTypes[25]
+)
+
+var File_nrf110_permify_plugin_v1_options_proto protoreflect.FileDescriptor
+
+const file_nrf110_permify_plugin_v1_options_proto_rawDesc = "" +
+	"\n" +
+	"&nrf110/permify/plugin/v1/options.proto\x12\x18nrf110.permify.plugin.v1\x1a google/protobuf/descriptor.proto\"\x91\x01\n" +
+	"\x0eParentResource\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\x12;\n" +
+	"\x05check\x18\x04 \x01(\x0e2%.nrf110.permify.plugin.v1.ParentCheckR\x05check\"M\n" +
+	"\bResolver\x12\x14\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x12!\n" +
+	"\vcontext_key\x18\x02 \x01(\tH\x00R\n" +
+	"contextKeyB\b\n" +
+	"\x06source\"A\n" +
+	"\vTenantGuard\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"\xaf\x01\n" +
+	"\x0fPermissionField\x12\\\n" +
+	"\vpermissions\x18\x01 \x03(\v2:.nrf110.permify.plugin.v1.PermissionField.PermissionsEntryR\vpermissions\x1a>\n" +
+	"\x10PermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"9\n" +
+	"\aSubject\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\"\xd8\x01\n" +
+	"\x0fContextualTuple\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x1a\n" +
+	"\brelation\x18\x03 \x01(\tR\brelation\x12!\n" +
+	"\fsubject_type\x18\x04 \x01(\tR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x05 \x01(\tR\tsubjectId\x12)\n" +
+	"\x10subject_relation\x18\x06 \x01(\tR\x0fsubjectRelation*B\n" +
+	"\vParentCheck\x12\x1c\n" +
+	"\x18PARENT_CHECK_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PARENT_CHECK_ALSO\x10\x01*\xa7\x01\n" +
+	"\x15MissingResourcePolicy\x12'\n" +
+	"#MISSING_RESOURCE_POLICY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dMISSING_RESOURCE_POLICY_CHECK\x10\x01\x12 \n" +
+	"\x1cMISSING_RESOURCE_POLICY_SKIP\x10\x02\x12 \n" +
+	"\x1cMISSING_RESOURCE_POLICY_DENY\x10\x03*\x9d\x01\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ATTRIBUTE_TYPE_BOOLEAN\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x02\x12\x1a\n" +
+	"\x16ATTRIBUTE_TYPE_INTEGER\x10\x03\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_DOUBLE\x10\x04*\x98\x01\n" +
+	"\x11AttributePresence\x12\"\n" +
+	"\x1eATTRIBUTE_PRESENCE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ATTRIBUTE_PRESENCE_IF_SET\x10\x01\x12!\n" +
+	"\x1dATTRIBUTE_PRESENCE_OMIT_EMPTY\x10\x02\x12\x1d\n" +
+	"\x19ATTRIBUTE_PRESENCE_ALWAYS\x10\x03*\xa9\x01\n" +
+	"\n" +
+	"AccessMode\x12\x1b\n" +
+	"\x17ACCESS_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ACCESS_MODE_PUBLIC\x10\x01\x12\x1d\n" +
+	"\x19ACCESS_MODE_AUTHENTICATED\x10\x02\x12\x14\n" +
+	"\x10ACCESS_MODE_DENY\x10\x03\x12\x18\n" +
+	"\x14ACCESS_MODE_INTERNAL\x10\x04\x12\x17\n" +
+	"\x13ACCESS_MODE_CHECKED\x10\x05*\x81\x01\n" +
+	"\x14PermissionCombinator\x12%\n" +
+	"!PERMISSION_COMBINATOR_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cPERMISSION_COMBINATOR_ALL_OF\x10\x01\x12 \n" +
+	"\x1cPERMISSION_COMBINATOR_ANY_OF\x10\x02:5\n" +
+	"\x06strict\x12\x1c.google.protobuf.FileOptions\x18\x9c\x18 \x01(\bR\x06strict:L\n" +
+	"\x12deduplicate_checks\x12\x1c.google.protobuf.FileOptions\x18\x9d\x18 \x01(\bR\x11deduplicateChecks:B\n" +
+	"\rsingle_tenant\x12\x1c.google.protobuf.FileOptions\x18\x9e\x18 \x01(\bR\fsingleTenant:g\n" +
+	"\ftenant_guard\x12\x1c.google.protobuf.FileOptions\x18\x9f\x18 \x01(\v2%.nrf110.permify.plugin.v1.TenantGuardR\vtenantGuard:>\n" +
+	"\vsorted_maps\x12\x1c.google.protobuf.FileOptions\x18\xa0\x18 \x01(\bR\n" +
+	"sortedMaps:s\n" +
+	"\x0fparent_resource\x12\x1f.google.protobuf.MessageOptions\x18\x9c\x18 \x01(\v2(.nrf110.permify.plugin.v1.ParentResourceR\x0eparentResource:F\n" +
+	"\x0eallow_empty_id\x12\x1f.google.protobuf.MessageOptions\x18\x9d\x18 \x01(\bR\fallowEmptyId:m\n" +
+	"\x0ftenant_resolver\x12\x1f.google.protobuf.MessageOptions\x18\x9e\x18 \x01(\v2\".nrf110.permify.plugin.v1.ResolverR\x0etenantResolver:e\n" +
+	"\vid_resolver\x12\x1f.google.protobuf.MessageOptions\x18\x9f\x18 \x01(\v2\".nrf110.permify.plugin.v1.ResolverR\n" +
+	"idResolver:E\n" +
+	"\x0eoneof_required\x12\x1d.google.protobuf.OneofOptions\x18\x9c\x18 \x01(\bR\roneofRequired:z\n" +
+	"\x10missing_resource\x12\x1d.google.protobuf.FieldOptions\x18\x9c\x18 \x01(\x0e2/.nrf110.permify.plugin.v1.MissingResourcePolicyR\x0fmissingResource:M\n" +
+	"\x13map_key_resource_id\x12\x1d.google.protobuf.FieldOptions\x18\x9d\x18 \x01(\bR\x10mapKeyResourceId:n\n" +
+	"\x0eattribute_type\x12\x1d.google.protobuf.FieldOptions\x18\x9e\x18 \x01(\x0e2'.nrf110.permify.plugin.v1.AttributeTypeR\rattributeType:O\n" +
+	"\x13attribute_converter\x12\x1d.google.protobuf.FieldOptions\x18\x9f\x18 \x01(\tR\x12attributeConverter:z\n" +
+	"\x12attribute_presence\x12\x1d.google.protobuf.FieldOptions\x18\xa0\x18 \x01(\x0e2+.nrf110.permify.plugin.v1.AttributePresenceR\x11attributePresence:K\n" +
+	"\x11attribute_default\x12\x1d.google.protobuf.FieldOptions\x18\xa1\x18 \x01(\tR\x10attributeDefault:T\n" +
+	"\x16context_attribute_name\x12\x1d.google.protobuf.FieldOptions\x18\xa2\x18 \x01(\tR\x14contextAttributeName:[\n" +
+	"\asubject\x12\x1d.google.protobuf.FieldOptions\x18\xa3\x18 \x01(\v2!.nrf110.permify.plugin.v1.SubjectR\asubject:=\n" +
+	"\n" +
+	"snap_token\x12\x1d.google.protobuf.FieldOptions\x18\xa4\x18 \x01(\bR\tsnapToken:t\n" +
+	"\x10permission_field\x12\x1d.google.protobuf.FieldOptions\x18\xa5\x18 \x01(\v2).nrf110.permify.plugin.v1.PermissionFieldR\x0fpermissionField:u\n" +
+	"\x10contextual_tuple\x12\x1e.google.protobuf.MethodOptions\x18\x9c\x18 \x03(\v2).nrf110.permify.plugin.v1.ContextualTupleR\x0fcontextualTuple:5\n" +
+	"\x05depth\x12\x1e.google.protobuf.MethodOptions\x18\x9d\x18 \x01(\x05R\x05depth:A\n" +
+	"\vpermissions\x12\x1e.google.protobuf.MethodOptions\x18\x9e\x18 \x03(\tR\vpermissions:\x84\x01\n" +
+	"\x15permission_combinator\x12\x1e.google.protobuf.MethodOptions\x18\x9f\x18 \x01(\x0e2..nrf110.permify.plugin.v1.PermissionCombinatorR\x14permissionCombinator:]\n" +
+	"\x06access\x12\x1e.google.protobuf.MethodOptions\x18\xa0\x18 \x01(\x0e2$.nrf110.permify.plugin.v1.AccessModeR\x06access:>\n" +
+	"\n" +
+	"max_checks\x12\x1e.google.protobuf.MethodOptions\x18\xa1\x18 \x01(\rR\tmaxChecksBWZUgithub.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1;pluginv1b\x06proto3"
+
+var (
+	file_nrf110_permify_plugin_v1_options_proto_rawDescOnce sync.Once
+	file_nrf110_permify_plugin_v1_options_proto_rawDescData []byte
+)
+
+func file_nrf110_permify_plugin_v1_options_proto_rawDescGZIP() []byte {
+	file_nrf110_permify_plugin_v1_options_proto_rawDescOnce.Do(func() {
+		file_nrf110_permify_plugin_v1_options_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nrf110_permify_plugin_v1_options_proto_rawDesc), len(file_nrf110_permify_plugin_v1_options_proto_rawDesc)))
+	})
+	return file_nrf110_permify_plugin_v1_options_proto_rawDescData
+}
+
+var file_nrf110_permify_plugin_v1_options_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_nrf110_permify_plugin_v1_options_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_nrf110_permify_plugin_v1_options_proto_goTypes = []any{
+	(ParentCheck)(0),                    // 0: nrf110.permify.plugin.v1.ParentCheck
+	(MissingResourcePolicy)(0),          // 1: nrf110.permify.plugin.v1.MissingResourcePolicy
+	(AttributeType)(0),                  // 2: nrf110.permify.plugin.v1.AttributeType
+	(AttributePresence)(0),              // 3: nrf110.permify.plugin.v1.AttributePresence
+	(AccessMode)(0),                     // 4: nrf110.permify.plugin.v1.AccessMode
+	(PermissionCombinator)(0),           // 5: nrf110.permify.plugin.v1.PermissionCombinator
+	(*ParentResource)(nil),              // 6: nrf110.permify.plugin.v1.ParentResource
+	(*Resolver)(nil),                    // 7: nrf110.permify.plugin.v1.Resolver
+	(*TenantGuard)(nil),                 // 8: nrf110.permify.plugin.v1.TenantGuard
+	(*PermissionField)(nil),             // 9: nrf110.permify.plugin.v1.PermissionField
+	(*Subject)(nil),                     // 10: nrf110.permify.plugin.v1.Subject
+	(*ContextualTuple)(nil),             // 11: nrf110.permify.plugin.v1.ContextualTuple
+	nil,                                 // 12: nrf110.permify.plugin.v1.PermissionField.PermissionsEntry
+	(*descriptorpb.FileOptions)(nil),    // 13: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil), // 14: google.protobuf.MessageOptions
+	(*descriptorpb.OneofOptions)(nil),   // 15: google.protobuf.OneofOptions
+	(*descriptorpb.FieldOptions)(nil),   // 16: google.protobuf.FieldOptions
+	(*descriptorpb.MethodOptions)(nil),  // 17: google.protobuf.MethodOptions
+}
+var file_nrf110_permify_plugin_v1_options_proto_depIdxs = []int32{
+	0,  // 0: nrf110.permify.plugin.v1.ParentResource.check:type_name -> nrf110.permify.plugin.v1.ParentCheck
+	12, // 1: nrf110.permify.plugin.v1.PermissionField.permissions:type_name -> nrf110.permify.plugin.v1.PermissionField.PermissionsEntry
+	13, // 2: nrf110.permify.plugin.v1.strict:extendee -> google.protobuf.FileOptions
+	13, // 3: nrf110.permify.plugin.v1.deduplicate_checks:extendee -> google.protobuf.FileOptions
+	13, // 4: nrf110.permify.plugin.v1.single_tenant:extendee -> google.protobuf.FileOptions
+	13, // 5: nrf110.permify.plugin.v1.tenant_guard:extendee -> google.protobuf.FileOptions
+	13, // 6: nrf110.permify.plugin.v1.sorted_maps:extendee -> google.protobuf.FileOptions
+	14, // 7: nrf110.permify.plugin.v1.parent_resource:extendee -> google.protobuf.MessageOptions
+	14, // 8: nrf110.permify.plugin.v1.allow_empty_id:extendee -> google.protobuf.MessageOptions
+	14, // 9: nrf110.permify.plugin.v1.tenant_resolver:extendee -> google.protobuf.MessageOptions
+	14, // 10: nrf110.permify.plugin.v1.id_resolver:extendee -> google.protobuf.MessageOptions
+	15, // 11: nrf110.permify.plugin.v1.oneof_required:extendee -> google.protobuf.OneofOptions
+	16, // 12: nrf110.permify.plugin.v1.missing_resource:extendee -> google.protobuf.FieldOptions
+	16, // 13: nrf110.permify.plugin.v1.map_key_resource_id:extendee -> google.protobuf.FieldOptions
+	16, // 14: nrf110.permify.plugin.v1.attribute_type:extendee -> google.protobuf.FieldOptions
+	16, // 15: nrf110.permify.plugin.v1.attribute_converter:extendee -> google.protobuf.FieldOptions
+	16, // 16: nrf110.permify.plugin.v1.attribute_presence:extendee -> google.protobuf.FieldOptions
+	16, // 17: nrf110.permify.plugin.v1.attribute_default:extendee -> google.protobuf.FieldOptions
+	16, // 18: nrf110.permify.plugin.v1.context_attribute_name:extendee -> google.protobuf.FieldOptions
+	16, // 19: nrf110.permify.plugin.v1.subject:extendee -> google.protobuf.FieldOptions
+	16, // 20: nrf110.permify.plugin.v1.snap_token:extendee -> google.protobuf.FieldOptions
+	16, // 21: nrf110.permify.plugin.v1.permission_field:extendee -> google.protobuf.FieldOptions
+	17, // 22: nrf110.permify.plugin.v1.contextual_tuple:extendee -> google.protobuf.MethodOptions
+	17, // 23: nrf110.permify.plugin.v1.depth:extendee -> google.protobuf.MethodOptions
+	17, // 24: nrf110.permify.plugin.v1.permissions:extendee -> google.protobuf.MethodOptions
+	17, // 25: nrf110.permify.plugin.v1.permission_combinator:extendee -> google.protobuf.MethodOptions
+	17, // 26: nrf110.permify.plugin.v1.access:extendee -> google.protobuf.MethodOptions
+	17, // 27: nrf110.permify.plugin.v1.max_checks:extendee -> google.protobuf.MethodOptions
+	8,  // 28: nrf110.permify.plugin.v1.tenant_guard:type_name -> nrf110.permify.plugin.v1.TenantGuard
+	6,  // 29: nrf110.permify.plugin.v1.parent_resource:type_name -> nrf110.permify.plugin.v1.ParentResource
+	7,  // 30: nrf110.permify.plugin.v1.tenant_resolver:type_name -> nrf110.permify.plugin.v1.Resolver
+	7,  // 31: nrf110.permify.plugin.v1.id_resolver:type_name -> nrf110.permify.plugin.v1.Resolver
+	1,  // 32: nrf110.permify.plugin.v1.missing_resource:type_name -> nrf110.permify.plugin.v1.MissingResourcePolicy
+	2,  // 33: nrf110.permify.plugin.v1.attribute_type:type_name -> nrf110.permify.plugin.v1.AttributeType
+	3,  // 34: nrf110.permify.plugin.v1.attribute_presence:type_name -> nrf110.permify.plugin.v1.AttributePresence
+	10, // 35: nrf110.permify.plugin.v1.subject:type_name -> nrf110.permify.plugin.v1.Subject
+	9,  // 36: nrf110.permify.plugin.v1.permission_field:type_name -> nrf110.permify.plugin.v1.PermissionField
+	11, // 37: nrf110.permify.plugin.v1.contextual_tuple:type_name -> nrf110.permify.plugin.v1.ContextualTuple
+	5,  // 38: nrf110.permify.plugin.v1.permission_combinator:type_name -> nrf110.permify.plugin.v1.PermissionCombinator
+	4,  // 39: nrf110.permify.plugin.v1.access:type_name -> nrf110.permify.plugin.v1.AccessMode
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	28, // [28:40] is the sub-list for extension type_name
+	2,  // [2:28] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_nrf110_permify_plugin_v1_options_proto_init() }
+func file_nrf110_permify_plugin_v1_options_proto_init() {
+	if File_nrf110_permify_plugin_v1_options_proto != nil {
+		return
+	}
+	file_nrf110_permify_plugin_v1_options_proto_msgTypes[1].OneofWrappers = []any{
+		(*Resolver_Name)(nil),
+		(*Resolver_ContextKey)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nrf110_permify_plugin_v1_options_proto_rawDesc), len(file_nrf110_permify_plugin_v1_options_proto_rawDesc)),
+			NumEnums:      6,
+			NumMessages:   7,
+			NumExtensions: 26,
+			NumServices:   0,
+		},
+		GoTypes:           file_nrf110_permify_plugin_v1_options_proto_goTypes,
+		DependencyIndexes: file_nrf110_permify_plugin_v1_options_proto_depIdxs,
+		EnumInfos:         file_nrf110_permify_plugin_v1_options_proto_enumTypes,
+		MessageInfos:      file_nrf110_permify_plugin_v1_options_proto_msgTypes,
+		ExtensionInfos:    file_nrf110_permify_plugin_v1_options_proto_extTypes,
+	}.Build()
+	File_nrf110_permify_plugin_v1_options_proto = out.File
+	file_nrf110_permify_plugin_v1_options_proto_goTypes = nil
+	file_nrf110_permify_plugin_v1_options_proto_depIdxs = nil
+}
@@ -26,8 +26,8 @@ func TestGoldenFilesMatchOutput(t *testing.T) {
 			return nil
 		}
 
-		// Only process *_permit.pb.go files
-		if !strings.HasSuffix(d.Name(), "_permit.pb.go") {
+		// Only process *_permit.pb.go files and their benchmarks
+		if !isPermitFile(d.Name()) {
 			return nil
 		}
 
@@ -72,12 +72,12 @@ func TestAllGoldenFilesHaveCorrespondingOutput(t *testing.T) {
 	goldenFiles := make(map[string]bool)
 	outputFiles := make(map[string]bool)
 
-	// Collect all golden *_permit.pb.go files
+	// Collect all golden *_permit.pb.go files and their benchmarks
 	err := filepath.WalkDir(goldenRoot, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
-		if !d.IsDir() && strings.HasSuffix(d.Name(), "_permit.pb.go") {
+		if !d.IsDir() && isPermitFile(d.Name()) {
 			relPath, _ := filepath.Rel(goldenRoot, path)
 			goldenFiles[relPath] = true
 		}
@@ -85,12 +85,12 @@ func TestAllGoldenFilesHaveCorrespondingOutput(t *testing.T) {
 	})
 	require.NoError(t, err, "Failed to walk golden directory")
 
-	// Collect all output *_permit.pb.go files
+	// Collect all output *_permit.pb.go files and their benchmarks
 	err = filepath.WalkDir(outputRoot, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
-		if !d.IsDir() && strings.HasSuffix(d.Name(), "_permit.pb.go") {
+		if !d.IsDir() && isPermitFile(d.Name()) {
 			relPath, _ := filepath.Rel(outputRoot, path)
 			outputFiles[relPath] = true
 		}
@@ -156,4 +156,9 @@ func TestGoldenFileStructure(t *testing.T) {
 		require.NoError(t, err)
 		assert.Greater(t, outputCount, 0, "Should have at least one output file")
 	})
-}
\ No newline at end of file
+}
+
+// isPermitFile reports whether name is a file generated by the plugin, either checks or their benchmarks.
+func isPermitFile(name string) bool {
+	return strings.HasSuffix(name, "_permit.pb.go") || strings.HasSuffix(name, "_permit_test.go")
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"flag"
+
 	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/model"
 	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -11,19 +13,25 @@ func main() {
 	util.InitLogger()
 	defer util.LogFile.Close()
 
-	protogen.Options{}.Run(func(plugin *protogen.Plugin) error {
+	var flags flag.FlagSet
+	options := model.NewOptions()
+	options.RegisterFlags(&flags)
+
+	protogen.Options{
+		ParamFunc: flags.Set,
+	}.Run(func(plugin *protogen.Plugin) error {
 		plugin.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 		for _, f := range plugin.Files {
 			if !f.Generate {
 				continue
 			}
-			buildModel(plugin, f)
+			buildModel(plugin, f, options)
 		}
 		return nil
 	})
 }
 
-func buildModel(plugin *protogen.Plugin, file *protogen.File) {
+func buildModel(plugin *protogen.Plugin, file *protogen.File, options *model.Options) {
 	if len(file.Services) == 0 {
 		return
 	}
@@ -41,8 +49,12 @@ func buildModel(plugin *protogen.Plugin, file *protogen.File) {
 	gen.P("package " + file.GoPackageName)
 	gen.P("")
 
+	fileOptions := options.ForFile(plugin, file)
 	for _, service := range file.Services {
-		svc := model.NewService(plugin, gen, service)
+		svc := model.NewService(plugin, gen, service, fileOptions)
 		svc.Generate()
 	}
+	if fileOptions.Benchmarks {
+		model.GenerateBenchmarks(plugin, file, fileOptions)
+	}
 }
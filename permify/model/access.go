@@ -0,0 +1,54 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/proto"
+)
+
+// findAccess resolves the access mode of a method from its options, where public is the public option.
+func findAccess(public bool, methodOptions proto.Message) (pluginv1.AccessMode, error) {
+	access, _ := proto.GetExtension(methodOptions, pluginv1.E_Access).(pluginv1.AccessMode)
+	switch {
+	case access == pluginv1.AccessMode_ACCESS_MODE_UNSPECIFIED && public:
+		return pluginv1.AccessMode_ACCESS_MODE_PUBLIC, nil
+	case access == pluginv1.AccessMode_ACCESS_MODE_UNSPECIFIED:
+		return pluginv1.AccessMode_ACCESS_MODE_CHECKED, nil
+	case public && access != pluginv1.AccessMode_ACCESS_MODE_PUBLIC:
+		return access, fmt.Errorf("public methods can't have access mode %s", access)
+	}
+	return access, nil
+}
+
+// IsPublic reports whether anyone may call the method.
+func (method *Method) IsPublic() bool {
+	return method.Access == pluginv1.AccessMode_ACCESS_MODE_PUBLIC
+}
+
+// generateAuthenticated returns a CheckConfig without checks, which the interceptor passes once the caller has
+// authenticated.
+func (method *Method) generateAuthenticated() {
+	file := method.file
+	file.P(util.Indent(1), "return pkg.CheckConfig {")
+	file.P(util.Indent(2), "IsPublic: false,")
+	file.P(util.Indent(2), `Checks: []pkg.Check{},`)
+	file.P(util.Indent(1), "}")
+}
+
+// generateAccessMode adds GetAccessMode to internal methods, whose CheckConfig denies every call so that an
+// interceptor unaware of internal calls fails closed. The mode is returned as a plain string so that generated code
+// doesn't depend on the plugin.
+func (method *Method) generateAccessMode() {
+	file := method.file
+	mode := strings.ToLower(strings.TrimPrefix(method.Access.String(), "ACCESS_MODE_"))
+
+	file.P("// GetAccessMode returns how the method is authorized. GetChecks denies every call, so an interceptor allowing")
+	file.P("// internal calls must check it first.")
+	file.P("func (req *", method.RequestType, ") GetAccessMode() string {")
+	file.P(util.Indent(1), "return ", strconv.Quote(mode))
+	file.P("}")
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestFindAccess(t *testing.T) {
+	methodOptions := func(access pluginv1.AccessMode) *descriptorpb.MethodOptions {
+		methodOptions := &descriptorpb.MethodOptions{}
+		proto.SetExtension(methodOptions, pluginv1.E_Access, access)
+		return methodOptions
+	}
+
+	tests := []struct {
+		name     string
+		public   bool
+		options  *descriptorpb.MethodOptions
+		expected pluginv1.AccessMode
+		err      string
+	}{
+		{name: "checked by default", options: &descriptorpb.MethodOptions{}, expected: pluginv1.AccessMode_ACCESS_MODE_CHECKED},
+		{name: "public option", public: true, options: &descriptorpb.MethodOptions{}, expected: pluginv1.AccessMode_ACCESS_MODE_PUBLIC},
+		{name: "public option and mode", public: true, options: methodOptions(pluginv1.AccessMode_ACCESS_MODE_PUBLIC), expected: pluginv1.AccessMode_ACCESS_MODE_PUBLIC},
+		{name: "explicit mode", options: methodOptions(pluginv1.AccessMode_ACCESS_MODE_INTERNAL), expected: pluginv1.AccessMode_ACCESS_MODE_INTERNAL},
+		{name: "public option conflicts", public: true, options: methodOptions(pluginv1.AccessMode_ACCESS_MODE_DENY), err: "public methods can't have access mode ACCESS_MODE_DENY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access, err := findAccess(tt.public, tt.options)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, access)
+		})
+	}
+}
+
+func TestMethodGenerateAccessModes(t *testing.T) {
+	tests := []struct {
+		access   pluginv1.AccessMode
+		contains []string
+		excludes []string
+	}{
+		{
+			access:   pluginv1.AccessMode_ACCESS_MODE_AUTHENTICATED,
+			contains: []string{"IsPublic: false,\n\t\tChecks:   []pkg.Check{},"},
+			excludes: []string{"GetAccessMode", "deny_reason"},
+		},
+		{
+			access:   pluginv1.AccessMode_ACCESS_MODE_DENY,
+			contains: []string{`"deny_reason": "method denies every call",`},
+			excludes: []string{"GetAccessMode"},
+		},
+		{
+			access: pluginv1.AccessMode_ACCESS_MODE_INTERNAL,
+			contains: []string{
+				`"deny_reason": "method is internal",`,
+				"func (req *ProfileRequest) GetAccessMode() string {\n\treturn \"internal\"\n}",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.access.String(), func(t *testing.T) {
+			file := newTestGeneratedFile(t)
+			method := &Method{file: file, options: NewOptions(), Access: tt.access, RequestType: "ProfileRequest"}
+
+			method.Generate()
+
+			content, err := file.Content()
+			require.NoError(t, err)
+			assert.False(t, method.IsPublic())
+			for _, expected := range tt.contains {
+				assert.Contains(t, string(content), expected)
+			}
+			for _, unexpected := range tt.excludes {
+				assert.NotContains(t, string(content), unexpected)
+			}
+		})
+	}
+}
@@ -0,0 +1,619 @@
+package model
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// attributeConversion describes how each value of an attribute field is converted to a value Permify accepts:
+// a boolean, string, integer (int32) or double. Repeated fields and maps become arrays of their converted values, and
+// the converter of a map is called with each of its keys as well as its values.
+type attributeConversion struct {
+	Kind      protoreflect.Kind
+	Type      pluginv1.AttributeType
+	Converter *protogen.GoIdent
+	// Unwrap is set for the well-known wrapper types, whose Value is converted like a scalar of Kind.
+	Unwrap bool
+	// WellKnown is set for the other well-known types with a conversion of their own.
+	WellKnown *wellKnownAttribute
+}
+
+// wellKnownAttribute describes the conversions of a well-known message type, one for each Permify type it can be
+// converted to.
+type wellKnownAttribute struct {
+	Default pluginv1.AttributeType
+	Render  map[pluginv1.AttributeType]func(file *protogen.GeneratedFile, expr string) string
+	// Spread is set when a single message converts to an array of values.
+	Spread bool
+}
+
+// attributeWrapperKinds maps the well-known wrapper messages to the kind of their Value field.
+var attributeWrapperKinds = map[protoreflect.FullName]protoreflect.Kind{
+	"google.protobuf.BoolValue":   protoreflect.BoolKind,
+	"google.protobuf.StringValue": protoreflect.StringKind,
+	"google.protobuf.Int32Value":  protoreflect.Int32Kind,
+	"google.protobuf.Int64Value":  protoreflect.Int64Kind,
+	"google.protobuf.UInt32Value": protoreflect.Uint32Kind,
+	"google.protobuf.UInt64Value": protoreflect.Uint64Kind,
+	"google.protobuf.FloatValue":  protoreflect.FloatKind,
+	"google.protobuf.DoubleValue": protoreflect.DoubleKind,
+}
+
+// wellKnownAttributes are the well-known message types that convert to Permify types without an attribute_converter.
+var wellKnownAttributes = map[protoreflect.FullName]*wellKnownAttribute{
+	"google.protobuf.Timestamp": {
+		Default: pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+		Render: map[pluginv1.AttributeType]func(*protogen.GeneratedFile, string) string{
+			// Unix seconds
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE: func(file *protogen.GeneratedFile, expr string) string {
+				return fmt.Sprintf("float64(%s.GetSeconds())", expr)
+			},
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING: func(file *protogen.GeneratedFile, expr string) string {
+				return fmt.Sprintf("%s.AsTime().Format(%s)", expr, file.QualifiedGoIdent(protogen.GoIdent{
+					GoName:       "RFC3339",
+					GoImportPath: "time",
+				}))
+			},
+		},
+	},
+	"google.protobuf.Duration": {
+		Default: pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+		Render: map[pluginv1.AttributeType]func(*protogen.GeneratedFile, string) string{
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE: func(file *protogen.GeneratedFile, expr string) string {
+				return fmt.Sprintf("%s.AsDuration().Seconds()", expr)
+			},
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING: func(file *protogen.GeneratedFile, expr string) string {
+				return fmt.Sprintf("%s.AsDuration().String()", expr)
+			},
+		},
+	},
+	"google.protobuf.Struct": {
+		Default: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+		Render: map[pluginv1.AttributeType]func(*protogen.GeneratedFile, string) string{
+			// JSON
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING: func(file *protogen.GeneratedFile, expr string) string {
+				return fmt.Sprintf("%s(%s)", file.QualifiedGoIdent(protogen.GoIdent{
+					GoName:       "Format",
+					GoImportPath: "google.golang.org/protobuf/encoding/protojson",
+				}), expr)
+			},
+		},
+	},
+	"google.protobuf.Value": {
+		Render: map[pluginv1.AttributeType]func(*protogen.GeneratedFile, string) string{
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN: func(file *protogen.GeneratedFile, expr string) string {
+				return expr + ".GetBoolValue()"
+			},
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING: func(file *protogen.GeneratedFile, expr string) string {
+				return expr + ".GetStringValue()"
+			},
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE: func(file *protogen.GeneratedFile, expr string) string {
+				return expr + ".GetNumberValue()"
+			},
+		},
+	},
+	"google.protobuf.FieldMask": {
+		Default: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+		Render: map[pluginv1.AttributeType]func(*protogen.GeneratedFile, string) string{
+			pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING: func(file *protogen.GeneratedFile, expr string) string {
+				return expr + ".GetPaths()"
+			},
+		},
+		Spread: true,
+	},
+}
+
+// defaultAttributeTypes are the Permify types of the kinds that convert without loss.
+var defaultAttributeTypes = map[protoreflect.Kind]pluginv1.AttributeType{
+	protoreflect.BoolKind:     pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN,
+	protoreflect.StringKind:   pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+	protoreflect.EnumKind:     pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+	protoreflect.Int32Kind:    pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+	protoreflect.Sint32Kind:   pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+	protoreflect.Sfixed32Kind: pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+	protoreflect.FloatKind:    pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+	protoreflect.DoubleKind:   pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+}
+
+// newAttributeConversion works out the conversion of the values of field, which is either the field's
+// attribute_converter, its attribute_type or the default type for its kind.
+func newAttributeConversion(field *protogen.Field) (*attributeConversion, error) {
+	if field == nil {
+		return &attributeConversion{}, nil
+	}
+
+	value := field.Desc
+	if field.Desc.IsMap() {
+		value = field.Desc.MapValue()
+	}
+	kind := value.Kind()
+	conversion := &attributeConversion{Kind: kind}
+
+	if found, converter := util.GetStringExtension(field.Desc, pluginv1.E_AttributeConverter); found && converter != "" {
+		conversion.Converter = parseGoIdent(converter)
+		return conversion, nil
+	}
+	if field.Desc.IsMap() {
+		// Converting only the values would silently drop the keys, which usually carry the meaning of the map
+		return nil, fmt.Errorf("map attributes must set attribute_converter, which is called with each key and value")
+	}
+
+	explicit, _ := proto.GetExtension(field.Desc.Options(), pluginv1.E_AttributeType).(pluginv1.AttributeType)
+
+	if kind == protoreflect.MessageKind || kind == protoreflect.GroupKind {
+		name := value.Message().FullName()
+		if wrapped, ok := attributeWrapperKinds[name]; ok {
+			kind = wrapped
+			conversion.Kind = wrapped
+			conversion.Unwrap = true
+		} else if wellKnown, ok := wellKnownAttributes[name]; ok {
+			conversion.WellKnown = wellKnown
+			conversion.Type = explicit
+			if explicit == pluginv1.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED {
+				conversion.Type = wellKnown.Default
+			}
+			if conversion.Type == pluginv1.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED {
+				return nil, fmt.Errorf("%s attributes have no default Permify type, set attribute_type", name)
+			}
+			if _, ok := wellKnown.Render[conversion.Type]; !ok {
+				return nil, fmt.Errorf("%s attributes can't be converted to %s", name, conversion.Type)
+			}
+			return conversion, nil
+		} else {
+			return nil, fmt.Errorf("message attributes must set attribute_converter")
+		}
+	}
+
+	if explicit == pluginv1.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED {
+		defaultType, ok := defaultAttributeTypes[kind]
+		if !ok {
+			return nil, fmt.Errorf("%s attributes have no default Permify type, set attribute_type or attribute_converter", kind)
+		}
+		conversion.Type = defaultType
+		return conversion, nil
+	}
+
+	if !convertible(kind, explicit) {
+		return nil, fmt.Errorf("%s attributes can't be converted to %s", kind, explicit)
+	}
+	conversion.Type = explicit
+	return conversion, nil
+}
+
+// convertible reports whether values of kind can be converted to the Permify type t.
+func convertible(kind protoreflect.Kind, t pluginv1.AttributeType) bool {
+	switch kind {
+	case protoreflect.BoolKind:
+		return t == pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN || t == pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING
+	case protoreflect.StringKind:
+		return t == pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING
+	case protoreflect.EnumKind:
+		return t == pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER || t == pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return t == pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE || t == pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return t == pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER ||
+			t == pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE ||
+			t == pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING
+	default:
+		// The other integer kinds would wrap around when narrowed to Permify's 32-bit integers.
+		return util.IsIdKind(kind) &&
+			(t == pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE || t == pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING)
+	}
+}
+
+// parseGoIdent splits a Go function reference of the form "import/path.Func" into its import path and name. A
+// reference without an import path names a function in the generated package.
+func parseGoIdent(ref string) *protogen.GoIdent {
+	idx := strings.LastIndex(ref, ".")
+	if idx < 0 {
+		return &protogen.GoIdent{GoName: ref}
+	}
+	return &protogen.GoIdent{GoName: ref[idx+1:], GoImportPath: protogen.GoImportPath(ref[:idx])}
+}
+
+// GoType returns the Go type of a converted value.
+func (conversion *attributeConversion) GoType() string {
+	if conversion.Converter != nil {
+		return "any"
+	}
+	switch conversion.Type {
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:
+		return "bool"
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING:
+		return "string"
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER:
+		return "int32"
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE:
+		return "float64"
+	default:
+		return "any"
+	}
+}
+
+// IsIdentity reports whether values are used as they are, so a repeated field can be used without copying it.
+func (conversion *attributeConversion) IsIdentity() bool {
+	return conversion.Converter == nil && !conversion.Unwrap && conversion.WellKnown == nil && conversion.isScalarIdentity()
+}
+
+// isScalarIdentity reports whether scalars of Kind already have the Go type of Type.
+func (conversion *attributeConversion) isScalarIdentity() bool {
+	switch conversion.Type {
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:
+		return conversion.Kind == protoreflect.BoolKind
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING:
+		return conversion.Kind == protoreflect.StringKind
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER:
+		return conversion.Kind == protoreflect.Int32Kind || conversion.Kind == protoreflect.Sint32Kind ||
+			conversion.Kind == protoreflect.Sfixed32Kind
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE:
+		return conversion.Kind == protoreflect.DoubleKind
+	default:
+		return true
+	}
+}
+
+// IsSpread reports whether a single value converts to an array of values.
+func (conversion *attributeConversion) IsSpread() bool {
+	return conversion.WellKnown != nil && conversion.WellKnown.Spread
+}
+
+// Render returns the expression converting the single value expr.
+func (conversion *attributeConversion) Render(file *protogen.GeneratedFile, expr string) string {
+	if conversion.Converter != nil {
+		return conversion.renderConverter(file, expr)
+	}
+	if conversion.WellKnown != nil {
+		return conversion.WellKnown.Render[conversion.Type](file, expr)
+	}
+	if conversion.Unwrap {
+		expr += ".GetValue()"
+	}
+	if conversion.isScalarIdentity() {
+		return expr
+	}
+	switch conversion.Type {
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING:
+		switch conversion.Kind {
+		case protoreflect.BoolKind:
+			return fmt.Sprintf("%s(%s)", file.QualifiedGoIdent(strconvIdent("FormatBool")), expr)
+		case protoreflect.EnumKind:
+			if strings.HasPrefix(expr, "*") {
+				expr = "(" + expr + ")"
+			}
+			return expr + ".String()"
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			return fmt.Sprintf("%s(float64(%s), 'g', -1, 64)", file.QualifiedGoIdent(strconvIdent("FormatFloat")), expr)
+		default:
+			return renderIdConversion(file, expr, conversion.Kind)
+		}
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER:
+		return fmt.Sprintf("int32(%s)", expr)
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE:
+		return fmt.Sprintf("float64(%s)", expr)
+	default:
+		return expr
+	}
+}
+
+// RenderEntry returns the expression converting the map entry of key and value, which only a converter can do.
+func (conversion *attributeConversion) RenderEntry(file *protogen.GeneratedFile, key string, value string) string {
+	return conversion.renderConverter(file, key, value)
+}
+
+// renderConverter returns the call of the attribute_converter with args.
+func (conversion *attributeConversion) renderConverter(file *protogen.GeneratedFile, args ...string) string {
+	name := conversion.Converter.GoName
+	if conversion.Converter.GoImportPath != "" {
+		name = file.QualifiedGoIdent(*conversion.Converter)
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+}
+
+// Zero returns the zero value of the Permify type, or of an array of it, and an empty string for attributes with a
+// converter, which have none.
+func (conversion *attributeConversion) Zero(array bool) string {
+	if array || conversion.IsSpread() {
+		return "[]" + conversion.GoType() + "{}"
+	}
+	switch conversion.GoType() {
+	case "bool":
+		return "false"
+	case "string":
+		return `""`
+	case "int32", "float64":
+		return conversion.GoType() + "(0)"
+	default:
+		return ""
+	}
+}
+
+// NonZero returns the condition under which the converted value expr isn't the zero value of its type.
+func (conversion *attributeConversion) NonZero(expr string) string {
+	if conversion.IsSpread() {
+		return "len(" + expr + ") > 0"
+	}
+	switch conversion.GoType() {
+	case "bool":
+		return expr
+	case "string":
+		return expr + ` != ""`
+	case "int32", "float64":
+		return expr + " != 0"
+	default:
+		return expr + " != nil"
+	}
+}
+
+// Literal returns value, an attribute_default, as a Go literal of the Permify type.
+func (conversion *attributeConversion) Literal(value string) (string, error) {
+	if conversion.Converter != nil || conversion.IsSpread() {
+		return "", fmt.Errorf("attribute_default isn't supported on attributes converted to arrays or by a function")
+	}
+	switch conversion.Type {
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("attribute_default %q isn't a boolean", value)
+		}
+		return strconv.FormatBool(parsed), nil
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING:
+		return strconv.Quote(value), nil
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER:
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("attribute_default %q isn't a 32-bit integer", value)
+		}
+		return fmt.Sprintf("int32(%d)", parsed), nil
+	case pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE:
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+			return "", fmt.Errorf("attribute_default %q isn't a finite double", value)
+		}
+		return fmt.Sprintf("float64(%s)", strconv.FormatFloat(parsed, 'g', -1, 64)), nil
+	default:
+		return "", fmt.Errorf("attribute_default needs a Permify type")
+	}
+}
+
+// isArrayAttribute reports whether the attribute at path is an array, either because it's collected from a
+// collection or because its field converts to one.
+func isArrayAttribute(path *Path, conversion *attributeConversion) bool {
+	leaf := path.Leaf().Field
+	return path.Child != nil || conversion.IsSpread() ||
+		(leaf != nil && (leaf.Desc.IsList() || leaf.Desc.IsMap()))
+}
+
+// validateAttribute reports an attribute at path that can't be converted or has an invalid attribute_default.
+func validateAttribute(path *Path) error {
+	field := path.Leaf().Field
+	conversion, err := newAttributeConversion(field)
+	if err != nil {
+		return err
+	}
+	if found, value := util.GetStringExtension(field.Desc, pluginv1.E_AttributeDefault); found {
+		if isArrayAttribute(path, conversion) {
+			return fmt.Errorf("attribute_default isn't supported on array attributes")
+		}
+		if _, err := conversion.Literal(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributeRenderer renders the code filling a map of attribute values, converted to Permify types, from attribute
+// paths.
+type attributeRenderer struct {
+	file    *protogen.GeneratedFile
+	options *Options
+	// variable is the name of the map in the generated code.
+	variable string
+}
+
+// render declares the map and fills it with the attributes at paths, in name order.
+func (renderer *attributeRenderer) render(paths map[string]*Path, nestingLevel int) {
+	file := renderer.file
+
+	file.P(util.Indent(nestingLevel), renderer.variable, " := make(map[string]any, ", len(paths), ")")
+	keys := maps.Keys(paths)
+	sortedKeys := slices.Sorted(keys)
+	for _, name := range sortedKeys {
+		path := paths[name]
+		policy := renderer.attributePresencePolicy(path.Leaf().Field)
+		if fallback := attributeFallback(path, policy); fallback != "" {
+			// Written first, and replaced when the attribute has a value
+			renderer.renderAssign(name, fallback, nestingLevel)
+		}
+		omitEmpty := policy == pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_OMIT_EMPTY
+		renderer.renderAttribute(name, path, nestingLevel, false, omitEmpty)
+	}
+}
+
+// renderAssign sets the attribute called name to value.
+func (renderer *attributeRenderer) renderAssign(name string, value string, nestingLevel int) {
+	renderer.file.P(util.Indent(nestingLevel), renderer.variable, `["`, name, `"] = `, value)
+}
+
+// attributePresencePolicy returns when the attribute held in field is written, from its annotation or else the
+// plugin's options.
+func (renderer *attributeRenderer) attributePresencePolicy(field *protogen.Field) pluginv1.AttributePresence {
+	if field != nil {
+		opts := field.Desc.Options()
+		if policy, ok := proto.GetExtension(opts, pluginv1.E_AttributePresence).(pluginv1.AttributePresence); ok &&
+			policy != pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_UNSPECIFIED {
+			return policy
+		}
+	}
+	if renderer.options != nil {
+		return renderer.options.AttributePresence
+	}
+	return pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_IF_SET
+}
+
+// attributeFallback returns the value written for the attribute at path when it would otherwise be left out: its
+// attribute_default, or the zero value when it's always written. It's empty when there's no fallback, or when the
+// attribute is always written anyway.
+func attributeFallback(path *Path, policy pluginv1.AttributePresence) string {
+	field := path.Leaf().Field
+	conversion := attributeConversionOf(field)
+	array := isArrayAttribute(path, conversion)
+	if !array && policy != pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_OMIT_EMPTY && len(attributeConditions(path)) == 0 {
+		return ""
+	}
+	if field != nil {
+		if found, value := util.GetStringExtension(field.Desc, pluginv1.E_AttributeDefault); found {
+			literal, _ := conversion.Literal(value)
+			return literal
+		}
+	}
+	if policy == pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_ALWAYS {
+		return conversion.Zero(array)
+	}
+	return ""
+}
+
+// attributeConversionOf returns the conversion of the attribute held in field.
+func attributeConversionOf(field *protogen.Field) *attributeConversion {
+	conversion, err := newAttributeConversion(field)
+	if err != nil {
+		// Already reported by findAttributes, the generated files are discarded
+		return &attributeConversion{}
+	}
+	return conversion
+}
+
+func (renderer *attributeRenderer) renderAttribute(name string, remainingPath *Path, nestingLevel int, isNested bool, omitEmpty bool) {
+	file := renderer.file
+	conversion := attributeConversionOf(remainingPath.Leaf().Field)
+	valuesName := name + "Values"
+
+	if remainingPath.Child != nil {
+		// We have a nested collection, need to collect values into a slice
+		varName := util.VariableName()
+		if !isNested {
+			// First time entering a collection, initialize the slice with a value for each of its elements, though
+			// nested collections may hold more
+			renderer.renderValues(valuesName, conversion, remainingPath.Path, nestingLevel)
+		}
+		renderRange(file, renderer.options, remainingPath, nestingLevel, "_", varName)
+		renderer.renderAttribute(name, remainingPath.Child.WithPrefix(varName), nestingLevel+1, true, omitEmpty)
+		file.P(util.Indent(nestingLevel), "}")
+		if !isNested {
+			renderer.renderAttributeValues(name, nestingLevel)
+		}
+		return
+	}
+
+	conditions := attributeConditions(remainingPath)
+	if len(conditions) > 0 {
+		file.P(util.Indent(nestingLevel), "if ", strings.Join(conditions, " && "), " {")
+		nestingLevel++
+	}
+
+	field := remainingPath.Field
+	switch {
+	case field != nil && field.Desc.IsList() && conversion.IsIdentity():
+		if isNested {
+			file.P(util.Indent(nestingLevel), valuesName, ` = append(`, valuesName, `, `, remainingPath.Path, `...)`)
+		} else {
+			file.P(util.Indent(nestingLevel), `if len(`, remainingPath.Path, `) > 0 {`)
+			renderer.renderAssign(name, remainingPath.Path, nestingLevel+1)
+			file.P(util.Indent(nestingLevel), "}")
+		}
+	case field != nil && (field.Desc.IsList() || field.Desc.IsMap()):
+		// Each value of the collection is converted into an array
+		if !isNested {
+			renderer.renderValues(valuesName, conversion, remainingPath.Path, nestingLevel)
+		}
+		keyName := "_"
+		if field.Desc.IsMap() {
+			keyName = util.VariableName()
+		}
+		varName := util.VariableName()
+		value := conversion.Render(file, varName)
+		if field.Desc.IsMap() {
+			value = conversion.RenderEntry(file, keyName, varName)
+		}
+		renderRange(file, renderer.options, remainingPath, nestingLevel, keyName, varName)
+		file.P(util.Indent(nestingLevel+1), valuesName, ` = append(`, valuesName, `, `, value, spread(conversion), `)`)
+		file.P(util.Indent(nestingLevel), "}")
+		if !isNested {
+			renderer.renderAttributeValues(name, nestingLevel)
+		}
+	default:
+		value := remainingPath.Path
+		if field != nil && util.IsOptionalScalar(field) {
+			value = "*" + value
+		}
+		value = conversion.Render(file, value)
+		switch {
+		case isNested:
+			// We're inside a collection, append to the slice
+			file.P(util.Indent(nestingLevel), valuesName, ` = append(`, valuesName, `, `, value, spread(conversion), `)`)
+		case omitEmpty:
+			varName := util.VariableName()
+			file.P(util.Indent(nestingLevel), "if ", varName, " := ", value, "; ", conversion.NonZero(varName), " {")
+			renderer.renderAssign(name, varName, nestingLevel+1)
+			file.P(util.Indent(nestingLevel), "}")
+		default:
+			// Direct assignment for non-nested attributes
+			renderer.renderAssign(name, value, nestingLevel)
+		}
+	}
+
+	if len(conditions) > 0 {
+		file.P(util.Indent(nestingLevel-1), "}")
+	}
+}
+
+// renderValues declares the slice collecting the values of an attribute from the collection at collectionPath, with
+// room for a value per element. Conversions returning arrays may need more.
+func (renderer *attributeRenderer) renderValues(valuesName string, conversion *attributeConversion, collectionPath string, nestingLevel int) {
+	renderer.file.P(util.Indent(nestingLevel), valuesName, " := make([]", conversion.GoType(), ", 0, len(", collectionPath, "))")
+}
+
+// spread returns the suffix appending every value a conversion returns, when it returns an array.
+func spread(conversion *attributeConversion) string {
+	if conversion.IsSpread() {
+		return "..."
+	}
+	return ""
+}
+
+// renderAttributeValues assigns the values collected for an attribute once they've all been converted.
+func (renderer *attributeRenderer) renderAttributeValues(name string, nestingLevel int) {
+	file := renderer.file
+
+	file.P(util.Indent(nestingLevel), `if len(`, name, `Values) > 0 {`)
+	renderer.renderAssign(name, name+"Values", nestingLevel+1)
+	file.P(util.Indent(nestingLevel), "}")
+}
+
+// attributeConditions returns the conditions under which the attribute at the end of path is present: every message
+// traversed to reach it must be set, as must the attribute itself when it's an optional scalar or a message.
+func attributeConditions(path *Path) []string {
+	var conditions []string
+	segments := strings.Split(path.Path, ".")
+	for idx := 1; idx < len(segments)-1; idx++ {
+		conditions = append(conditions, strings.Join(segments[:idx+1], ".")+" != nil")
+	}
+	if path.Field != nil && (util.IsOptionalScalar(path.Field) || isSingularMessage(path.Field)) {
+		conditions = append(conditions, path.Path+" != nil")
+	}
+	return conditions
+}
+
+func isSingularMessage(field *protogen.Field) bool {
+	return util.IsMessage(field) && !field.Desc.IsList() && !field.Desc.IsMap()
+}
@@ -0,0 +1,286 @@
+package model
+
+import (
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestConvertible(t *testing.T) {
+	assert.True(t, convertible(protoreflect.BoolKind, pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING))
+	assert.False(t, convertible(protoreflect.StringKind, pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER))
+	assert.True(t, convertible(protoreflect.EnumKind, pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING))
+	assert.False(t, convertible(protoreflect.EnumKind, pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE))
+	assert.True(t, convertible(protoreflect.Uint64Kind, pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE))
+	assert.False(t, convertible(protoreflect.Int64Kind, pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN))
+	assert.False(t, convertible(protoreflect.BytesKind, pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING))
+	assert.True(t, convertible(protoreflect.Sint32Kind, pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER))
+	assert.False(t, convertible(protoreflect.Uint32Kind, pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER))
+	assert.False(t, convertible(protoreflect.Int64Kind, pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER))
+}
+
+func TestAttributeConversionRender(t *testing.T) {
+	file := newTestGeneratedFile(t)
+
+	tests := []struct {
+		name       string
+		conversion attributeConversion
+		goType     string
+		expected   string
+	}{
+		{
+			name:       "string is used as is",
+			conversion: attributeConversion{Kind: protoreflect.StringKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			goType:     "string",
+			expected:   "v",
+		},
+		{
+			name:       "enum becomes an integer",
+			conversion: attributeConversion{Kind: protoreflect.EnumKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER},
+			goType:     "int32",
+			expected:   "int32(v)",
+		},
+		{
+			name:       "enum is named",
+			conversion: attributeConversion{Kind: protoreflect.EnumKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			goType:     "string",
+			expected:   "v.String()",
+		},
+		{
+			name:       "float becomes a double",
+			conversion: attributeConversion{Kind: protoreflect.FloatKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE},
+			goType:     "float64",
+			expected:   "float64(v)",
+		},
+		{
+			name:       "bool is formatted as a string",
+			conversion: attributeConversion{Kind: protoreflect.BoolKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			goType:     "string",
+			expected:   "strconv.FormatBool(v)",
+		},
+		{
+			name:       "double is formatted as a string",
+			conversion: attributeConversion{Kind: protoreflect.DoubleKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			goType:     "string",
+			expected:   "strconv.FormatFloat(float64(v), 'g', -1, 64)",
+		},
+		{
+			name:       "uint64 is formatted as a string",
+			conversion: attributeConversion{Kind: protoreflect.Uint64Kind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			goType:     "string",
+			expected:   "strconv.FormatUint(v, 10)",
+		},
+		{
+			name: "converter in the generated package",
+			conversion: attributeConversion{
+				Kind:      protoreflect.MessageKind,
+				Converter: &protogen.GoIdent{GoName: "labelValue"},
+			},
+			goType:   "any",
+			expected: "labelValue(v)",
+		},
+		{
+			name: "converter in another package",
+			conversion: attributeConversion{
+				Kind:      protoreflect.MessageKind,
+				Converter: parseGoIdent("example.com/authz/attributes.Label"),
+			},
+			goType:   "any",
+			expected: "attributes.Label(v)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.goType, tt.conversion.GoType())
+			assert.Equal(t, tt.expected, tt.conversion.Render(file, "v"))
+		})
+	}
+}
+
+func TestAttributeConversionRenderOptionalEnum(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	conversion := attributeConversion{Kind: protoreflect.EnumKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING}
+
+	assert.Equal(t, "(*resource.Status).String()", conversion.Render(file, "*resource.Status"))
+}
+
+func TestAttributeConversionRenderWellKnown(t *testing.T) {
+	file := newTestGeneratedFile(t)
+
+	tests := []struct {
+		name       string
+		conversion attributeConversion
+		goType     string
+		expected   string
+	}{
+		{
+			name: "wrapper is unwrapped",
+			conversion: attributeConversion{
+				Kind:   protoreflect.StringKind,
+				Type:   pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				Unwrap: true,
+			},
+			goType:   "string",
+			expected: "v.GetValue()",
+		},
+		{
+			name: "wrapper is unwrapped before converting",
+			conversion: attributeConversion{
+				Kind:   protoreflect.Int64Kind,
+				Type:   pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+				Unwrap: true,
+			},
+			goType:   "float64",
+			expected: "float64(v.GetValue())",
+		},
+		{
+			name: "timestamp is unix seconds",
+			conversion: attributeConversion{
+				Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+				WellKnown: wellKnownAttributes["google.protobuf.Timestamp"],
+			},
+			goType:   "float64",
+			expected: "float64(v.GetSeconds())",
+		},
+		{
+			name: "timestamp is formatted",
+			conversion: attributeConversion{
+				Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				WellKnown: wellKnownAttributes["google.protobuf.Timestamp"],
+			},
+			goType:   "string",
+			expected: "v.AsTime().Format(time.RFC3339)",
+		},
+		{
+			name: "duration is seconds",
+			conversion: attributeConversion{
+				Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+				WellKnown: wellKnownAttributes["google.protobuf.Duration"],
+			},
+			goType:   "float64",
+			expected: "v.AsDuration().Seconds()",
+		},
+		{
+			name: "struct is json",
+			conversion: attributeConversion{
+				Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				WellKnown: wellKnownAttributes["google.protobuf.Struct"],
+			},
+			goType:   "string",
+			expected: "protojson.Format(v)",
+		},
+		{
+			name: "value is read as the chosen type",
+			conversion: attributeConversion{
+				Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN,
+				WellKnown: wellKnownAttributes["google.protobuf.Value"],
+			},
+			goType:   "bool",
+			expected: "v.GetBoolValue()",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.False(t, tt.conversion.IsIdentity())
+			assert.Equal(t, tt.goType, tt.conversion.GoType())
+			assert.Equal(t, tt.expected, tt.conversion.Render(file, "v"))
+		})
+	}
+}
+
+func TestAttributeConversionSpread(t *testing.T) {
+	fieldMask := attributeConversion{
+		Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+		WellKnown: wellKnownAttributes["google.protobuf.FieldMask"],
+	}
+	assert.True(t, fieldMask.IsSpread())
+	assert.Equal(t, "...", spread(&fieldMask))
+
+	timestamp := attributeConversion{
+		Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+		WellKnown: wellKnownAttributes["google.protobuf.Timestamp"],
+	}
+	assert.False(t, timestamp.IsSpread())
+	assert.Equal(t, "", spread(&timestamp))
+}
+
+func TestAttributeConversionZero(t *testing.T) {
+	integer := attributeConversion{Kind: protoreflect.EnumKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER}
+	assert.Equal(t, "int32(0)", integer.Zero(false))
+	assert.Equal(t, "[]int32{}", integer.Zero(true))
+	assert.Equal(t, "v != 0", integer.NonZero("v"))
+
+	boolean := attributeConversion{Kind: protoreflect.BoolKind, Type: pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN}
+	assert.Equal(t, "false", boolean.Zero(false))
+	assert.Equal(t, "v", boolean.NonZero("v"))
+
+	fieldMask := attributeConversion{
+		Type:      pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING,
+		WellKnown: wellKnownAttributes["google.protobuf.FieldMask"],
+	}
+	assert.Equal(t, "[]string{}", fieldMask.Zero(false))
+	assert.Equal(t, "len(v) > 0", fieldMask.NonZero("v"))
+
+	converted := attributeConversion{Kind: protoreflect.MessageKind, Converter: &protogen.GoIdent{GoName: "labelValue"}}
+	assert.Equal(t, "", converted.Zero(false))
+	assert.Equal(t, "v != nil", converted.NonZero("v"))
+}
+
+func TestAttributeConversionLiteral(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute pluginv1.AttributeType
+		value     string
+		expected  string
+		wantErr   bool
+	}{
+		{name: "boolean", attribute: pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN, value: "true", expected: "true"},
+		{name: "string is quoted", attribute: pluginv1.AttributeType_ATTRIBUTE_TYPE_STRING, value: `say "hi"`, expected: `"say \"hi\""`},
+		{name: "integer", attribute: pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER, value: "-42", expected: "int32(-42)"},
+		{name: "double", attribute: pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE, value: "2.5", expected: "float64(2.5)"},
+		{name: "not a boolean", attribute: pluginv1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN, value: "yes", wantErr: true},
+		{name: "integer overflows", attribute: pluginv1.AttributeType_ATTRIBUTE_TYPE_INTEGER, value: "3000000000", wantErr: true},
+		{name: "double isn't finite", attribute: pluginv1.AttributeType_ATTRIBUTE_TYPE_DOUBLE, value: "NaN", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conversion := attributeConversion{Type: tt.attribute}
+			literal, err := conversion.Literal(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, literal)
+		})
+	}
+
+	converted := attributeConversion{Converter: &protogen.GoIdent{GoName: "labelValue"}}
+	_, err := converted.Literal("x")
+	assert.Error(t, err)
+}
+
+func TestParseGoIdent(t *testing.T) {
+	assert.Equal(t, &protogen.GoIdent{GoName: "labelValue"}, parseGoIdent("labelValue"))
+	assert.Equal(t,
+		&protogen.GoIdent{GoName: "Format", GoImportPath: "google.golang.org/protobuf/encoding/prototext"},
+		parseGoIdent("google.golang.org/protobuf/encoding/prototext.Format"),
+	)
+}
+
+func TestAttributeConversionMap(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	board := newTestMessage(t, "Board")
+
+	_, err := newAttributeConversion(board.Fields[1])
+	assert.EqualError(t, err, "map attributes must set attribute_converter, which is called with each key and value")
+
+	conversion := attributeConversion{Kind: protoreflect.MessageKind, Converter: &protogen.GoIdent{GoName: "tagValue"}}
+	assert.Equal(t, "tagValue(k, v)", conversion.RenderEntry(file, "k", "v"))
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	permifyv1 "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// permifytestPackage populates the requests of generated benchmarks.
+const permifytestPackage = protogen.GoImportPath("github.com/nrf110/protoc-gen-connectrpc-permify/permifytest")
+
+// GenerateBenchmarks generates a benchmark of GetChecks for the request of each method in file, reporting its
+// allocations. Requests are populated with permifytest.Populate, so the checks of every resource they hold are built.
+// When options deduplicate checks, each checked method also gets a test that repeating the resources of its request
+// leaves the same checks, in the same order.
+func GenerateBenchmarks(plugin *protogen.Plugin, file *protogen.File, options *Options) {
+	gen := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+"_permit_test.go", file.GoImportPath)
+	gen.P("package " + file.GoPackageName)
+	gen.P("")
+
+	benchmark := gen.QualifiedGoIdent(protogen.GoIdent{GoName: "B", GoImportPath: "testing"})
+	populate := gen.QualifiedGoIdent(protogen.GoIdent{GoName: "Populate", GoImportPath: permifytestPackage})
+	for _, service := range file.Services {
+		for _, method := range service.Methods {
+			gen.P("func Benchmark", service.GoName, method.GoName, "GetChecks(b *", benchmark, ") {")
+			gen.P(util.Indent(1), "req := &", gen.QualifiedGoIdent(method.Input.GoIdent), "{}")
+			gen.P(util.Indent(1), populate, "(req)")
+			gen.P(util.Indent(1), "b.ReportAllocs()")
+			gen.P(util.Indent(1), "for b.Loop() {")
+			gen.P(util.Indent(2), "req.GetChecks()")
+			gen.P(util.Indent(1), "}")
+			gen.P("}")
+			gen.P()
+
+			access, err := findAccess(util.GetBoolExtension(method.Desc, permifyv1.E_Public), method.Desc.Options())
+			if options.DeduplicateChecks && err == nil && access == pluginv1.AccessMode_ACCESS_MODE_CHECKED {
+				generateDeduplicationTest(gen, service, method)
+			}
+		}
+	}
+}
+
+// generateDeduplicationTest generates a test that a request holding each of its resources twice gets the checks of
+// the request holding them once.
+func generateDeduplicationTest(gen *protogen.GeneratedFile, service *protogen.Service, method *protogen.Method) {
+	test := gen.QualifiedGoIdent(protogen.GoIdent{GoName: "T", GoImportPath: "testing"})
+	populate := gen.QualifiedGoIdent(protogen.GoIdent{GoName: "Populate", GoImportPath: permifytestPackage})
+	repeat := gen.QualifiedGoIdent(protogen.GoIdent{GoName: "Repeat", GoImportPath: permifytestPackage})
+	deepEqual := gen.QualifiedGoIdent(protogen.GoIdent{GoName: "DeepEqual", GoImportPath: "reflect"})
+
+	gen.P("func Test", service.GoName, method.GoName, "DeduplicatesChecks(t *", test, ") {")
+	gen.P(util.Indent(1), "req := &", gen.QualifiedGoIdent(method.Input.GoIdent), "{}")
+	gen.P(util.Indent(1), populate, "(req)")
+	gen.P(util.Indent(1), "checks := req.GetChecks().Checks")
+	gen.P(util.Indent(1), repeat, "(req)")
+	gen.P(util.Indent(1), "if repeated := req.GetChecks().Checks; !", deepEqual, "(repeated, checks) {")
+	gen.P(util.Indent(2), `t.Errorf("GetChecks() of the repeated request = %v, want %v", repeated, checks)`)
+	gen.P(util.Indent(1), "}")
+	gen.P("}")
+	gen.P()
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"strconv"
+
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// DenyReasonAttribute is the attribute carrying the reason on the check generated by renderDeny.
+const DenyReasonAttribute = "deny_reason"
+
+// renderDeny returns a CheckConfig that can never pass. Its only check names neither an entity type nor a
+// permission, which Permify rejects, so the interceptor fails closed without relying on any particular relationship
+// data. The reason is attached as an attribute so that it shows up wherever the check is logged.
+func renderDeny(file *protogen.GeneratedFile, nestingLevel int, reason string) {
+	file.P(util.Indent(nestingLevel), "return pkg.CheckConfig {")
+	file.P(util.Indent(nestingLevel+1), "IsPublic: false,")
+	file.P(util.Indent(nestingLevel+1), "Checks: []pkg.Check{")
+	file.P(util.Indent(nestingLevel+2), "{")
+	file.P(util.Indent(nestingLevel+3), "Entity: &pkg.Resource {")
+	file.P(util.Indent(nestingLevel+4), "Attributes: map[string]any{")
+	file.P(util.Indent(nestingLevel+5), strconv.Quote(DenyReasonAttribute), ": ", strconv.Quote(reason), ",")
+	file.P(util.Indent(nestingLevel+4), "},")
+	file.P(util.Indent(nestingLevel+3), "},")
+	file.P(util.Indent(nestingLevel+2), "},")
+	file.P(util.Indent(nestingLevel+1), "},")
+	file.P(util.Indent(nestingLevel), "}")
+}
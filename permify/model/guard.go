@@ -0,0 +1,74 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+)
+
+// TenantGuard is checked on each distinct tenant of a method's checks, ahead of the checks themselves, so that the
+// caller must belong to a tenant before anything in it is checked.
+type TenantGuard struct {
+	// Type is the tenant's entity type, whose id is the tenant id.
+	Type       string
+	Permission string
+}
+
+// parseTenantGuard parses a guard written as type#permission, such as tenant#member.
+func parseTenantGuard(value string) (*TenantGuard, error) {
+	entityType, permission, _ := strings.Cut(value, "#")
+	if entityType == "" || permission == "" {
+		return nil, fmt.Errorf("unknown tenant_guard %q, expected type#permission such as tenant#member", value)
+	}
+	return &TenantGuard{Type: entityType, Permission: permission}, nil
+}
+
+// newTenantGuard reads the tenant_guard file option. Setting neither field turns the guard off, which it reports
+// with a nil guard.
+func newTenantGuard(annotation *pluginv1.TenantGuard) (*TenantGuard, error) {
+	switch {
+	case annotation.GetType() == "" && annotation.GetPermission() == "":
+		return nil, nil
+	case annotation.GetType() == "" || annotation.GetPermission() == "":
+		return nil, errors.New("a type and a permission are both required")
+	}
+	return &TenantGuard{Type: annotation.GetType(), Permission: annotation.GetPermission()}, nil
+}
+
+func (guard *TenantGuard) String() string {
+	return guard.Type + "#" + guard.Permission
+}
+
+// noTenantReason is the deny reason of a guarded request whose checks have no tenant to check the guard on.
+const noTenantReason = "request has no tenant to check the tenant guard on"
+
+// generateTenantGuard prepends a check of the guard to the checks, for each distinct tenant in the order the tenants
+// first appear. A request without checks has no tenant to guard, and is denied rather than passing unguarded.
+func (method *Method) generateTenantGuard() {
+	file := method.file
+	guard := method.options.TenantGuard
+	file.P(util.Indent(1), "tenants := make(map[string]struct{}, 1)")
+	file.P(util.Indent(1), "guards := make([]pkg.Check, 0, 1)")
+	file.P(util.Indent(1), "for _, check := range checks {")
+	file.P(util.Indent(2), "if _, ok := tenants[check.TenantID]; ok {")
+	file.P(util.Indent(3), "continue")
+	file.P(util.Indent(2), "}")
+	file.P(util.Indent(2), "tenants[check.TenantID] = struct{}{}")
+	file.P(util.Indent(2), "guards = append(guards, pkg.Check {")
+	file.P(util.Indent(3), "TenantID: check.TenantID,")
+	file.P(util.Indent(3), "Permission: ", strconv.Quote(guard.Permission), ",")
+	file.P(util.Indent(3), "Entity: &pkg.Resource {")
+	file.P(util.Indent(4), "Type: ", strconv.Quote(guard.Type), ",")
+	file.P(util.Indent(4), "ID: check.TenantID,")
+	file.P(util.Indent(3), "},")
+	file.P(util.Indent(2), "})")
+	file.P(util.Indent(1), "}")
+	file.P(util.Indent(1), "if len(guards) == 0 {")
+	renderDeny(file, 2, noTenantReason)
+	file.P(util.Indent(1), "}")
+	file.P(util.Indent(1), "checks = append(guards, checks...)")
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTenantGuard(t *testing.T) {
+	guard, err := parseTenantGuard("tenant#member")
+	require.NoError(t, err)
+	assert.Equal(t, &TenantGuard{Type: "tenant", Permission: "member"}, guard)
+	assert.Equal(t, "tenant#member", guard.String())
+
+	for _, value := range []string{"tenant", "tenant#", "#member"} {
+		_, err := parseTenantGuard(value)
+		assert.EqualError(t, err, `unknown tenant_guard "`+value+`", expected type#permission such as tenant#member`)
+	}
+}
+
+func TestNewTenantGuard(t *testing.T) {
+	guard, err := newTenantGuard(&pluginv1.TenantGuard{Type: "organization", Permission: "member"})
+	require.NoError(t, err)
+	assert.Equal(t, &TenantGuard{Type: "organization", Permission: "member"}, guard)
+
+	guard, err = newTenantGuard(&pluginv1.TenantGuard{})
+	require.NoError(t, err)
+	assert.Nil(t, guard)
+
+	_, err = newTenantGuard(&pluginv1.TenantGuard{Type: "organization"})
+	assert.EqualError(t, err, "a type and a permission are both required")
+}
+
+func TestMethodGenerateTenantGuard(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	options := NewOptions()
+	options.SingleTenant = true
+	options.TenantGuard = &TenantGuard{Type: "tenant", Permission: "member"}
+	method := &Method{
+		file:        file,
+		options:     options,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "GetDraftRequest",
+		MaxChecks:   5,
+		Resource:    &Resource{file: file, options: options, Type: "Draft", Path: NewRootPathBuilder("req", file).Build()},
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	code := string(content)
+	assert.Contains(t, code, "// GetChecks checks tenant#member on each tenant of its checks, ahead of them, and denies\n// requests without checks.\n")
+	assert.Contains(t, code, `"deny_reason": "request has no tenant to check the tenant guard on",`)
+	assert.Contains(t, code, "guards = append(guards, pkg.Check{\n\t\t\tTenantID:   check.TenantID,\n\t\t\tPermission: \"member\",\n\t\t\tEntity: &pkg.Resource{\n\t\t\t\tType: \"tenant\",\n\t\t\t\tID:   check.TenantID,\n\t\t\t},\n\t\t})")
+
+	// Guards are added once the tenants are known to be the same, and count towards the limit.
+	order := []string{
+		"if check.TenantID != checks[0].TenantID {",
+		"if _, ok := tenants[check.TenantID]; ok {",
+		"if len(guards) == 0 {",
+		"checks = append(guards, checks...)",
+		"if len(checks) > 5 {",
+	}
+	last := -1
+	for _, line := range order {
+		index := strings.Index(code, line)
+		require.Greater(t, index, last, "%q is out of order", line)
+		last = index
+	}
+}
+
+func TestMethodGenerateTenantGuardSkipsUncheckedMethods(t *testing.T) {
+	for _, access := range []pluginv1.AccessMode{
+		pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
+		pluginv1.AccessMode_ACCESS_MODE_AUTHENTICATED,
+		pluginv1.AccessMode_ACCESS_MODE_DENY,
+	} {
+		t.Run(access.String(), func(t *testing.T) {
+			file := newTestGeneratedFile(t)
+			options := NewOptions()
+			options.TenantGuard = &TenantGuard{Type: "tenant", Permission: "member"}
+			method := &Method{file: file, options: options, Access: access, RequestType: "GetDraftRequest"}
+
+			method.Generate()
+
+			content, err := file.Content()
+			require.NoError(t, err)
+			assert.NotContains(t, string(content), "guards")
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// minDepth is the least depth Permify accepts for a check.
+const minDepth = 3
+
+// Metadata is sent to Permify with every check of a method.
+type Metadata struct {
+	// SnapTokenPath leads from the request to the snap token, when it has one.
+	SnapTokenPath *Path
+	// Depth overrides the server's default depth when it's set.
+	Depth int32
+}
+
+// newMetadata reads the snap_token field and depth annotation of pb, or returns nil when it has neither.
+func newMetadata(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Method) *Metadata {
+	snapTokenPath, err := findSingleField(pb.Input, pluginv1.E_SnapToken, NewRootPathBuilder("req", file), "snap token")
+	if err == nil && snapTokenPath != nil {
+		err = validateSnapToken(snapTokenPath.Field)
+	}
+	depth, _ := proto.GetExtension(pb.Desc.Options(), pluginv1.E_Depth).(int32)
+	if err == nil && depth != 0 && depth < minDepth {
+		err = fmt.Errorf("depth must be at least %d, got %d", minDepth, depth)
+	}
+	if err != nil {
+		plugin.Error(fmt.Errorf("method %s in service %s: %w", pb.GoName, pb.Parent.GoName, err))
+		return nil
+	}
+
+	if snapTokenPath == nil && depth == 0 {
+		return nil
+	}
+	return &Metadata{
+		SnapTokenPath: snapTokenPath,
+		Depth:         depth,
+	}
+}
+
+func validateSnapToken(field *protogen.Field) error {
+	switch {
+	case field.Desc.IsList() || field.Desc.IsMap():
+		return fmt.Errorf("snap token %s must be a singular field", field.Desc.FullName())
+	case util.IsOneofField(field):
+		return fmt.Errorf("snap token %s must be outside of a oneof", field.Desc.FullName())
+	case !util.IsIdField(field) || util.ValueKind(field) != protoreflect.StringKind:
+		return fmt.Errorf("snap token %s must be a string or a wrapper of one", field.Desc.FullName())
+	}
+	return nil
+}
+
+// generateMetadata adds GetCheckMetadata, returning the metadata of the checks of GetChecks.
+func (method *Method) generateMetadata() {
+	file := method.file
+	ident := func(name string) string {
+		return file.QualifiedGoIdent(protogen.GoIdent{GoName: name, GoImportPath: permifyBasePackage})
+	}
+	metadata := method.Metadata
+
+	file.P("// GetCheckMetadata returns the metadata sent to Permify with every check returned by GetChecks.")
+	file.P("func (req *", method.RequestType, ") GetCheckMetadata() *", ident("PermissionCheckRequestMetadata"), " {")
+	if metadata.SnapTokenPath != nil {
+		file.P(util.Indent(1), "var snapToken string")
+		file.P(util.Indent(1), "if ", strings.Join(idConditions(metadata.SnapTokenPath), " && "), " {")
+		file.P(util.Indent(2), "snapToken = ", renderIdValue(file, metadata.SnapTokenPath.Path, metadata.SnapTokenPath.Field))
+		file.P(util.Indent(1), "}")
+	}
+	file.P(util.Indent(1), "return ", ident("PermissionCheckRequestMetadata_builder"), "{")
+	if metadata.SnapTokenPath != nil {
+		file.P(util.Indent(2), "SnapToken: snapToken,")
+	}
+	if metadata.Depth != 0 {
+		file.P(util.Indent(2), "Depth: ", metadata.Depth, ",")
+	}
+	file.P(util.Indent(1), "}.Build()")
+	file.P("}")
+}
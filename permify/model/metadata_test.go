@@ -0,0 +1,18 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSnapToken(t *testing.T) {
+	draft := newTestMessage(t, "Draft")
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	assert.NoError(t, validateSnapToken(draft.Fields[0]))
+	assert.NoError(t, validateSnapToken(request.Fields[1]))
+	assert.ErrorContains(t, validateSnapToken(draft.Fields[1]), "test.v1.Draft.team_id must be a string or a wrapper of one")
+	assert.ErrorContains(t, validateSnapToken(draft.Fields[2]), "test.v1.Draft.tags must be a singular field")
+	assert.ErrorContains(t, validateSnapToken(request.Fields[3]), "test.v1.CreateDraftRequest.folder_id must be outside of a oneof")
+}
@@ -4,47 +4,194 @@ import (
 	"fmt"
 
 	permifyv1 "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
 	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 )
 
 type Method struct {
 	file        *protogen.GeneratedFile
-	IsPublic    bool
+	options     *Options
+	Access      pluginv1.AccessMode
 	Permission  string
 	RequestType string
 	Resource    *Resource
+	// PermissionField selects the permission from the request in place of Permission when it's set.
+	PermissionField *PermissionField
+	// Permissions are each checked in place of Permission when a method has more than one, combined by Combinator.
+	Permissions []string
+	Combinator  pluginv1.PermissionCombinator
+	// MaxChecks denies requests producing more checks than this, when it's not 0.
+	MaxChecks uint
+	// ContextPaths are the request fields sent to Permify as check context data, by name.
+	ContextPaths map[string]*Path
+	// ContextualTuples are the relationships sent to Permify with every check.
+	ContextualTuples []*ContextualTuple
+	// Subject is checked in place of the caller when it's set.
+	Subject *Subject
+	// Metadata is sent to Permify with every check when it's set.
+	Metadata *Metadata
 }
 
-func NewMethod(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Method) *Method {
-	isPublic := util.GetBoolExtension(pb.Desc, permifyv1.E_Public)
+func NewMethod(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Method, options *Options) *Method {
+	access, err := findAccess(util.GetBoolExtension(pb.Desc, permifyv1.E_Public), pb.Desc.Options())
+	if err != nil {
+		plugin.Error(fmt.Errorf("method %s in service %s: %w", pb.GoName, pb.Parent.GoName, err))
+	}
 	hasPermission, permission := util.GetStringExtension(pb.Desc, permifyv1.E_Permission)
 
-	if !isPublic && !hasPermission {
+	permissionField := newPermissionField(plugin, file, pb)
+	permissions, combinator, err := findPermissions(pb.Desc.Options())
+	if err != nil {
+		plugin.Error(fmt.Errorf("method %s in service %s: %w", pb.GoName, pb.Parent.GoName, err))
+	}
+
+	switch {
+	case hasPermission && len(permissions) > 0:
+		plugin.Error(fmt.Errorf("method %s in service %s can't specify both a permission and permissions", pb.GoName, pb.Parent.GoName))
+	case permissionField != nil && len(permissions) > 0:
+		plugin.Error(fmt.Errorf("method %s in service %s can't specify both permissions and a permission field", pb.GoName, pb.Parent.GoName))
+	case len(permissions) == 1:
+		hasPermission, permission, permissions = true, permissions[0], nil
+	}
+
+	checked := access == pluginv1.AccessMode_ACCESS_MODE_CHECKED
+	anyPermission := hasPermission || len(permissions) > 0 || permissionField != nil
+	if checked && !anyPermission {
 		plugin.Error(fmt.Errorf("method %s in service %s must specify a permission", pb.GoName, pb.Parent.GoName))
 	}
+	if !checked && anyPermission {
+		plugin.Error(fmt.Errorf("method %s in service %s can't specify a permission with access mode %s", pb.GoName, pb.Parent.GoName, access))
+	}
+	if hasPermission && permissionField != nil {
+		plugin.Error(fmt.Errorf("method %s in service %s can't specify both a permission and a permission field", pb.GoName, pb.Parent.GoName))
+	}
 
-	resource := NewResource(plugin, file, pb.Input)
-	if !isPublic && resource == nil {
+	resource := NewResource(plugin, file, pb.Input, options)
+	if checked && resource == nil {
 		plugin.Error(fmt.Errorf("method %s in service %s must specify a resource", pb.GoName, pb.Parent.GoName))
 	}
+	maxChecks := options.MaxChecks
+	if methodMax, _ := proto.GetExtension(pb.Desc.Options(), pluginv1.E_MaxChecks).(uint32); methodMax > 0 {
+		maxChecks = uint(methodMax)
+	}
+	if maxChecks > 0 {
+		util.Log.Printf("limiting %s to %d checks", pb.GoName, maxChecks)
+	}
+	if resource != nil {
+		resource.configureChecks(max(len(permissions), 1), maxChecks)
+		if checked && len(permissions) > 1 && options.TenantGuard != nil {
+			// The guard's checks would break up the consecutive checks of each entity's permissions
+			plugin.Error(fmt.Errorf("method %s in service %s can't specify permissions with the tenant_guard %s",
+				pb.GoName, pb.Parent.GoName, options.TenantGuard))
+		}
+		if parentPermission := resource.parentPermission(); len(permissions) > 1 && parentPermission != "" {
+			plugin.Error(fmt.Errorf("method %s in service %s can't specify permissions when a parent_resource has its own permission %s",
+				pb.GoName, pb.Parent.GoName, parentPermission))
+		}
+		if checked && !options.ContextChecks && resource.usesResolvers() {
+			plugin.Error(fmt.Errorf("method %s in service %s has resolvers, which need the context_checks parameter and an interceptor calling GetChecksContext",
+				pb.GoName, pb.Parent.GoName))
+		}
+		if checked {
+			resource.logTenantSource(pb.GoName)
+			if !resource.hasTenantSource() {
+				util.Log.Printf("warning: no resource of %s in service %s has a tenant_id field, inherited tenant or tenant_resolver, so every check is in the default tenant",
+					pb.GoName, pb.Parent.GoName)
+			}
+		}
+	}
 
 	method := Method{
-		file:        file,
-		IsPublic:    isPublic,
-		Permission:  permission,
-		RequestType: pb.Input.GoIdent.GoName,
-		Resource:    resource,
+		file:             file,
+		options:          options,
+		Access:           access,
+		Permission:       permission,
+		PermissionField:  permissionField,
+		Permissions:      permissions,
+		Combinator:       combinator,
+		MaxChecks:        maxChecks,
+		RequestType:      pb.Input.GoIdent.GoName,
+		Resource:         resource,
+		ContextPaths:     findAttributes(plugin, pb.Input, pluginv1.E_ContextAttributeName, NewRootPathBuilder("req", file), make(map[string]*Path)),
+		ContextualTuples: newContextualTuples(plugin, file, pb),
+		Subject:          newSubject(plugin, file, pb),
+		Metadata:         newMetadata(plugin, file, pb),
 	}
 
 	return &method
 }
 
 func (method *Method) Generate() {
+	if method.Access == pluginv1.AccessMode_ACCESS_MODE_CHECKED && method.Resource != nil && method.Resource.usesResolvers() {
+		method.generateChecksContext()
+	} else {
+		method.generateGetChecks()
+	}
+
+	if method.Access == pluginv1.AccessMode_ACCESS_MODE_INTERNAL {
+		method.file.P()
+		method.generateAccessMode()
+	}
+
+	if len(method.Permissions) > 0 {
+		method.file.P()
+		method.generateCombinator()
+	}
+	if len(method.ContextPaths) > 0 {
+		method.file.P()
+		method.generateContext()
+	}
+	if len(method.ContextualTuples) > 0 {
+		method.file.P()
+		method.generateTuples()
+	}
+	if method.Subject != nil {
+		method.file.P()
+		method.generateSubject()
+	}
+	if method.Metadata != nil {
+		method.file.P()
+		method.generateMetadata()
+	}
+}
+
+// generateGetChecks generates GetChecks according to the method's access mode.
+func (method *Method) generateGetChecks() {
+	if method.Access == pluginv1.AccessMode_ACCESS_MODE_CHECKED {
+		var comments []string
+		if method.Resource != nil {
+			comments = method.Resource.sourceComments()
+		}
+		if len(comments) > 0 {
+			method.file.P("// GetChecks returns the checks of the request.")
+			for _, comment := range comments {
+				method.file.P("// ", comment)
+			}
+		}
+		if method.MaxChecks > 0 {
+			method.file.P("// GetChecks denies requests that would produce more than ", method.MaxChecks, " checks.")
+		}
+		if method.options.SingleTenant && method.Resource != nil {
+			method.file.P("// GetChecks denies requests whose checks aren't all in the same tenant.")
+		}
+		if method.options.TenantGuard != nil && method.Resource != nil {
+			method.file.P("// GetChecks checks ", method.options.TenantGuard, " on each tenant of its checks, ahead of them, and denies")
+			method.file.P("// requests without checks.")
+		}
+	}
 	method.file.P("func (req *", method.RequestType, ") GetChecks() pkg.CheckConfig {")
-	if method.IsPublic {
+	switch method.Access {
+	case pluginv1.AccessMode_ACCESS_MODE_PUBLIC:
 		method.generatePublic()
-	} else {
+	case pluginv1.AccessMode_ACCESS_MODE_AUTHENTICATED:
+		method.generateAuthenticated()
+	case pluginv1.AccessMode_ACCESS_MODE_DENY:
+		renderDeny(method.file, 1, "method denies every call")
+	case pluginv1.AccessMode_ACCESS_MODE_INTERNAL:
+		renderDeny(method.file, 1, "method is internal")
+	default:
 		method.generateChecks()
 	}
 	method.file.P("}")
@@ -60,14 +207,87 @@ func (method *Method) generatePublic() {
 
 func (method *Method) generateChecks() {
 	file := method.file
-	file.P(util.Indent(1), `permission := "`, method.Permission, `"`)
-	file.P(util.Indent(1), "var checks []pkg.Check")
-	if method.Resource != nil {
+	if method.PermissionField != nil {
+		method.PermissionField.render(file, 1)
+	} else if len(method.Permissions) > 0 {
+		file.P(util.Indent(1), "permissions := []string{", renderStrings(method.Permissions), "}")
+	} else {
+		file.P(util.Indent(1), `permission := "`, method.Permission, `"`)
+	}
+	if method.Resource == nil {
+		file.P(util.Indent(1), "var checks []pkg.Check")
+	} else if capacity := method.Resource.checkCapacity(); capacity == "" {
+		file.P(util.Indent(1), "var checks []pkg.Check")
+		method.Resource.Generate(1)
+	} else {
+		file.P(util.Indent(1), "checks := make([]pkg.Check, 0, ", capacity, ")")
 		method.Resource.Generate(1)
 	}
+	if method.options.DeduplicateChecks {
+		method.generateDeduplication()
+	}
+	if method.options.SingleTenant && method.Resource != nil {
+		method.generateSingleTenant()
+	}
+	if method.options.TenantGuard != nil && method.Resource != nil {
+		method.generateTenantGuard()
+	}
+	if method.MaxChecks > 0 {
+		// Resources checked more than once, for several permissions or with their parent, can still exceed the limit
+		file.P(util.Indent(1), "if len(checks) > ", method.MaxChecks, " {")
+		renderDeny(file, 2, maxChecksReason(method.MaxChecks))
+		file.P(util.Indent(1), "}")
+	}
 
 	file.P(util.Indent(1), "return pkg.CheckConfig {")
 	file.P(util.Indent(2), "IsPublic: false,")
 	file.P(util.Indent(2), "Checks: checks,")
 	file.P(util.Indent(1), "}")
 }
+
+// generateContext adds GetCheckContext, returning the context data to send to Permify with the checks of GetChecks.
+func (method *Method) generateContext() {
+	file := method.file
+	file.P("// GetCheckContext returns the data sent to Permify as the context of every check returned by GetChecks.")
+	file.P("func (req *", method.RequestType, ") GetCheckContext() map[string]any {")
+	renderer := &attributeRenderer{file: file, options: method.options, variable: "checkContext"}
+	renderer.render(method.ContextPaths, 1)
+	file.P(util.Indent(1), "return checkContext")
+	file.P("}")
+}
+
+// generateDeduplication drops the checks identical to an earlier one, in place, so the remaining checks keep the
+// order they were produced in. Checks are identical when they have the same tenant, entity type, id, permission and
+// attributes, compared by their Go syntax representation, which fmt prints with sorted map keys. Only checks with
+// attributes pay for formatting them.
+func (method *Method) generateDeduplication() {
+	file := method.file
+	sprintf := file.QualifiedGoIdent(protogen.GoIdent{GoName: "Sprintf", GoImportPath: "fmt"})
+	file.P(util.Indent(1), "seen := make(map[[5]string]struct{}, len(checks))")
+	file.P(util.Indent(1), "unique := checks[:0]")
+	file.P(util.Indent(1), "for _, check := range checks {")
+	file.P(util.Indent(2), "key := [5]string{check.TenantID, check.Entity.Type, check.Entity.ID, check.Permission}")
+	file.P(util.Indent(2), "if len(check.Entity.Attributes) > 0 {")
+	file.P(util.Indent(3), "key[4] = ", sprintf, `("%#v", check.Entity.Attributes)`)
+	file.P(util.Indent(2), "}")
+	file.P(util.Indent(2), "if _, ok := seen[key]; ok {")
+	file.P(util.Indent(3), "continue")
+	file.P(util.Indent(2), "}")
+	file.P(util.Indent(2), "seen[key] = struct{}{}")
+	file.P(util.Indent(2), "unique = append(unique, check)")
+	file.P(util.Indent(1), "}")
+	file.P(util.Indent(1), "checks = unique")
+}
+
+// singleTenantReason is the deny reason of a request whose checks span several tenants.
+const singleTenantReason = "request spans more than one tenant"
+
+// generateSingleTenant denies the request unless every check is in the tenant of the first one.
+func (method *Method) generateSingleTenant() {
+	file := method.file
+	file.P(util.Indent(1), "for _, check := range checks {")
+	file.P(util.Indent(2), "if check.TenantID != checks[0].TenantID {")
+	renderDeny(file, 3, singleTenantReason)
+	file.P(util.Indent(2), "}")
+	file.P(util.Indent(1), "}")
+}
@@ -1,9 +1,12 @@
 package model
 
 import (
+	"strings"
 	"testing"
 
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
@@ -17,7 +20,7 @@ func TestMethodStruct(t *testing.T) {
 
 	method := &Method{
 		file:        mockFile,
-		IsPublic:    false,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 		Permission:  "read",
 		RequestType: "UserRequest",
 		Resource:    mockResource,
@@ -25,7 +28,7 @@ func TestMethodStruct(t *testing.T) {
 
 	// Verify all fields are set correctly
 	assert.Equal(t, mockFile, method.file)
-	assert.False(t, method.IsPublic)
+	assert.False(t, method.IsPublic())
 	assert.Equal(t, "read", method.Permission)
 	assert.Equal(t, "UserRequest", method.RequestType)
 	assert.Equal(t, mockResource, method.Resource)
@@ -37,13 +40,13 @@ func TestMethodPublicMethod(t *testing.T) {
 
 	publicMethod := &Method{
 		file:        mockFile,
-		IsPublic:    true,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 		Permission:  "", // Public methods don't need permissions
 		RequestType: "PublicRequest",
 		Resource:    nil, // Public methods don't need resources
 	}
 
-	assert.True(t, publicMethod.IsPublic)
+	assert.True(t, publicMethod.IsPublic())
 	assert.Empty(t, publicMethod.Permission)
 	assert.Nil(t, publicMethod.Resource)
 }
@@ -58,13 +61,13 @@ func TestMethodProtectedMethod(t *testing.T) {
 
 	protectedMethod := &Method{
 		file:        mockFile,
-		IsPublic:    false,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 		Permission:  "write",
 		RequestType: "DocumentRequest",
 		Resource:    mockResource,
 	}
 
-	assert.False(t, protectedMethod.IsPublic)
+	assert.False(t, protectedMethod.IsPublic())
 	assert.Equal(t, "write", protectedMethod.Permission)
 	assert.NotNil(t, protectedMethod.Resource)
 	assert.Equal(t, "Document", protectedMethod.Resource.Type)
@@ -83,7 +86,7 @@ func TestMethodGenerate(t *testing.T) {
 		{
 			name: "public method logic",
 			method: &Method{
-				IsPublic:    true,
+				Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 				RequestType: "PublicRequest",
 			},
 			shouldCallGen: false, // We'll test the logic, not the generation
@@ -92,7 +95,7 @@ func TestMethodGenerate(t *testing.T) {
 		{
 			name: "protected method logic",
 			method: &Method{
-				IsPublic:    false,
+				Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 				Permission:  "read",
 				RequestType: "UserRequest",
 				Resource: &Resource{
@@ -107,11 +110,11 @@ func TestMethodGenerate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Test the method structure instead of actual generation
-			if tt.method.IsPublic {
-				assert.True(t, tt.method.IsPublic, "Public method should have IsPublic=true")
+			if tt.method.IsPublic() {
+				assert.True(t, tt.method.IsPublic(), "Public method should have IsPublic=true")
 				assert.Empty(t, tt.method.Permission, "Public method should not have permission")
 			} else {
-				assert.False(t, tt.method.IsPublic, "Protected method should have IsPublic=false")
+				assert.False(t, tt.method.IsPublic(), "Protected method should have IsPublic=false")
 				assert.NotEmpty(t, tt.method.Permission, "Protected method should have permission")
 			}
 			assert.NotEmpty(t, tt.method.RequestType, "Method should have request type")
@@ -122,12 +125,12 @@ func TestMethodGenerate(t *testing.T) {
 func TestMethodGeneratePublic(t *testing.T) {
 	// Test the generatePublic method logic without actual code generation
 	method := &Method{
-		IsPublic:    true,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 		RequestType: "PublicRequest",
 	}
 
 	// Verify public method characteristics
-	assert.True(t, method.IsPublic, "Method should be public")
+	assert.True(t, method.IsPublic(), "Method should be public")
 	assert.Equal(t, "PublicRequest", method.RequestType, "Should have correct request type")
 }
 
@@ -162,7 +165,7 @@ func TestMethodGenerateChecks(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Test the method properties instead of actual generation
 			assert.Equal(t, tt.expected, tt.method.Permission, "Permission should match expected")
-			assert.False(t, tt.method.IsPublic, "Non-public method should have IsPublic=false")
+			assert.False(t, tt.method.IsPublic(), "Non-public method should have IsPublic=false")
 		})
 	}
 }
@@ -171,7 +174,7 @@ func TestMethodValidation(t *testing.T) {
 	// Test method validation scenarios
 	tests := []struct {
 		name        string
-		isPublic    bool
+		access      pluginv1.AccessMode
 		permission  string
 		resource    *Resource
 		expectValid bool
@@ -179,7 +182,7 @@ func TestMethodValidation(t *testing.T) {
 	}{
 		{
 			name:        "valid public method",
-			isPublic:    true,
+			access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 			permission:  "",
 			resource:    nil,
 			expectValid: true,
@@ -187,7 +190,7 @@ func TestMethodValidation(t *testing.T) {
 		},
 		{
 			name:       "valid protected method",
-			isPublic:   false,
+			access:     pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			permission: "read",
 			resource: &Resource{
 				Type: "User",
@@ -197,7 +200,7 @@ func TestMethodValidation(t *testing.T) {
 		},
 		{
 			name:        "invalid - no permission and not public",
-			isPublic:    false,
+			access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			permission:  "",
 			resource:    nil,
 			expectValid: false,
@@ -205,7 +208,7 @@ func TestMethodValidation(t *testing.T) {
 		},
 		{
 			name:        "invalid - no resource for protected method",
-			isPublic:    false,
+			access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			permission:  "write",
 			resource:    nil,
 			expectValid: false,
@@ -216,13 +219,13 @@ func TestMethodValidation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			method := &Method{
-				IsPublic:   tt.isPublic,
+				Access:     tt.access,
 				Permission: tt.permission,
 				Resource:   tt.resource,
 			}
 
 			// Test the logical validation using the method fields
-			isValid := method.IsPublic || (method.Permission != "" && method.Resource != nil)
+			isValid := method.IsPublic() || (method.Permission != "" && method.Resource != nil)
 			assert.Equal(t, tt.expectValid, isValid, tt.description)
 		})
 	}
@@ -248,14 +251,14 @@ func TestMethodPermissionTypes(t *testing.T) {
 		t.Run("permission_"+perm, func(t *testing.T) {
 			method := &Method{
 				file:        mockFile,
-				IsPublic:    false,
+				Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 				Permission:  perm,
 				RequestType: "TestRequest",
 				Resource:    mockResource,
 			}
 
 			assert.Equal(t, perm, method.Permission)
-			assert.False(t, method.IsPublic)
+			assert.False(t, method.IsPublic())
 			assert.NotNil(t, method.Resource)
 		})
 	}
@@ -277,7 +280,7 @@ func TestMethodRequestTypes(t *testing.T) {
 		t.Run("request_type_"+reqType, func(t *testing.T) {
 			method := &Method{
 				file:        mockFile,
-				IsPublic:    true, // Public to avoid needing resource
+				Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC, // Public to avoid needing resource
 				RequestType: reqType,
 			}
 
@@ -301,7 +304,7 @@ func TestMethodResourceAssociation(t *testing.T) {
 		t.Run("resource_"+resource.Type, func(t *testing.T) {
 			method := &Method{
 				file:       mockFile,
-				IsPublic:   false,
+				Access:     pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 				Permission: "read",
 				Resource:   resource,
 			}
@@ -324,7 +327,7 @@ func TestMethodCodeGenerationStructure(t *testing.T) {
 		{
 			name: "public method pattern",
 			method: &Method{
-				IsPublic:    true,
+				Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 				RequestType: "PublicRequest",
 			},
 			expectedPattern: "GetChecks() pkg.CheckConfig",
@@ -333,7 +336,7 @@ func TestMethodCodeGenerationStructure(t *testing.T) {
 		{
 			name: "protected method pattern",
 			method: &Method{
-				IsPublic:    false,
+				Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 				Permission:  "read",
 				RequestType: "UserRequest",
 				Resource:    &Resource{Type: "User"},
@@ -350,7 +353,7 @@ func TestMethodCodeGenerationStructure(t *testing.T) {
 			assert.NotEmpty(t, tt.method.RequestType)
 			assert.Contains(t, tt.expectedPattern, "GetChecks")
 
-			if tt.method.IsPublic {
+			if tt.method.IsPublic() {
 				assert.Empty(t, tt.method.Permission)
 			} else {
 				assert.NotEmpty(t, tt.method.Permission)
@@ -358,3 +361,196 @@ func TestMethodCodeGenerationStructure(t *testing.T) {
 		})
 	}
 }
+
+func TestMethodGenerateContext(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		options:     NewOptions(),
+		Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
+		RequestType: "TransferRequest",
+		ContextPaths: map[string]*Path{
+			"reason": {Path: "req.Reason"},
+			"amount": {Path: "req.Amount"},
+		},
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (req *TransferRequest) GetCheckContext() map[string]any {")
+	assert.Contains(t, string(content), `checkContext["amount"] = req.Amount
+	checkContext["reason"] = req.Reason
+	return checkContext`)
+}
+
+func TestMethodGenerateWithoutContext(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
+		RequestType: "PublicRequest",
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "GetCheckContext")
+}
+
+func TestMethodGenerateMultiplePermissions(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		options:     NewOptions(),
+		RequestType: "TransferOwnershipRequest",
+		Permissions: []string{"write", "transfer"},
+		Combinator:  pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_ALL_OF,
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `permissions := []string{"write", "transfer"}`)
+	assert.NotContains(t, string(content), "permission :=")
+	assert.Contains(t, string(content), "func (req *TransferOwnershipRequest) GetPermissionCombinator() string {\n\treturn \"all_of\"\n}")
+}
+
+func TestMethodGenerateMaxChecks(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		options:     NewOptions(),
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "ListRequest",
+		MaxChecks:   5,
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "// GetChecks denies requests that would produce more than 5 checks.")
+	assert.Contains(t, string(content), "if len(checks) > 5 {")
+	assert.Contains(t, string(content), `"deny_reason": "request has more than 5 checks",`)
+}
+
+func TestMethodGenerateDeduplication(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	options := NewOptions()
+	options.DeduplicateChecks = true
+	method := &Method{
+		file:        file,
+		options:     options,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "ListRequest",
+		MaxChecks:   5,
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	code := string(content)
+	assert.Contains(t, code, "key := [5]string{check.TenantID, check.Entity.Type, check.Entity.ID, check.Permission}\n"+
+		"\t\tif len(check.Entity.Attributes) > 0 {\n\t\t\tkey[4] = fmt.Sprintf(\"%#v\", check.Entity.Attributes)\n\t\t}")
+
+	// The checks are filtered in a single pass over them in order, keeping the first of each, so the remaining
+	// checks keep their relative order. The limit applies to the checks that remain.
+	order := []string{
+		"var checks []pkg.Check",
+		"unique := checks[:0]",
+		"for _, check := range checks {",
+		"if _, ok := seen[key]; ok {",
+		"continue",
+		"seen[key] = struct{}{}",
+		"unique = append(unique, check)",
+		"checks = unique",
+		"if len(checks) > 5 {",
+		"Checks:   checks,",
+	}
+	last := -1
+	for _, line := range order {
+		index := strings.Index(code, line)
+		require.Greater(t, index, last, "%q is out of order", line)
+		last = index
+	}
+}
+
+func TestMethodGenerateWithoutDeduplication(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		options:     NewOptions(),
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "ListRequest",
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "seen")
+	assert.NotContains(t, string(content), `"fmt"`)
+}
+
+func TestMethodGenerateSingleTenant(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	options := NewOptions()
+	options.DeduplicateChecks = true
+	options.SingleTenant = true
+	method := &Method{
+		file:        file,
+		options:     options,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "GetDraftRequest",
+		MaxChecks:   5,
+		Resource:    &Resource{file: file, options: options, Type: "Draft", Path: NewRootPathBuilder("req", file).Build()},
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	code := string(content)
+	assert.Contains(t, code, "// GetChecks denies requests whose checks aren't all in the same tenant.\n")
+
+	// Tenants are compared once duplicates are dropped, and before the limit is applied.
+	order := []string{
+		"checks = unique",
+		"for _, check := range checks {\n\t\tif check.TenantID != checks[0].TenantID {",
+		`"deny_reason": "request spans more than one tenant",`,
+		"if len(checks) > 5 {",
+	}
+	last := -1
+	for _, line := range order {
+		index := strings.Index(code, line)
+		require.Greater(t, index, last, "%q is out of order", line)
+		last = index
+	}
+}
+
+func TestMethodGenerateWithoutSingleTenant(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		options:     NewOptions(),
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "GetDraftRequest",
+		Resource:    &Resource{file: file, options: NewOptions(), Type: "Draft", Path: NewRootPathBuilder("req", file).Build()},
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "checks[0].TenantID")
+}
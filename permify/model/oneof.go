@@ -0,0 +1,164 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// Oneof is a oneof whose cases hold resources. Each case may hold a different resource type, so the generated code
+// switches over the oneof's wrapper types and checks whichever case is set.
+type Oneof struct {
+	file *protogen.GeneratedFile
+	// Name is the field path of the oneof in the request.
+	Name     string
+	GoName   string
+	Required bool
+	// MissingResource is how a request in which no case holding a resource is set is handled, unless the oneof is
+	// required.
+	MissingResource pluginv1.MissingResourcePolicy
+	Cases           []*OneofCase
+}
+
+// OneofCase is a single case of a Oneof, identified by the wrapper type protoc-gen-go generates for it. The path of
+// its resource is rooted at the case's field, and is prefixed with the switch variable when generated.
+type OneofCase struct {
+	GoIdent  protogen.GoIdent
+	Resource *Resource
+}
+
+func findOneofResource(plugin *protogen.Plugin, file *protogen.GeneratedFile, options *Options, pb *protogen.Oneof, path *PathBuilder, ancestry ancestry) *Resource {
+	util.Log.Println("checking oneof", pb.GoName)
+	var cases []*OneofCase
+	for _, field := range pb.Fields {
+		if !util.IsMessage(field) {
+			continue
+		}
+
+		if result := findResourcePath(plugin, file, options, field.Message, NewRootPathBuilder(field.GoName, file), ancestry.oneofCase(path, field)); result != nil {
+			cases = append(cases, &OneofCase{
+				GoIdent:  field.GoIdent,
+				Resource: result,
+			})
+		}
+	}
+
+	if len(cases) == 0 {
+		return nil
+	}
+
+	return &Resource{
+		file:     file,
+		options:  options,
+		Path:     path.Build(),
+		location: ancestry.location,
+		Oneof: &Oneof{
+			file:            file,
+			Name:            joinFieldPath(ancestry.location, path.fieldPath(), string(pb.Desc.Name())),
+			GoName:          pb.GoName,
+			Required:        util.GetBoolExtension(pb.Desc, pluginv1.E_OneofRequired),
+			MissingResource: oneofMissingResource(pb, options),
+			Cases:           cases,
+		},
+	}
+}
+
+// Generate switches over the cases of the oneof held by containerPath. scopes are the variables in scope, which the
+// case's resources extend with the switch variable.
+func (oneof *Oneof) Generate(containerPath string, nestingLevel int, scopes []string) {
+	file := oneof.file
+	oneofPath := fmt.Sprintf("%s.%s", containerPath, oneof.GoName)
+
+	varName := "_"
+	if oneof.usesCase(len(scopes)) {
+		varName = util.VariableName()
+		file.P(util.Indent(nestingLevel), "switch ", varName, " := ", oneofPath, ".(type) {")
+		for _, c := range oneof.Cases {
+			c.Resource.Path.WithPrefix(varName)
+		}
+	} else {
+		file.P(util.Indent(nestingLevel), "switch ", oneofPath, ".(type) {")
+	}
+	for _, c := range oneof.Cases {
+		c.Resource.scopes = append(slices.Clone(scopes), varName)
+	}
+
+	for _, c := range oneof.Cases {
+		file.P(util.Indent(nestingLevel), "case *", file.QualifiedGoIdent(c.GoIdent), ":")
+		c.Resource.Generate(nestingLevel + 1)
+	}
+
+	// Neither an unset oneof nor a case that isn't a resource may leave the request without checks, which would allow it
+	if oneof.Required {
+		file.P(util.Indent(nestingLevel), "case nil:")
+		renderDeny(file, nestingLevel+1, fmt.Sprintf("oneof %s is not set", oneof.Name))
+		file.P(util.Indent(nestingLevel), "default:")
+		renderDeny(file, nestingLevel+1, fmt.Sprintf("oneof %s holds no resource", oneof.Name))
+	} else if oneof.MissingResource != pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_SKIP {
+		file.P(util.Indent(nestingLevel), "default:")
+		oneof.renderMissing(nestingLevel+1, scopes)
+	}
+	file.P(util.Indent(nestingLevel), "}")
+}
+
+// oneofMissingResource returns the missing resource policy of the oneof pb, set by the missing_resource option of the
+// first of its cases that has one, or else by the plugin parameter.
+func oneofMissingResource(pb *protogen.Oneof, options *Options) pluginv1.MissingResourcePolicy {
+	for _, field := range pb.Fields {
+		if policy, _ := proto.GetExtension(field.Desc.Options(), pluginv1.E_MissingResource).(pluginv1.MissingResourcePolicy); policy != pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_UNSPECIFIED {
+			return policy
+		}
+	}
+	return options.MissingResource
+}
+
+// renderMissing handles a request in which the oneof holds no resource, whether it's unset or set to a case that
+// isn't a resource, according to its missing resource policy. Required oneofs are always denied. Otherwise, as for a
+// missing resource, the type of every case is checked with an empty id and the default tenant, or the request is
+// denied. scopes are the variables in scope, where the switch variable isn't bound to any case.
+func (oneof *Oneof) renderMissing(nestingLevel int, scopes []string) {
+	file := oneof.file
+	if oneof.Required {
+		renderDeny(file, nestingLevel, fmt.Sprintf("oneof %s is not set", oneof.Name))
+		return
+	}
+	switch oneof.MissingResource {
+	case pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_SKIP:
+	case pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_DENY:
+		renderDeny(file, nestingLevel, fmt.Sprintf("oneof %s holds no resource", oneof.Name))
+	default:
+		// Cases of the same type are checked once
+		var missing []*Resource
+		for _, c := range oneof.Cases {
+			if !slices.ContainsFunc(missing, func(resource *Resource) bool { return resource.Type == c.Resource.Type }) {
+				missing = append(missing, c.Resource)
+			}
+		}
+		reason := fmt.Sprintf("oneof %s holds no resource", oneof.Name)
+		if len(missing) == 1 {
+			missing[0].renderMissing(nestingLevel, nil, scopes, reason)
+			return
+		}
+		// Each case declares the same variables, so each gets a block of its own
+		for _, resource := range missing {
+			file.P(util.Indent(nestingLevel), "{")
+			resource.renderMissing(nestingLevel+1, nil, scopes, reason)
+			file.P(util.Indent(nestingLevel), "}")
+		}
+	}
+}
+
+// usesCase reports whether any case reads the value held by the oneof, and so needs the switch to bind it. scope is
+// the scope the switch variable binds.
+func (oneof *Oneof) usesCase(scope int) bool {
+	for _, c := range oneof.Cases {
+		if c.Resource.Path.Child != nil || c.Resource.usesResource() || c.Resource.bindsScope(scope) {
+			return true
+		}
+	}
+	return false
+}
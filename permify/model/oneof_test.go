@@ -0,0 +1,126 @@
+package model
+
+import (
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestOneofUsesCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource *Resource
+		expected bool
+	}{
+		{
+			name: "resource without ids or attributes",
+			resource: &Resource{
+				Type: "Link",
+				Path: &Path{Path: "Link"},
+			},
+			expected: false,
+		},
+		{
+			name: "resource with an id",
+			resource: &Resource{
+				Type:   "Document",
+				Path:   &Path{Path: "Document"},
+				IdPath: &Path{Path: "resource.Id"},
+			},
+			expected: true,
+		},
+		{
+			name: "resource held in a collection",
+			resource: &Resource{
+				Type: "Folder",
+				Path: &Path{Path: "Folders", Child: &Path{}},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oneof := &Oneof{
+				GoName: "Target",
+				Cases:  []*OneofCase{{Resource: tt.resource}},
+			}
+
+			assert.Equal(t, tt.expected, oneof.usesCase(1))
+		})
+	}
+}
+
+func TestOneofGenerateWithoutResource(t *testing.T) {
+	tests := []struct {
+		name       string
+		required   bool
+		policy     pluginv1.MissingResourcePolicy
+		expected   []string
+		unexpected []string
+	}{
+		{
+			name:     "required",
+			required: true,
+			expected: []string{
+				"case nil:\n\t\treturn pkg.CheckConfig{",
+				`"deny_reason": "oneof test.v1.ShareRequest.target is not set",`,
+				"default:\n\t\treturn pkg.CheckConfig{",
+				`"deny_reason": "oneof test.v1.ShareRequest.target holds no resource",`,
+			},
+		},
+		{
+			name:       "check",
+			policy:     pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_CHECK,
+			expected:   []string{"default:\n\t\t{\n\t\t\tvar id string", `Type: "Document",`, `Type: "Folder",`},
+			unexpected: []string{"case nil:", "deny_reason"},
+		},
+		{
+			name:       "deny",
+			policy:     pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_DENY,
+			expected:   []string{`"deny_reason": "oneof test.v1.ShareRequest.target holds no resource",`},
+			unexpected: []string{"case nil:"},
+		},
+		{
+			name:       "skip",
+			policy:     pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_SKIP,
+			unexpected: []string{"default:", "deny_reason"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := newTestGeneratedFile(t)
+			oneof := &Oneof{
+				file:            file,
+				Name:            "test.v1.ShareRequest.target",
+				GoName:          "Target",
+				Required:        tt.required,
+				MissingResource: tt.policy,
+				Cases: []*OneofCase{
+					{GoIdent: protogen.GoIdent{GoName: "ShareRequest_Document"}, Resource: &Resource{file: file, Type: "Document", Path: &Path{Path: "Document"}}},
+					{GoIdent: protogen.GoIdent{GoName: "ShareRequest_Folder"}, Resource: &Resource{file: file, Type: "Folder", Path: &Path{Path: "Folder"}}},
+				},
+			}
+
+			file.P("func f(req *ShareRequest) pkg.CheckConfig {")
+			file.P(`permission := "share"`)
+			file.P("var checks []pkg.Check")
+			oneof.Generate("req", 1, []string{""})
+			file.P("return pkg.CheckConfig{Checks: checks}")
+			file.P("}")
+
+			content, err := file.Content()
+			require.NoError(t, err)
+			for _, expected := range tt.expected {
+				assert.Contains(t, string(content), expected)
+			}
+			for _, unexpected := range tt.unexpected {
+				assert.NotContains(t, string(content), unexpected)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package model
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// Options are the plugin parameters, set with `opt` in buf.gen.yaml. They apply to every file in a run, and
+// annotations on individual fields or methods take precedence over them.
+type Options struct {
+	// MissingResource is how a resource held in an unset optional field is handled.
+	MissingResource pluginv1.MissingResourcePolicy
+	// SortedMaps iterates maps in key order, so that checks and attribute values are produced in a stable order at
+	// the cost of sorting the keys and allocating them on every call. It's on by default.
+	SortedMaps bool
+	// AttributePresence is when an attribute is written to a check.
+	AttributePresence pluginv1.AttributePresence
+	// Strict denies a request whose resource id or tenant id is unset rather than checking an empty or default one.
+	Strict bool
+	// MaxChecks denies requests that would produce more checks than this, when it's not 0.
+	MaxChecks uint
+	// DeduplicateChecks drops checks identical to an earlier one in the same request, keeping the first of each.
+	DeduplicateChecks bool
+	// SingleTenant denies requests whose checks don't all share one tenant, so a request can't act on several tenants
+	// at once.
+	SingleTenant bool
+	// TenantGuard is checked on each tenant of a method's checks ahead of them, when it's set.
+	TenantGuard *TenantGuard
+	// ContextChecks declares that the interceptor calls GetChecksContext with the context of each call, which methods
+	// with resolvers require. Through GetChecks their resolvers would find nothing and deny every call.
+	ContextChecks bool
+	// Benchmarks generates a benchmark of GetChecks for each method, and tests of its deduplication, into a
+	// _permit_test.go file. They import permifytest, making the plugin a test dependency of the generated package, so
+	// they're only generated on request.
+	Benchmarks bool
+}
+
+func NewOptions() *Options {
+	return &Options{
+		MissingResource:   pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_CHECK,
+		SortedMaps:        true,
+		AttributePresence: pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_IF_SET,
+	}
+}
+
+// RegisterFlags binds the options to flags, which protogen sets from the plugin parameter.
+func (options *Options) RegisterFlags(flags *flag.FlagSet) {
+	flags.Var(&missingResourceFlag{policy: &options.MissingResource}, "missing_resource",
+		"how an unset optional resource is handled: check, skip or deny")
+	flags.BoolVar(&options.SortedMaps, "sorted_maps", options.SortedMaps,
+		"iterate maps in key order when building checks and attributes, or in Go's random order when false")
+	flags.Var(&attributePresenceFlag{presence: &options.AttributePresence}, "attribute_presence",
+		"when an attribute is written: if_set, omit_empty or always")
+	flags.BoolVar(&options.Strict, "strict", options.Strict,
+		"deny requests whose resource or tenant ids are unset")
+	flags.UintVar(&options.MaxChecks, "max_checks", options.MaxChecks,
+		"deny requests that would produce more checks than this, or 0 for no limit")
+	flags.BoolVar(&options.DeduplicateChecks, "deduplicate_checks", options.DeduplicateChecks,
+		"drop checks identical to an earlier one in the same request")
+	flags.BoolVar(&options.SingleTenant, "single_tenant", options.SingleTenant,
+		"deny requests whose checks don't all share one tenant")
+	flags.Var(&tenantGuardFlag{guard: &options.TenantGuard}, "tenant_guard",
+		"the type#permission checked on the tenant of every check, such as tenant#member")
+	flags.BoolVar(&options.ContextChecks, "context_checks", options.ContextChecks,
+		"the interceptor calls GetChecksContext, which methods with tenant or id resolvers require")
+	flags.BoolVar(&options.Benchmarks, "benchmarks", options.Benchmarks,
+		"generate benchmarks and tests of GetChecks, which depend on this module's permifytest package")
+}
+
+// ForFile returns the options for the services of file, with its file-level annotations applied.
+func (options *Options) ForFile(plugin *protogen.Plugin, file *protogen.File) *Options {
+	fileOptions := *options
+	if proto.HasExtension(file.Desc.Options(), pluginv1.E_Strict) {
+		fileOptions.Strict = proto.GetExtension(file.Desc.Options(), pluginv1.E_Strict).(bool)
+	}
+	if proto.HasExtension(file.Desc.Options(), pluginv1.E_SortedMaps) {
+		fileOptions.SortedMaps = proto.GetExtension(file.Desc.Options(), pluginv1.E_SortedMaps).(bool)
+	}
+	if proto.HasExtension(file.Desc.Options(), pluginv1.E_DeduplicateChecks) {
+		fileOptions.DeduplicateChecks = proto.GetExtension(file.Desc.Options(), pluginv1.E_DeduplicateChecks).(bool)
+	}
+	if proto.HasExtension(file.Desc.Options(), pluginv1.E_SingleTenant) {
+		fileOptions.SingleTenant = proto.GetExtension(file.Desc.Options(), pluginv1.E_SingleTenant).(bool)
+	}
+	if proto.HasExtension(file.Desc.Options(), pluginv1.E_TenantGuard) {
+		guard, err := newTenantGuard(proto.GetExtension(file.Desc.Options(), pluginv1.E_TenantGuard).(*pluginv1.TenantGuard))
+		if err != nil {
+			plugin.Error(fmt.Errorf("tenant_guard of file %s: %w", file.Desc.Path(), err))
+		}
+		fileOptions.TenantGuard = guard
+	}
+	return &fileOptions
+}
+
+type missingResourceFlag struct {
+	policy *pluginv1.MissingResourcePolicy
+}
+
+func (f *missingResourceFlag) String() string {
+	if f.policy == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(f.policy.String(), "MISSING_RESOURCE_POLICY_"))
+}
+
+func (f *missingResourceFlag) Set(value string) error {
+	policy, ok := pluginv1.MissingResourcePolicy_value["MISSING_RESOURCE_POLICY_"+strings.ToUpper(value)]
+	if !ok || policy == int32(pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_UNSPECIFIED) {
+		return fmt.Errorf("unknown missing_resource policy %q, expected check, skip or deny", value)
+	}
+	*f.policy = pluginv1.MissingResourcePolicy(policy)
+	return nil
+}
+
+type attributePresenceFlag struct {
+	presence *pluginv1.AttributePresence
+}
+
+func (f *attributePresenceFlag) String() string {
+	if f.presence == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(f.presence.String(), "ATTRIBUTE_PRESENCE_"))
+}
+
+func (f *attributePresenceFlag) Set(value string) error {
+	presence, ok := pluginv1.AttributePresence_value["ATTRIBUTE_PRESENCE_"+strings.ToUpper(value)]
+	if !ok || presence == int32(pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_UNSPECIFIED) {
+		return fmt.Errorf("unknown attribute_presence %q, expected if_set, omit_empty or always", value)
+	}
+	*f.presence = pluginv1.AttributePresence(presence)
+	return nil
+}
+
+type tenantGuardFlag struct {
+	guard **TenantGuard
+}
+
+func (f *tenantGuardFlag) String() string {
+	if f.guard == nil || *f.guard == nil {
+		return ""
+	}
+	return (*f.guard).String()
+}
+
+func (f *tenantGuardFlag) Set(value string) error {
+	if value == "" {
+		*f.guard = nil
+		return nil
+	}
+	guard, err := parseTenantGuard(value)
+	if err != nil {
+		return err
+	}
+	*f.guard = guard
+	return nil
+}
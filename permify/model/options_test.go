@@ -0,0 +1,166 @@
+package model
+
+import (
+	"flag"
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestNewOptionsDefaults(t *testing.T) {
+	options := NewOptions()
+
+	assert.Equal(t, pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_CHECK, options.MissingResource)
+	assert.True(t, options.SortedMaps)
+	assert.Equal(t, pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_IF_SET, options.AttributePresence)
+}
+
+func TestOptionsSortedMapsFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+
+	require.NoError(t, flags.Set("sorted_maps", "false"))
+	assert.False(t, options.SortedMaps)
+}
+
+func TestOptionsMissingResourceFlag(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected pluginv1.MissingResourcePolicy
+	}{
+		{value: "check", expected: pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_CHECK},
+		{value: "skip", expected: pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_SKIP},
+		{value: "DENY", expected: pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_DENY},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			var flags flag.FlagSet
+			options := NewOptions()
+			options.RegisterFlags(&flags)
+
+			require.NoError(t, flags.Set("missing_resource", tt.value))
+			assert.Equal(t, tt.expected, options.MissingResource)
+		})
+	}
+}
+
+func TestOptionsMissingResourceFlagRejectsUnknownPolicies(t *testing.T) {
+	for _, value := range []string{"", "unspecified", "allow"} {
+		t.Run(value, func(t *testing.T) {
+			var flags flag.FlagSet
+			options := NewOptions()
+			options.RegisterFlags(&flags)
+
+			assert.Error(t, flags.Set("missing_resource", value))
+			assert.Equal(t, pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_CHECK, options.MissingResource)
+		})
+	}
+}
+
+func TestOptionsAttributePresenceFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+
+	require.NoError(t, flags.Set("attribute_presence", "omit_empty"))
+	assert.Equal(t, pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_OMIT_EMPTY, options.AttributePresence)
+
+	assert.Error(t, flags.Set("attribute_presence", "unspecified"))
+	assert.Error(t, flags.Set("attribute_presence", "never"))
+	assert.Equal(t, pluginv1.AttributePresence_ATTRIBUTE_PRESENCE_OMIT_EMPTY, options.AttributePresence)
+}
+
+func TestOptionsStrictFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+	assert.False(t, options.Strict)
+
+	require.NoError(t, flags.Set("strict", "true"))
+	assert.True(t, options.Strict)
+}
+
+func TestOptionsMaxChecksFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+	assert.Zero(t, options.MaxChecks)
+
+	require.NoError(t, flags.Set("max_checks", "25"))
+	assert.Equal(t, uint(25), options.MaxChecks)
+	assert.Error(t, flags.Set("max_checks", "-1"))
+}
+
+func TestOptionsDeduplicateChecksFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+	assert.False(t, options.DeduplicateChecks)
+
+	require.NoError(t, flags.Set("deduplicate_checks", "true"))
+	assert.True(t, options.DeduplicateChecks)
+}
+
+func TestOptionsSingleTenantFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+	assert.False(t, options.SingleTenant)
+
+	require.NoError(t, flags.Set("single_tenant", "true"))
+	assert.True(t, options.SingleTenant)
+}
+
+func TestOptionsTenantGuardFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+	assert.Nil(t, options.TenantGuard)
+
+	require.NoError(t, flags.Set("tenant_guard", "organization#member"))
+	assert.Equal(t, &TenantGuard{Type: "organization", Permission: "member"}, options.TenantGuard)
+
+	assert.Error(t, flags.Set("tenant_guard", "organization"))
+	require.NoError(t, flags.Set("tenant_guard", ""))
+	assert.Nil(t, options.TenantGuard)
+}
+
+func TestOptionsBenchmarksFlag(t *testing.T) {
+	var flags flag.FlagSet
+	options := NewOptions()
+	options.RegisterFlags(&flags)
+	assert.False(t, options.Benchmarks)
+
+	require.NoError(t, flags.Set("benchmarks", "true"))
+	assert.True(t, options.Benchmarks)
+}
+
+func TestOptionsForFileSortedMaps(t *testing.T) {
+	var file descriptorpb.FileDescriptorProto
+	require.NoError(t, prototext.Unmarshal([]byte(`
+name: "test/v1/unsorted.proto"
+package: "test.v1"
+syntax: "proto3"
+options {
+  go_package: "test/v1;testv1"
+  [nrf110.permify.plugin.v1.sorted_maps]: false
+}
+`), &file))
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{&file},
+	})
+	require.NoError(t, err)
+	options := NewOptions()
+
+	assert.False(t, options.ForFile(plugin, plugin.FilesByPath[file.GetName()]).SortedMaps)
+	assert.True(t, options.SortedMaps)
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// Parent is the entity a resource belongs to, checked for operations such as creating the resource that can't be
+// checked on the resource itself.
+type Parent struct {
+	Type string
+	// IdPath leads from the resource to the parent's id.
+	IdPath *Path
+	// Permission is checked on the parent in place of the method's permission when it's set.
+	Permission string
+	Check      pluginv1.ParentCheck
+}
+
+// findParent reads the parent_resource annotation of the resource message pb.
+func findParent(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Message) *Parent {
+	messageOptions := pb.Desc.Options()
+	if !proto.HasExtension(messageOptions, pluginv1.E_ParentResource) {
+		return nil
+	}
+	annotation := proto.GetExtension(messageOptions, pluginv1.E_ParentResource).(*pluginv1.ParentResource)
+
+	parent, err := newParent(file, pb, annotation)
+	if err != nil {
+		plugin.Error(fmt.Errorf("parent of resource %s: %w", pb.GoIdent.GoName, err))
+		return nil
+	}
+	return parent
+}
+
+func newParent(file *protogen.GeneratedFile, pb *protogen.Message, annotation *pluginv1.ParentResource) (*Parent, error) {
+	if annotation.GetType() == "" {
+		return nil, errors.New("type is required")
+	}
+	idPath, err := newIdFieldPath(file, "resource", pb, annotation.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("id: %w", err)
+	}
+	return &Parent{
+		Type:       annotation.GetType(),
+		IdPath:     idPath,
+		Permission: annotation.GetPermission(),
+		Check:      annotation.GetCheck(),
+	}, nil
+}
+
+// ReplacesResource reports whether the parent is checked instead of the resource when the resource has no id.
+func (parent *Parent) ReplacesResource() bool {
+	return parent.Check == pluginv1.ParentCheck_PARENT_CHECK_UNSPECIFIED
+}
+
+// renderCheck appends the check of the parent, with the tenant of the resource. Without a permission of its own, the
+// parent is checked once for each of the method's permissions when there are several. Methods with several
+// permissions can't have a parent with a permission of its own.
+func (parent *Parent) renderCheck(resource *Resource, nestingLevel int) {
+	file := resource.file
+	permission := "permission"
+	if parent.Permission != "" {
+		permission = `"` + parent.Permission + `"`
+	}
+
+	file.P(util.Indent(nestingLevel), "var parentId string")
+	file.P(util.Indent(nestingLevel), "if ", strings.Join(idConditions(parent.IdPath), " && "), " {")
+	file.P(util.Indent(nestingLevel+1), "parentId = ", renderIdValue(file, parent.IdPath.Path, parent.IdPath.Field))
+	file.P(util.Indent(nestingLevel), "}")
+	if resource.strict() {
+		renderEmptyGuard(file, nestingLevel, "parentId", unsetReason(resource.fieldPath(parent.IdPath)))
+	}
+	loop := resource.multiplePermissions() && parent.Permission == ""
+	if loop {
+		file.P(util.Indent(nestingLevel), "for _, permission := range permissions {")
+		nestingLevel++
+	}
+	file.P(util.Indent(nestingLevel), "checks = append(checks, pkg.Check {")
+	file.P(util.Indent(nestingLevel+1), "TenantID:     tenantId,")
+	file.P(util.Indent(nestingLevel+1), "Permission:   ", permission, ",")
+	file.P(util.Indent(nestingLevel+1), "Entity: &pkg.Resource {")
+	file.P(util.Indent(nestingLevel+2), `Type: "`, parent.Type, `",`)
+	file.P(util.Indent(nestingLevel+2), `ID:   parentId,`)
+	file.P(util.Indent(nestingLevel+1), "},")
+	file.P(util.Indent(nestingLevel), "})")
+	if loop {
+		file.P(util.Indent(nestingLevel-1), "}")
+	}
+}
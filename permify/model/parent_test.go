@@ -0,0 +1,55 @@
+package model
+
+import (
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewParent(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	draft := newTestMessage(t, "Draft")
+
+	parent, err := newParent(file, draft, &pluginv1.ParentResource{
+		Type:       "Team",
+		Id:         "team_id",
+		Permission: "create",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Team", parent.Type)
+	assert.Equal(t, "resource.TeamId", parent.IdPath.Path)
+	assert.Equal(t, "create", parent.Permission)
+	assert.True(t, parent.ReplacesResource())
+
+	parent, err = newParent(file, draft, &pluginv1.ParentResource{
+		Type:  "Team",
+		Id:    "team_id",
+		Check: pluginv1.ParentCheck_PARENT_CHECK_ALSO,
+	})
+	require.NoError(t, err)
+	assert.False(t, parent.ReplacesResource())
+}
+
+func TestNewParentErrors(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	draft := newTestMessage(t, "Draft")
+
+	tests := []struct {
+		name       string
+		annotation *pluginv1.ParentResource
+		err        string
+	}{
+		{name: "missing type", annotation: &pluginv1.ParentResource{Id: "team_id"}, err: "type is required"},
+		{name: "missing id", annotation: &pluginv1.ParentResource{Type: "Team"}, err: "id: field path is empty"},
+		{name: "unknown id", annotation: &pluginv1.ParentResource{Type: "Team", Id: "folder_id"}, err: "id: field path"},
+		{name: "id isn't an id", annotation: &pluginv1.ParentResource{Type: "Team", Id: "score"}, err: "must lead to a string, an integer or a wrapper of one"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newParent(file, draft, tt.annotation)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -2,9 +2,12 @@ package model
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -21,6 +24,8 @@ func (fh fieldHolder) VariableType(file *protogen.GeneratedFile) string {
 	return goType
 }
 
+// variableType describes the Go type of field. Identifiers are rendered with their import path rather than through
+// QualifiedGoIdent, so describing a path never adds an import the generated code doesn't use.
 func variableType(file *protogen.GeneratedFile, field *protogen.Field) (goType string, pointer bool) {
 	if field.Desc.IsWeak() {
 		return "struct{}", false
@@ -31,7 +36,7 @@ func variableType(file *protogen.GeneratedFile, field *protogen.Field) (goType s
 	case protoreflect.BoolKind:
 		goType = "bool"
 	case protoreflect.EnumKind:
-		goType = file.QualifiedGoIdent(field.Enum.GoIdent)
+		goType = field.Enum.GoIdent.String()
 	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
 		goType = "int32"
 	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
@@ -50,7 +55,7 @@ func variableType(file *protogen.GeneratedFile, field *protogen.Field) (goType s
 		goType = "[]byte"
 		pointer = false // rely on nullability of slices for presence
 	case protoreflect.MessageKind, protoreflect.GroupKind:
-		goType = "*" + file.QualifiedGoIdent(field.Message.GoIdent)
+		goType = "*" + field.Message.GoIdent.String()
 		pointer = false // pointer captured as part of the type
 	}
 	switch {
@@ -122,6 +127,44 @@ func (node *PathBuilder) VariableType() string {
 	return ""
 }
 
+// protoFields returns the fields of the node, leaving out its root.
+func (node *PathBuilder) protoFields() []*protogen.Field {
+	var fields []*protogen.Field
+	for _, holder := range node.fields {
+		if holder.field != nil {
+			fields = append(fields, holder.field)
+		}
+	}
+	return fields
+}
+
+// fieldPath returns the proto names of the fields leading from the root of the path being built, through the
+// collections it enters, to the node.
+func (node *PathBuilder) fieldPath() string {
+	var parent string
+	if node.parent != nil {
+		parent = node.parent.fieldPath()
+	}
+	return joinFieldPath(parent, fieldNames(node.protoFields()))
+}
+
+func (node *PathBuilder) Field() *protogen.Field {
+	if length := len(node.fields); length > 0 {
+		return node.fields[length-1].field
+	}
+	return nil
+}
+
+// Presence returns the first field of the node that tracks whether the resource it leads to is set.
+func (node *PathBuilder) Presence() *protogen.Field {
+	for _, holder := range node.fields {
+		if holder.field != nil && util.TracksPresence(holder.field) {
+			return holder.field
+		}
+	}
+	return nil
+}
+
 func (node *PathBuilder) Build() *Path {
 	return walk(node, nil)
 }
@@ -131,12 +174,18 @@ func walk(currentNode *PathBuilder, path *Path) *Path {
 		return walk(currentNode.parent, &Path{
 			Path:         currentNode.Path(),
 			VariableType: currentNode.VariableType(),
+			Field:        currentNode.Field(),
+			Fields:       currentNode.protoFields(),
+			Presence:     currentNode.Presence(),
 			Child:        path,
 		})
 	}
 	return &Path{
 		Path:         currentNode.Path(),
 		VariableType: currentNode.VariableType(),
+		Field:        currentNode.Field(),
+		Fields:       currentNode.protoFields(),
+		Presence:     currentNode.Presence(),
 		Child:        path,
 	}
 }
@@ -144,7 +193,11 @@ func walk(currentNode *PathBuilder, path *Path) *Path {
 type Path struct {
 	Path         string
 	VariableType string
-	Child        *Path
+	Field        *protogen.Field
+	// Fields are the fields the node reads, of which Field is the last.
+	Fields   []*protogen.Field
+	Presence *protogen.Field
+	Child    *Path
 }
 
 func (path *Path) WithPrefix(prefix string) *Path {
@@ -168,6 +221,38 @@ func (path *Path) IsPointer() bool {
 	return strings.HasPrefix(path.VariableType, "*")
 }
 
+// Leaf returns the last node of the path, which holds the field the path resolves to.
+func (path *Path) Leaf() *Path {
+	currentPath := path
+	for currentPath.Child != nil {
+		currentPath = currentPath.Child
+	}
+	return currentPath
+}
+
+// FieldPath returns the dot-separated proto names of the fields along the path, as a request would name them.
+func (path *Path) FieldPath() string {
+	var names []string
+	for currentPath := path; currentPath != nil; currentPath = currentPath.Child {
+		names = append(names, fieldNames(currentPath.Fields))
+	}
+	return joinFieldPath(names...)
+}
+
+// fieldNames joins the proto names of fields with dots.
+func fieldNames(fields []*protogen.Field) string {
+	names := make([]string, len(fields))
+	for idx, field := range fields {
+		names[idx] = string(field.Desc.Name())
+	}
+	return strings.Join(names, ".")
+}
+
+// joinFieldPath joins the non-empty field paths in paths with dots.
+func joinFieldPath(paths ...string) string {
+	return strings.Join(slices.DeleteFunc(paths, func(path string) bool { return path == "" }), ".")
+}
+
 func (path *Path) String() string {
 	var sb strings.Builder
 	currentPath := path
@@ -180,3 +265,91 @@ func (path *Path) String() string {
 	}
 	return sb.String()
 }
+
+// ResolveFieldPath follows fieldPath, a dot-separated list of proto field names such as "document.owner_id", from
+// message. Every field but the last must be a singular message field that isn't part of a oneof.
+func ResolveFieldPath(message *protogen.Message, fieldPath string) ([]*protogen.Field, error) {
+	if fieldPath == "" {
+		return nil, fmt.Errorf("field path is empty")
+	}
+
+	var fields []*protogen.Field
+	current := message
+	for idx, name := range strings.Split(fieldPath, ".") {
+		if current == nil {
+			return nil, fmt.Errorf("field path %q: %s is not a message", fieldPath, fields[idx-1].Desc.Name())
+		}
+		var found *protogen.Field
+		for _, field := range current.Fields {
+			if string(field.Desc.Name()) == name {
+				found = field
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("field path %q: %s has no field %s", fieldPath, current.Desc.FullName(), name)
+		}
+		if found.Desc.IsList() || found.Desc.IsMap() || util.IsOneofField(found) {
+			return nil, fmt.Errorf("field path %q: %s must be a singular field outside of a oneof", fieldPath, name)
+		}
+		fields = append(fields, found)
+		current = found.Message
+		if util.IsWrapper(found) {
+			current = nil
+		}
+	}
+	return fields, nil
+}
+
+// NewFieldPath resolves fieldPath from message, and returns it as a path from root.
+func NewFieldPath(file *protogen.GeneratedFile, root string, message *protogen.Message, fieldPath string) (*Path, error) {
+	fields, err := ResolveFieldPath(message, fieldPath)
+	if err != nil {
+		return nil, err
+	}
+	builder := NewRootPathBuilder(root, file)
+	for _, field := range fields {
+		builder = builder.AddField(field)
+	}
+	return builder.Build(), nil
+}
+
+// findAnnotatedFields collects the paths of the fields of pb carrying ext, and of those in the singular messages it
+// holds. Messages already being visited are skipped so recursive messages terminate.
+func findAnnotatedFields(pb *protogen.Message, ext protoreflect.ExtensionType, path *PathBuilder, visiting []*protogen.Message, accum []*Path) []*Path {
+	visiting = append(visiting, pb)
+	for _, field := range pb.Fields {
+		if proto.HasExtension(field.Desc.Options(), ext) {
+			accum = append(accum, path.AddField(field).Build())
+			continue
+		}
+
+		if !util.IsMessage(field) || field.Desc.IsList() || field.Desc.IsMap() || util.IsOneofField(field) {
+			continue
+		}
+		if slices.ContainsFunc(visiting, func(message *protogen.Message) bool {
+			return message.Desc.FullName() == field.Message.Desc.FullName()
+		}) {
+			continue
+		}
+		accum = findAnnotatedFields(field.Message, ext, path.AddField(field), visiting, accum)
+	}
+	return accum
+}
+
+// findSingleField returns the path of the one field found by findAnnotatedFields, or nil when there is none. It's an
+// error for more than one field to carry ext, and description names the annotation in the error.
+func findSingleField(pb *protogen.Message, ext protoreflect.ExtensionType, path *PathBuilder, description string) (*Path, error) {
+	paths := findAnnotatedFields(pb, ext, path, nil, nil)
+	switch len(paths) {
+	case 0:
+		return nil, nil
+	case 1:
+		return paths[0], nil
+	}
+	var fields []string
+	for _, path := range paths {
+		fields = append(fields, string(path.Field.Desc.FullName()))
+	}
+	return nil, fmt.Errorf("found %d %s fields, only one is allowed: %s", len(paths), description, strings.Join(fields, ", "))
+}
@@ -308,3 +308,56 @@ func TestPathChaining(t *testing.T) {
 		assert.Nil(t, path.Child.Child.Child)
 	})
 }
+
+func TestPathLeaf(t *testing.T) {
+	leaf := &Path{Path: "ids.id"}
+	path := &Path{
+		Path: "resource",
+		Child: &Path{
+			Path:  "level1",
+			Child: leaf,
+		},
+	}
+
+	assert.Same(t, leaf, path.Leaf())
+	assert.Same(t, leaf, leaf.Leaf())
+}
+
+func TestResolveFieldPath(t *testing.T) {
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	fields, err := ResolveFieldPath(request, "draft.team_id")
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "Draft", fields[0].GoName)
+	assert.Equal(t, "TeamId", fields[1].GoName)
+
+	tests := []struct {
+		fieldPath string
+		err       string
+	}{
+		{fieldPath: "", err: "field path is empty"},
+		{fieldPath: "draft.owner", err: "test.v1.Draft has no field owner"},
+		{fieldPath: "drafts.id", err: "drafts must be a singular field outside of a oneof"},
+		{fieldPath: "folder_id", err: "folder_id must be a singular field outside of a oneof"},
+		{fieldPath: "draft.id.value", err: "id is not a message"},
+		{fieldPath: "reviewer_id.value", err: "reviewer_id is not a message"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fieldPath, func(t *testing.T) {
+			_, err := ResolveFieldPath(request, tt.fieldPath)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewFieldPath(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	path, err := NewFieldPath(file, "req", request, "draft.id")
+	require.NoError(t, err)
+	assert.Equal(t, "req.Draft.Id", path.Path)
+	assert.Equal(t, "Id", path.Field.GoName)
+	assert.Nil(t, path.Child)
+}
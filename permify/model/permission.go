@@ -0,0 +1,180 @@
+package model
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PermissionField selects the permission a method checks from the value of a request field.
+type PermissionField struct {
+	Path *Path
+	// Cases map Go expressions of the field's values to permissions, in the order they're rendered. A string field
+	// without cases is checked as the permission itself.
+	Cases []PermissionCase
+}
+
+type PermissionCase struct {
+	Value      string
+	Permission string
+}
+
+// newPermissionField finds the request field annotated with permission_field, if any.
+func newPermissionField(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Method) *PermissionField {
+	path, err := findSingleField(pb.Input, pluginv1.E_PermissionField, NewRootPathBuilder("req", file), "permission")
+	if err == nil && path != nil {
+		annotation := proto.GetExtension(path.Field.Desc.Options(), pluginv1.E_PermissionField).(*pluginv1.PermissionField)
+		var permissionField *PermissionField
+		if permissionField, err = newPermissionFieldFromPath(file, path, annotation); err == nil {
+			return permissionField
+		}
+		err = fmt.Errorf("permission field %s: %w", path.Field.Desc.FullName(), err)
+	}
+	if err != nil {
+		plugin.Error(fmt.Errorf("method %s in service %s: %w", pb.GoName, pb.Parent.GoName, err))
+	}
+	return nil
+}
+
+func newPermissionFieldFromPath(file *protogen.GeneratedFile, path *Path, annotation *pluginv1.PermissionField) (*PermissionField, error) {
+	field := path.Field
+	switch {
+	case field.Desc.IsList() || field.Desc.IsMap():
+		return nil, fmt.Errorf("must be a singular field")
+	case util.IsOneofField(field):
+		return nil, fmt.Errorf("must be outside of a oneof")
+	}
+	for value, permission := range annotation.GetPermissions() {
+		if permission == "" {
+			return nil, fmt.Errorf("value %s maps to an empty permission", value)
+		}
+	}
+
+	permissionField := &PermissionField{Path: path}
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		if len(annotation.GetPermissions()) == 0 {
+			return nil, fmt.Errorf("enums must map their values to permissions")
+		}
+		for value := range annotation.GetPermissions() {
+			if field.Enum.Desc.Values().ByName(protoreflect.Name(value)) == nil {
+				return nil, fmt.Errorf("%s has no value %s", field.Enum.Desc.FullName(), value)
+			}
+		}
+		// Cases follow the order the enum declares its values in
+		for _, value := range field.Enum.Values {
+			if permission, ok := annotation.GetPermissions()[string(value.Desc.Name())]; ok {
+				permissionField.Cases = append(permissionField.Cases, PermissionCase{
+					Value:      file.QualifiedGoIdent(value.GoIdent),
+					Permission: permission,
+				})
+			}
+		}
+	case protoreflect.StringKind:
+		for _, value := range slices.Sorted(maps.Keys(annotation.GetPermissions())) {
+			permissionField.Cases = append(permissionField.Cases, PermissionCase{
+				Value:      strconv.Quote(value),
+				Permission: annotation.GetPermissions()[value],
+			})
+		}
+	default:
+		return nil, fmt.Errorf("must be a string or an enum")
+	}
+	return permissionField, nil
+}
+
+// render declares permission, selecting it from the field's value and denying the request when none is selected.
+func (permissionField *PermissionField) render(file *protogen.GeneratedFile, nestingLevel int) {
+	path := permissionField.Path
+	value := path.Path
+	conditions := idConditions(path)
+	// Only an optional field's presence needs checking, as any other value that selects no permission is denied
+	if util.IsOptionalScalar(path.Field) {
+		value = "*" + value
+	} else {
+		conditions = conditions[:len(conditions)-1]
+	}
+
+	if len(conditions) == 0 && len(permissionField.Cases) == 0 {
+		file.P(util.Indent(nestingLevel), "permission := ", value)
+		permissionField.renderDeny(file, nestingLevel)
+		return
+	}
+
+	file.P(util.Indent(nestingLevel), "var permission string")
+	level := nestingLevel
+	if len(conditions) > 0 {
+		file.P(util.Indent(nestingLevel), "if ", strings.Join(conditions, " && "), " {")
+		level++
+	}
+	if len(permissionField.Cases) == 0 {
+		file.P(util.Indent(level), "permission = ", value)
+	} else {
+		file.P(util.Indent(level), "switch ", value, " {")
+		for _, permissionCase := range permissionField.Cases {
+			file.P(util.Indent(level), "case ", permissionCase.Value, ":")
+			file.P(util.Indent(level+1), `permission = "`, permissionCase.Permission, `"`)
+		}
+		file.P(util.Indent(level), "}")
+	}
+	if len(conditions) > 0 {
+		file.P(util.Indent(nestingLevel), "}")
+	}
+	permissionField.renderDeny(file, nestingLevel)
+}
+
+func (permissionField *PermissionField) renderDeny(file *protogen.GeneratedFile, nestingLevel int) {
+	file.P(util.Indent(nestingLevel), `if permission == "" {`)
+	renderDeny(file, nestingLevel+1, fmt.Sprintf("field %s selects no permission", permissionField.Path.FieldPath()))
+	file.P(util.Indent(nestingLevel), "}")
+}
+
+// findPermissions reads the permissions annotation from the options of a method, checking that several permissions
+// are combined explicitly.
+func findPermissions(methodOptions proto.Message) ([]string, pluginv1.PermissionCombinator, error) {
+	permissions, _ := proto.GetExtension(methodOptions, pluginv1.E_Permissions).([]string)
+	combinator, _ := proto.GetExtension(methodOptions, pluginv1.E_PermissionCombinator).(pluginv1.PermissionCombinator)
+
+	for idx, permission := range permissions {
+		if permission == "" {
+			return nil, combinator, fmt.Errorf("permission %d is empty", idx+1)
+		}
+		if slices.Contains(permissions[:idx], permission) {
+			return nil, combinator, fmt.Errorf("permission %s is listed more than once", permission)
+		}
+	}
+	if len(permissions) > 1 && combinator == pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_UNSPECIFIED {
+		return nil, combinator, fmt.Errorf("%d permissions must set a permission_combinator", len(permissions))
+	}
+	return permissions, combinator, nil
+}
+
+// renderStrings renders values as the elements of a string slice literal.
+func renderStrings(values []string) string {
+	quoted := make([]string, len(values))
+	for idx, value := range values {
+		quoted[idx] = strconv.Quote(value)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// generateCombinator adds GetPermissionCombinator, telling the interceptor how the checks of each entity combine. The
+// combinator is returned as a plain string so that generated code doesn't depend on the plugin.
+func (method *Method) generateCombinator() {
+	file := method.file
+	combinator := strings.ToLower(strings.TrimPrefix(method.Combinator.String(), "PERMISSION_COMBINATOR_"))
+
+	file.P("// GetPermissionCombinator returns how the checks returned by GetChecks combine, all_of or any_of. Each entity")
+	file.P("// has one consecutive check per permission, in the order ", strings.Join(method.Permissions, ", "), ".")
+	file.P("func (req *", method.RequestType, ") GetPermissionCombinator() string {")
+	file.P(util.Indent(1), "return ", strconv.Quote(combinator))
+	file.P("}")
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestNewPermissionFieldFromPath(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	t.Run("enum cases follow declaration order", func(t *testing.T) {
+		path, err := NewFieldPath(file, "req", request, "draft.stage")
+		require.NoError(t, err)
+		permissionField, err := newPermissionFieldFromPath(file, path, &pluginv1.PermissionField{
+			Permissions: map[string]string{"STAGE_PUBLISH": "publish", "STAGE_REVIEW": "review"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []PermissionCase{
+			{Value: "Stage_STAGE_REVIEW", Permission: "review"},
+			{Value: "Stage_STAGE_PUBLISH", Permission: "publish"},
+		}, permissionField.Cases)
+	})
+
+	t.Run("string cases are sorted", func(t *testing.T) {
+		path, err := NewFieldPath(file, "req", request, "draft.id")
+		require.NoError(t, err)
+		permissionField, err := newPermissionFieldFromPath(file, path, &pluginv1.PermissionField{
+			Permissions: map[string]string{"write": "edit", "read": "view"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []PermissionCase{
+			{Value: `"read"`, Permission: "view"},
+			{Value: `"write"`, Permission: "edit"},
+		}, permissionField.Cases)
+	})
+
+	t.Run("strings may be unmapped", func(t *testing.T) {
+		path, err := NewFieldPath(file, "req", request, "draft.id")
+		require.NoError(t, err)
+		permissionField, err := newPermissionFieldFromPath(file, path, &pluginv1.PermissionField{})
+		require.NoError(t, err)
+		assert.Empty(t, permissionField.Cases)
+	})
+}
+
+func TestNewPermissionFieldFromPathErrors(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	tests := []struct {
+		name        string
+		fieldPath   string
+		permissions map[string]string
+		err         string
+	}{
+		{name: "unmapped enum", fieldPath: "draft.stage", err: "enums must map their values to permissions"},
+		{name: "unknown enum value", fieldPath: "draft.stage", permissions: map[string]string{"STAGE_ARCHIVE": "archive"}, err: "test.v1.Stage has no value STAGE_ARCHIVE"},
+		{name: "empty permission", fieldPath: "draft.id", permissions: map[string]string{"read": ""}, err: "value read maps to an empty permission"},
+		{name: "integer", fieldPath: "draft.team_id", err: "must be a string or an enum"},
+		{name: "list", fieldPath: "draft.tags", err: "must be a singular field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := NewRootPathBuilder("req", file).AddField(request.Fields[0])
+			for _, field := range request.Fields[0].Message.Fields {
+				if "draft."+string(field.Desc.Name()) == tt.fieldPath {
+					path = path.AddField(field)
+				}
+			}
+			_, err := newPermissionFieldFromPath(file, path.Build(), &pluginv1.PermissionField{Permissions: tt.permissions})
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestFindPermissions(t *testing.T) {
+	methodOptions := func(combinator pluginv1.PermissionCombinator, permissions ...string) *descriptorpb.MethodOptions {
+		methodOptions := &descriptorpb.MethodOptions{}
+		proto.SetExtension(methodOptions, pluginv1.E_Permissions, permissions)
+		proto.SetExtension(methodOptions, pluginv1.E_PermissionCombinator, combinator)
+		return methodOptions
+	}
+
+	permissions, combinator, err := findPermissions(methodOptions(pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_ANY_OF, "admin", "owner"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin", "owner"}, permissions)
+	assert.Equal(t, pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_ANY_OF, combinator)
+
+	permissions, _, err = findPermissions(methodOptions(pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_UNSPECIFIED, "view"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"view"}, permissions)
+
+	permissions, _, err = findPermissions(&descriptorpb.MethodOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+
+	_, _, err = findPermissions(methodOptions(pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_UNSPECIFIED, "write", "transfer"))
+	assert.ErrorContains(t, err, "2 permissions must set a permission_combinator")
+	_, _, err = findPermissions(methodOptions(pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_ALL_OF, "write", ""))
+	assert.ErrorContains(t, err, "permission 2 is empty")
+	_, _, err = findPermissions(methodOptions(pluginv1.PermissionCombinator_PERMISSION_COMBINATOR_ALL_OF, "write", "write"))
+	assert.ErrorContains(t, err, "permission write is listed more than once")
+}
@@ -0,0 +1,185 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// resolverPackage looks up the resolvers and context values named by resolver annotations.
+const resolverPackage = protogen.GoImportPath("github.com/nrf110/protoc-gen-connectrpc-permify/resolver")
+
+// Resolver reads a tenant or resource id from the context passed to GetChecksContext, when the request doesn't carry
+// it.
+type Resolver struct {
+	// Name is the name of a resolver registered with resolver.Register, or the key of a value set with
+	// resolver.WithValue when ContextKey is set.
+	Name       string
+	ContextKey bool
+}
+
+// findResolver reads the resolver annotation ext of the resource message pb.
+func findResolver(plugin *protogen.Plugin, pb *protogen.Message, ext protoreflect.ExtensionType) *Resolver {
+	messageOptions := pb.Desc.Options()
+	if !proto.HasExtension(messageOptions, ext) {
+		return nil
+	}
+	annotation := proto.GetExtension(messageOptions, ext).(*pluginv1.Resolver)
+
+	resolver, err := newResolver(annotation)
+	if err != nil {
+		plugin.Error(fmt.Errorf("%s of resource %s: %w", ext.TypeDescriptor().Name(), pb.GoIdent.GoName, err))
+		return nil
+	}
+	return resolver
+}
+
+func newResolver(annotation *pluginv1.Resolver) (*Resolver, error) {
+	switch {
+	case annotation.GetName() != "":
+		return &Resolver{Name: annotation.GetName()}, nil
+	case annotation.GetContextKey() != "":
+		return &Resolver{Name: annotation.GetContextKey(), ContextKey: true}, nil
+	default:
+		return nil, errors.New("a name or context_key is required")
+	}
+}
+
+// String describes the resolver for generated comments and deny reasons.
+func (resolver *Resolver) String() string {
+	if resolver.ContextKey {
+		return fmt.Sprintf("context key %q", resolver.Name)
+	}
+	return fmt.Sprintf("resolver %q", resolver.Name)
+}
+
+// expression returns the generated expression reading the tenant or id from ctx.
+func (resolver *Resolver) expression(file *protogen.GeneratedFile) string {
+	function := "Resolve"
+	if resolver.ContextKey {
+		function = "Value"
+	}
+	resolve := file.QualifiedGoIdent(protogen.GoIdent{GoName: function, GoImportPath: resolverPackage})
+	return fmt.Sprintf("%s(ctx, %q)", resolve, resolver.Name)
+}
+
+// render sets variable from the context, unless it's already set.
+func (resolver *Resolver) render(file *protogen.GeneratedFile, nestingLevel int, variable string) {
+	file.P(util.Indent(nestingLevel), "if ", variable, ` == "" {`)
+	file.P(util.Indent(nestingLevel+1), variable, " = ", resolver.expression(file))
+	file.P(util.Indent(nestingLevel), "}")
+}
+
+// sourcesReason is the deny reason of a tenant or id that none of its sources, a field or a resolver, provided.
+// missing says why the field didn't, and is empty when there's only the resolver.
+func sourcesReason(missing string, resolver *Resolver) string {
+	switch {
+	case resolver == nil:
+		return missing
+	case missing == "":
+		return fmt.Sprintf("%s returned no value", resolver)
+	default:
+		return fmt.Sprintf("%s and %s returned no value", missing, resolver)
+	}
+}
+
+// unsetReason says that the field at fieldPath in the request is unset.
+func unsetReason(fieldPath string) string {
+	return fmt.Sprintf("field %s is not set", fieldPath)
+}
+
+// describeSources describes where a tenant or id comes from, in order of precedence, for generated comments. source
+// describes the field it's read from, if any.
+func describeSources(source string, resolver *Resolver, fallback string) string {
+	description := source
+	if resolver != nil {
+		if description != "" {
+			description += ", then "
+		}
+		description += resolver.String()
+	}
+	if fallback != "" {
+		description += ", then " + fallback
+	}
+	return description
+}
+
+// usesResolvers reports whether the resource, or any resource in the cases of its oneof, has a resolver.
+func (resource *Resource) usesResolvers() bool {
+	if resource.TenantResolver != nil || resource.IdResolver != nil {
+		return true
+	}
+	if resource.Oneof != nil {
+		for _, c := range resource.Oneof.Cases {
+			if c.Resource.usesResolvers() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sourceComments describes, for the resource and those in the cases of its oneof, where the tenants that are
+// inherited or resolved and the ids that are resolved come from.
+func (resource *Resource) sourceComments() []string {
+	var comments []string
+	if _, inherited := resource.tenantField(); inherited || resource.TenantResolver != nil {
+		comments = append(comments, fmt.Sprintf("The tenant of %s comes from %s.", resource.Type, resource.describeTenant()))
+	}
+	if resource.IdResolver != nil {
+		var source string
+		if resource.IdPath != nil {
+			source = fmt.Sprintf("field %s", resource.IdPath.Leaf().Field.Desc.FullName())
+		}
+		fallback := "denied"
+		if resource.AllowEmptyId {
+			fallback = "empty"
+		}
+		comments = append(comments, fmt.Sprintf("The id of %s comes from %s.", resource.Type,
+			describeSources(source, resource.IdResolver, fallback)))
+	}
+	if resource.Oneof != nil {
+		for _, c := range resource.Oneof.Cases {
+			comments = append(comments, c.Resource.sourceComments()...)
+		}
+	}
+	return comments
+}
+
+// generateChecksContext generates GetChecksContext, holding the checks of a method whose resources have resolvers,
+// and GetChecks calling it without a context.
+func (method *Method) generateChecksContext() {
+	file := method.file
+	file.P("// GetChecks returns the checks of GetChecksContext with a background context, so resolvers relying on a")
+	file.P("// request's context find nothing, and the request is denied unless it carries what they would resolve.")
+	file.P("func (req *", method.RequestType, ") GetChecks() pkg.CheckConfig {")
+	background := file.QualifiedGoIdent(protogen.GoIdent{GoName: "Background", GoImportPath: "context"})
+	file.P(util.Indent(1), "return req.GetChecksContext(", background, "())")
+	file.P("}")
+	file.P()
+
+	file.P("// GetChecksContext returns the checks of the request, resolving tenants and ids it doesn't carry from ctx.")
+	file.P("// A field's value takes precedence over its resolver. The request is denied when neither provides one.")
+	for _, comment := range method.Resource.sourceComments() {
+		file.P("// ", comment)
+	}
+	if method.MaxChecks > 0 {
+		file.P("// It denies requests that would produce more than ", method.MaxChecks, " checks.")
+	}
+	if method.options.SingleTenant {
+		file.P("// It denies requests whose checks aren't all in the same tenant.")
+	}
+	if method.options.TenantGuard != nil {
+		file.P("// It checks ", method.options.TenantGuard, " on each tenant of its checks, ahead of them, and denies requests")
+		file.P("// without checks.")
+	}
+	ctx := file.QualifiedGoIdent(protogen.GoIdent{GoName: "Context", GoImportPath: "context"})
+	file.P("func (req *", method.RequestType, ") GetChecksContext(ctx ", ctx, ") pkg.CheckConfig {")
+	method.generateChecks()
+	file.P("}")
+}
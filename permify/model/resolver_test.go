@@ -0,0 +1,223 @@
+package model
+
+import (
+	"io"
+	stdlog "log"
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestNewResolver(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation *pluginv1.Resolver
+		expected   *Resolver
+		err        string
+	}{
+		{
+			name:       "registered resolver",
+			annotation: &pluginv1.Resolver{Source: &pluginv1.Resolver_Name{Name: "principal"}},
+			expected:   &Resolver{Name: "principal"},
+		},
+		{
+			name:       "context key",
+			annotation: &pluginv1.Resolver{Source: &pluginv1.Resolver_ContextKey{ContextKey: "tenant"}},
+			expected:   &Resolver{Name: "tenant", ContextKey: true},
+		},
+		{name: "neither", annotation: &pluginv1.Resolver{}, err: "a name or context_key is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := newResolver(tt.annotation)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolver)
+		})
+	}
+}
+
+func TestRenderTenantIdWithResolver(t *testing.T) {
+	draft := newTestMessage(t, "Draft")
+	principal := &Resolver{Name: "principal"}
+
+	tests := []struct {
+		name     string
+		strict   bool
+		field    bool
+		expected []string
+	}{
+		{
+			name:  "field then resolver",
+			field: true,
+			expected: []string{
+				"\tvar tenantId string\n\tif resource.Id != \"\" {\n\t\ttenantId = resource.Id\n\t}\n",
+				"\tif tenantId == \"\" {\n\t\ttenantId = resolver.Resolve(ctx, \"principal\")\n\t}\n",
+				`"deny_reason": "field id is not set and resolver \"principal\" returned no value",`,
+			},
+		},
+		{
+			name: "resolver only",
+			expected: []string{
+				"\ttenantId := resolver.Resolve(ctx, \"principal\")\n\tif tenantId == \"\" {\n",
+				`"deny_reason": "resolver \"principal\" returned no value",`,
+			},
+		},
+		{
+			name:     "strict",
+			strict:   true,
+			field:    true,
+			expected: []string{`"deny_reason": "field id is not set and resolver \"principal\" returned no value",`},
+		},
+		{
+			name:     "strict resolver only",
+			strict:   true,
+			expected: []string{`"deny_reason": "resolver \"principal\" returned no value",`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := newTestGeneratedFile(t)
+			options := NewOptions()
+			options.Strict = tt.strict
+			resource := &Resource{file: file, options: options, TenantResolver: principal}
+			if tt.field {
+				resource.TenantIdPath = NewRootPathBuilder("resource", file).AddField(draft.Fields[0]).Build()
+			}
+
+			file.P("func f() pkg.CheckConfig {")
+			resource.renderTenantId(1)
+			file.P("}")
+
+			content, err := file.Content()
+			require.NoError(t, err)
+			for _, expected := range tt.expected {
+				assert.Contains(t, string(content), expected)
+			}
+			// A resolver that resolves nothing never falls back to the default tenant
+			assert.NotContains(t, string(content), `"default"`)
+		})
+	}
+}
+
+func TestMethodGenerateChecksContext(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		options:     NewOptions(),
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "GetDraftRequest",
+		Resource: &Resource{
+			file:           file,
+			options:        NewOptions(),
+			Type:           "Draft",
+			Path:           NewRootPathBuilder("req", file).Build(),
+			TenantResolver: &Resolver{Name: "tenant", ContextKey: true},
+		},
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	code := string(content)
+	assert.Contains(t, code, "func (req *GetDraftRequest) GetChecks() pkg.CheckConfig {\n\treturn req.GetChecksContext(context.Background())\n}")
+	assert.Contains(t, code, "// The tenant of Draft comes from context key \"tenant\", then denied.\n")
+	assert.Contains(t, code, "func (req *GetDraftRequest) GetChecksContext(ctx context.Context) pkg.CheckConfig {")
+	assert.Contains(t, code, "tenantId := resolver.Value(ctx, \"tenant\")")
+}
+
+func TestMethodGenerateWithoutResolvers(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	method := &Method{
+		file:        file,
+		options:     NewOptions(),
+		Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
+		Permission:  "read",
+		RequestType: "GetDraftRequest",
+		Resource:    &Resource{file: file, options: NewOptions(), Type: "Draft", Path: NewRootPathBuilder("req", file).Build()},
+	}
+
+	method.Generate()
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "GetChecksContext")
+}
+
+// resolvedDescriptor describes test/v1/resolved.proto, whose GetDraft method checks a resource with a tenant_resolver.
+const resolvedDescriptor = `
+name: "test/v1/resolved.proto"
+package: "test.v1"
+syntax: "proto3"
+options { go_package: "test/v1;testv1" }
+message_type {
+  name: "GetDraftRequest"
+  options {
+    [nrf110.permify.v1.resource_type]: "Draft"
+    [nrf110.permify.plugin.v1.tenant_resolver] { context_key: "tenant" }
+  }
+  field {
+    name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id"
+    options { [nrf110.permify.v1.resource_id]: true }
+  }
+}
+service {
+  name: "Drafts"
+  method {
+    name: "GetDraft" input_type: ".test.v1.GetDraftRequest" output_type: ".test.v1.GetDraftRequest"
+    options { [nrf110.permify.v1.permission]: "read" }
+  }
+}
+`
+
+func TestNewMethodRequiresContextChecksForResolvers(t *testing.T) {
+	log := util.Log
+	util.Log = stdlog.New(io.Discard, "", 0)
+	t.Cleanup(func() { util.Log = log })
+
+	tests := []struct {
+		name          string
+		contextChecks bool
+		err           string
+	}{
+		{
+			name: "without context_checks",
+			err:  "method GetDraft in service Drafts has resolvers, which need the context_checks parameter and an interceptor calling GetChecksContext",
+		},
+		{
+			name:          "with context_checks",
+			contextChecks: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var file descriptorpb.FileDescriptorProto
+			require.NoError(t, prototext.Unmarshal([]byte(resolvedDescriptor), &file))
+			plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+				FileToGenerate: []string{file.GetName()},
+				ProtoFile:      []*descriptorpb.FileDescriptorProto{&file},
+			})
+			require.NoError(t, err)
+			options := NewOptions()
+			options.ContextChecks = tt.contextChecks
+
+			NewMethod(plugin, newTestGeneratedFile(t), plugin.FilesByPath[file.GetName()].Services[0].Methods[0], options)
+
+			assert.Equal(t, tt.err, plugin.Response().GetError())
+		})
+	}
+}
@@ -2,76 +2,147 @@ package model
 
 import (
 	"fmt"
-	"maps"
 	"slices"
 	"strings"
 
 	permifyv1 "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
 	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/runtime/protoimpl"
 )
 
 type Resource struct {
 	file           *protogen.GeneratedFile
+	options        *Options
 	GoName         string
 	Type           string
 	Path           *Path
 	IdPath         *Path
 	TenantIdPath   *Path
 	AttributePaths map[string]*Path
+	Oneof          *Oneof
+	Parent         *Parent
+	// AllowEmptyId checks the resource with an empty id in strict mode.
+	AllowEmptyId bool
+	// TenantResolver and IdResolver read the tenant and id from the context when the request doesn't carry them.
+	TenantResolver *Resolver
+	IdResolver     *Resolver
+	// InheritedTenant is the tenant of the resource when it has no tenant_id field of its own.
+	InheritedTenant *InheritedTenant
+	// permissionCount is the number of permissions each entity is checked for. With more than one, the entity is
+	// checked once for every permission in the permissions variable, rather than for the permission variable.
+	permissionCount int
+	// maxChecks denies the request before ranging over a collection that would take it past this many checks, when
+	// it's not 0.
+	maxChecks uint
+	// location is the field path from the request to the root of Path, which is the request itself unless the
+	// resource is in a oneof case.
+	location string
+	// scopes holds, while the resource is generated, the variables binding the elements of the collections and oneof
+	// cases entered so far, after "" for the request.
+	scopes []string
 }
 
-func NewResource(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Message) *Resource {
+func NewResource(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Message, options *Options) *Resource {
 	util.Log.Println("finding resource")
-	return findResourcePath(plugin, file, pb, NewRootPathBuilder("req", file))
+	return findResourcePath(plugin, file, options, pb, NewRootPathBuilder("req", file), ancestry{})
 }
 
 func (resource *Resource) Generate(nestingLevel int) {
-	resource.checksFromResources(resource.Path, nestingLevel)
+	if resource.scopes == nil {
+		resource.scopes = []string{""}
+	}
+	resource.checksFromResources(resource.Path, nestingLevel, nil)
+}
+
+// mapKey is the key of a map whose keys are the ids of the resources it holds, bound to a loop variable.
+type mapKey struct {
+	varName string
+	field   *protogen.Field
 }
 
-func findResourcePath(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Message, path *PathBuilder) *Resource {
-	options := pb.Desc.Options()
-	if proto.HasExtension(options, permifyv1.E_ResourceType) {
+func findResourcePath(plugin *protogen.Plugin, file *protogen.GeneratedFile, options *Options, pb *protogen.Message, path *PathBuilder, ancestry ancestry) *Resource {
+	messageOptions := pb.Desc.Options()
+	if proto.HasExtension(messageOptions, permifyv1.E_ResourceType) {
 		util.Log.Println("found resource type in", pb.GoIdent.String())
-		resourceType := proto.GetExtension(options, permifyv1.E_ResourceType).(string)
-		return &Resource{
+		resourceType := proto.GetExtension(messageOptions, permifyv1.E_ResourceType).(string)
+		resource := &Resource{
 			file:           file,
+			options:        options,
 			GoName:         pb.GoIdent.GoName,
 			Type:           resourceType,
 			Path:           path.Build(),
+			location:       ancestry.location,
 			IdPath:         findPath(plugin, pb, permifyv1.E_ResourceId, NewRootPathBuilder("resource", file)),
 			TenantIdPath:   findPath(plugin, pb, permifyv1.E_TenantId, NewRootPathBuilder("resource", file)),
-			AttributePaths: findAttributes(plugin, pb, NewRootPathBuilder("resource", file), make(map[string]*Path)),
+			AttributePaths: findAttributes(plugin, pb, permifyv1.E_AttributeName, NewRootPathBuilder("resource", file), make(map[string]*Path)),
+			Parent:         findParent(plugin, file, pb),
+			AllowEmptyId:   util.GetBoolExtension(pb.Desc, pluginv1.E_AllowEmptyId),
+			TenantResolver: findResolver(plugin, pb, pluginv1.E_TenantResolver),
+			IdResolver:     findResolver(plugin, pb, pluginv1.E_IdResolver),
+		}
+		if resource.TenantIdPath == nil {
+			resource.InheritedTenant = ancestry.tenant
+		}
+		if options.Strict && !resource.AllowEmptyId && !resource.hasIdSource() &&
+			(resource.Parent == nil || !resource.Parent.ReplacesResource()) {
+			plugin.Error(fmt.Errorf("resource %s has no id, which strict mode requires unless it sets allow_empty_id", pb.Desc.FullName()))
+		}
+		if options.Strict && resource.TenantIdPath == nil && resource.InheritedTenant == nil && resource.TenantResolver == nil {
+			plugin.Error(fmt.Errorf("resource %s has no tenant_id field, inherited tenant or tenant_resolver, which strict mode requires",
+				pb.Desc.FullName()))
 		}
+		return resource
 	}
 
+	ancestry = ancestry.enclosing(plugin, pb, path)
 	for _, field := range pb.Fields {
 		util.Log.Println("checking field", field.GoName)
-		if !field.Desc.HasOptionalKeyword() {
-			if util.IsMessageValueMap(field) {
-				util.Log.Println(field.GoName, "is a map of messages")
-				result := findResourcePath(plugin, file, util.GetMapFieldValue(field), NewPathBuilder(path.AddField(field)))
-				if result != nil {
+		if util.IsOneofField(field) {
+			// A oneof is searched as a whole, when its first case is reached
+			if field == field.Oneof.Fields[0] {
+				if result := findOneofResource(plugin, file, options, field.Oneof, path, ancestry); result != nil {
 					return result
 				}
 			}
+			continue
+		}
 
-			if util.IsMessage(field) {
-				util.Log.Println(field.GoName, "is a message")
-				if field.Desc.IsList() {
-					util.Log.Println(field.GoName, "is a repeated message")
-					if result := findResourcePath(plugin, file, field.Message, NewPathBuilder(path.AddField(field))); result != nil {
-						return result
-					}
-				}
+		if util.GetBoolExtension(field.Desc, pluginv1.E_MapKeyResourceId) {
+			if !util.IsMessageValueMap(field) {
+				plugin.Error(fmt.Errorf("%s must be a map of messages to use its key as the resource id", field.GoName))
+			} else if !util.IsIdKind(field.Desc.MapKey().Kind()) {
+				plugin.Error(fmt.Errorf("%s must have a string or integer key to use it as the resource id", field.GoName))
+			} else if value := util.GetMapFieldValue(field); !proto.HasExtension(value.Desc.Options(), permifyv1.E_ResourceType) {
+				// The key is the id of the map's values, never of resources nested within them
+				plugin.Error(fmt.Errorf("%s must be a map of resources to use its key as the resource id, and %s isn't one",
+					field.GoName, value.Desc.FullName()))
+			}
+		}
 
-				if result := findResourcePath(plugin, file, field.Message, path.AddField(field)); result != nil {
+		if util.IsMessageValueMap(field) {
+			util.Log.Println(field.GoName, "is a map of messages")
+			result := findResourcePath(plugin, file, options, util.GetMapFieldValue(field), NewPathBuilder(path.AddField(field)), ancestry)
+			if result != nil {
+				return result
+			}
+		}
+
+		if util.IsMessage(field) {
+			util.Log.Println(field.GoName, "is a message")
+			if field.Desc.IsList() {
+				util.Log.Println(field.GoName, "is a repeated message")
+				if result := findResourcePath(plugin, file, options, field.Message, NewPathBuilder(path.AddField(field)), ancestry); result != nil {
 					return result
 				}
 			}
+
+			if result := findResourcePath(plugin, file, options, field.Message, path.AddField(field), ancestry); result != nil {
+				return result
+			}
 		}
 	}
 
@@ -88,7 +159,7 @@ func findPath(plugin *protogen.Plugin, pb *protogen.Message, extension *protoimp
 		}
 
 		if util.IsMessage(field) {
-			if field.Desc.IsList() || field.Desc.IsMap() {
+			if field.Desc.IsList() || field.Desc.IsMap() || util.IsOneofField(field) {
 				continue
 			}
 
@@ -101,60 +172,427 @@ func findPath(plugin *protogen.Plugin, pb *protogen.Message, extension *protoimp
 	return nil
 }
 
-func findAttributes(plugin *protogen.Plugin, pb *protogen.Message, path *PathBuilder, accum map[string]*Path) map[string]*Path {
+// findAttributes collects the paths to the fields of pb annotated with ext, which names the attribute each one is
+// sent as.
+func findAttributes(plugin *protogen.Plugin, pb *protogen.Message, ext protoreflect.ExtensionType, path *PathBuilder, accum map[string]*Path) map[string]*Path {
 	for _, field := range pb.Fields {
-		if found, attributeName := util.GetStringExtension(field.Desc, permifyv1.E_AttributeName); found {
-			accum[attributeName] = path.AddField(field).Build()
+		if found, attributeName := util.GetStringExtension(field.Desc, ext); found {
+			attributePath := path.AddField(field).Build()
+			if err := validateAttribute(attributePath); err != nil {
+				plugin.Error(fmt.Errorf("attribute %s on %s: %w", attributeName, field.Desc.FullName(), err))
+			}
+			accum[attributeName] = attributePath
 			continue
 		}
 
 		if util.IsMessageValueMap(field) {
-			findAttributes(plugin, util.GetMapFieldValue(field), NewPathBuilder(path.AddField(field)), accum)
+			findAttributes(plugin, util.GetMapFieldValue(field), ext, NewPathBuilder(path.AddField(field)), accum)
+			continue
+		}
+
+		if util.IsOneofField(field) {
 			continue
 		}
 
 		if util.IsMessage(field) {
 			if field.Desc.IsList() {
-				findAttributes(plugin, field.Message, NewPathBuilder(path.AddField(field)), accum)
+				findAttributes(plugin, field.Message, ext, NewPathBuilder(path.AddField(field)), accum)
 			} else {
-				findAttributes(plugin, field.Message, path.AddField(field), accum)
+				findAttributes(plugin, field.Message, ext, path.AddField(field), accum)
 			}
 		}
 	}
 	return accum
 }
 
-func (resource *Resource) checksFromResources(remainingPath *Path, nestingLevel int) {
+func (resource *Resource) checksFromResources(remainingPath *Path, nestingLevel int, key *mapKey) {
 	file := resource.file
 	if remainingPath.Child != nil {
-		if remainingPath.Child.Child != nil || resource.IdPath != nil || resource.TenantIdPath != nil {
+		// A key only identifies the resources held directly by its map
+		keyName := "_"
+		key = nil
+		if isKeyedMap(remainingPath.Field) {
+			key = &mapKey{
+				varName: util.VariableName(),
+				field:   remainingPath.Field.Message.Fields[0],
+			}
+			keyName = key.varName
+		}
+
+		if remainingPath.Child.Child != nil || resource.usesResource() || resource.bindsScope(len(resource.scopes)) {
 			varName := util.VariableName()
-			file.P(util.Indent(nestingLevel), "for _, ", varName, " := range ", remainingPath.Path, " {")
-			resource.checksFromResources(remainingPath.Child.WithPrefix(varName), nestingLevel+1)
+			resource.renderRange(remainingPath, nestingLevel, keyName, varName)
+			resource.scopes = append(resource.scopes, varName)
+			resource.checksFromResources(remainingPath.Child.WithPrefix(varName), nestingLevel+1, key)
 		} else {
-			file.P(util.Indent(nestingLevel), "for range ", remainingPath.Path, " {")
-			resource.checksFromResources(remainingPath.Child, nestingLevel+1)
+			resource.renderRange(remainingPath, nestingLevel, keyName, "_")
+			resource.scopes = append(resource.scopes, "_")
+			resource.checksFromResources(remainingPath.Child, nestingLevel+1, key)
 		}
+		resource.scopes = resource.scopes[:len(resource.scopes)-1]
 		file.P(util.Indent(nestingLevel), "}")
+	} else if remainingPath.Presence != nil {
+		resource.renderPresence(remainingPath, nestingLevel, key)
 	} else {
-		if resource.IdPath != nil || resource.TenantIdPath != nil || len(resource.AttributePaths) > 0 {
-			file.P(util.Indent(nestingLevel), "resource := ", remainingPath.Path)
+		resource.renderResource(remainingPath.Path, nestingLevel, key)
+	}
+}
+
+// renderRange opens a loop over the collection at path, binding keyName and valueName, either of which may be "_".
+// With sorted_maps, a map whose entries are used is ranged over in key order.
+func (resource *Resource) renderRange(path *Path, nestingLevel int, keyName string, valueName string) {
+	if resource.maxChecks > 0 {
+		// Each element adds at least one check, so the collection's length alone can exceed the limit
+		resource.file.P(util.Indent(nestingLevel), "if len(checks)+len(", path.Path, ") > ", resource.maxChecks, " {")
+		renderDeny(resource.file, nestingLevel+1, maxChecksReason(resource.maxChecks))
+		resource.file.P(util.Indent(nestingLevel), "}")
+	}
+	renderRange(resource.file, resource.options, path, nestingLevel, keyName, valueName)
+}
+
+// maxChecksReason is the deny reason of a request exceeding maxChecks.
+func maxChecksReason(maxChecks uint) string {
+	return fmt.Sprintf("request has more than %d checks", maxChecks)
+}
+
+func renderRange(file *protogen.GeneratedFile, options *Options, path *Path, nestingLevel int, keyName string, valueName string) {
+	isMap := path.Field != nil && path.Field.Desc.IsMap()
+
+	switch {
+	case keyName == "_" && valueName == "_":
+		file.P(util.Indent(nestingLevel), "for range ", path.Path, " {")
+	case isMap && options != nil && options.SortedMaps && path.Field.Message.Fields[0].Desc.Kind() == protoreflect.BoolKind:
+		// Bool keys aren't ordered, so the only two keys are looked up in order instead
+		if keyName == "_" {
+			keyName = util.VariableName()
+		}
+		file.P(util.Indent(nestingLevel), "for _, ", keyName, " := range []bool{false, true} {")
+		file.P(util.Indent(nestingLevel+1), valueName, ", ok := ", path.Path, "[", keyName, "]")
+		file.P(util.Indent(nestingLevel+1), "if !ok {")
+		file.P(util.Indent(nestingLevel+2), "continue")
+		file.P(util.Indent(nestingLevel+1), "}")
+	case isMap && options != nil && options.SortedMaps:
+		if keyName == "_" {
+			keyName = util.VariableName()
+		}
+		sorted := file.QualifiedGoIdent(protogen.GoIdent{GoName: "Sorted", GoImportPath: "slices"})
+		keys := file.QualifiedGoIdent(protogen.GoIdent{GoName: "Keys", GoImportPath: "maps"})
+		file.P(util.Indent(nestingLevel), "for _, ", keyName, " := range ", sorted, "(", keys, "(", path.Path, ")) {")
+		if valueName != "_" {
+			file.P(util.Indent(nestingLevel+1), valueName, " := ", path.Path, "[", keyName, "]")
 		}
+	case valueName == "_":
+		file.P(util.Indent(nestingLevel), "for ", keyName, " := range ", path.Path, " {")
+	default:
+		file.P(util.Indent(nestingLevel), "for ", keyName, ", ", valueName, " := range ", path.Path, " {")
+	}
+}
 
-		var idPath string
-		file.P(util.Indent(nestingLevel), `var id string`)
-		if resource.IdPath != nil {
-			util.Log.Printf("rendering id path for %v", resource.IdPath)
-			resource.renderIdPath(resource.IdPath, nestingLevel, "id")
+// isKeyedMap reports whether field is a map whose keys are the ids of the resources it holds.
+func isKeyedMap(field *protogen.Field) bool {
+	return field != nil && field.Desc.IsMap() && util.GetBoolExtension(field.Desc, pluginv1.E_MapKeyResourceId)
+}
+
+func (resource *Resource) renderResource(resourcePath string, nestingLevel int, key *mapKey) {
+	file := resource.file
+	if resource.Oneof != nil {
+		resource.Oneof.Generate(resourcePath, nestingLevel, resource.scopes)
+		return
+	}
+
+	if resource.usesResource() {
+		file.P(util.Indent(nestingLevel), "resource := ", resourcePath)
+	}
+
+	hasId := key != nil || resource.IdPath != nil || resource.IdResolver != nil
+	if resource.Parent != nil && resource.Parent.ReplacesResource() && !hasId {
+		// Without an id the resource itself is never checked, only its parent
+		resource.renderTenantId(nestingLevel)
+		resource.Parent.renderCheck(resource, nestingLevel)
+		return
+	}
+
+	var idPath string
+	file.P(util.Indent(nestingLevel), `var id string`)
+	if key != nil {
+		file.P(util.Indent(nestingLevel), "if ", key.varName, idPresence(key.field), " {")
+		file.P(util.Indent(nestingLevel+1), "id = ", renderIdValue(file, key.varName, key.field))
+		file.P(util.Indent(nestingLevel), "}")
+	} else if resource.IdPath != nil {
+		util.Log.Printf("rendering id path for %v", resource.IdPath)
+		resource.renderIdPath(resource.IdPath, nestingLevel, "id")
+	}
+	if resource.IdResolver != nil {
+		resource.IdResolver.render(file, nestingLevel, "id")
+	}
+	if resource.Parent == nil || !resource.Parent.ReplacesResource() {
+		resource.renderIdGuard(nestingLevel, key)
+	}
+	resource.renderTenantId(nestingLevel)
+	resource.renderAttributes(nestingLevel)
+
+	switch {
+	case resource.Parent == nil:
+		resource.renderCheck(nestingLevel, idPath)
+	case resource.Parent.ReplacesResource():
+		file.P(util.Indent(nestingLevel), `if id == "" {`)
+		resource.Parent.renderCheck(resource, nestingLevel+1)
+		file.P(util.Indent(nestingLevel), "} else {")
+		resource.renderCheck(nestingLevel+1, idPath)
+		file.P(util.Indent(nestingLevel), "}")
+	default:
+		resource.renderCheck(nestingLevel, idPath)
+		resource.Parent.renderCheck(resource, nestingLevel)
+	}
+}
+
+// configureChecks applies the method's settings for its checks to the resource and to those in the cases of its
+// oneof.
+func (resource *Resource) configureChecks(permissionCount int, maxChecks uint) {
+	resource.permissionCount = permissionCount
+	resource.maxChecks = maxChecks
+	if resource.Oneof != nil {
+		for _, c := range resource.Oneof.Cases {
+			c.Resource.configureChecks(permissionCount, maxChecks)
+		}
+	}
+}
+
+// parentPermission returns the permission of the first parent, of the resource or in the cases of its oneof, that
+// has one of its own. Its check wouldn't line up with the consecutive checks of several permissions.
+func (resource *Resource) parentPermission() string {
+	if resource.Oneof != nil {
+		for _, c := range resource.Oneof.Cases {
+			if permission := c.Resource.parentPermission(); permission != "" {
+				return permission
+			}
 		}
+		return ""
+	}
+	if resource.Parent == nil {
+		return ""
+	}
+	return resource.Parent.Permission
+}
+
+// multiplePermissions reports whether each entity is checked for the permissions variable.
+func (resource *Resource) multiplePermissions() bool {
+	return resource.permissionCount > 1
+}
+
+// checksPerEntity returns the most checks the resource adds for each entity it holds.
+func (resource *Resource) checksPerEntity() int {
+	if resource.Oneof != nil {
+		most := 0
+		for _, c := range resource.Oneof.Cases {
+			most = max(most, c.Resource.checksPerEntity())
+		}
+		return most
+	}
+	count := max(resource.permissionCount, 1)
+	if resource.Parent != nil && !resource.Parent.ReplacesResource() {
+		if resource.Parent.Permission != "" {
+			count++
+		} else {
+			count += max(resource.permissionCount, 1)
+		}
+	}
+	return count
+}
+
+// checkCapacity returns an expression for the number of checks the resource adds to a request, to preallocate them
+// with. Only the outermost collection's length is known up front, so nested collections may still grow the checks.
+// It's empty when the collection is behind a presence check, and can't be read before reaching it.
+func (resource *Resource) checkCapacity() string {
+	perEntity := resource.checksPerEntity()
+	for path := resource.Path; path != nil && path.Child != nil; path = path.Child {
+		if path.Presence != nil {
+			return ""
+		}
+		if path.Field != nil && (path.Field.Desc.IsList() || path.Field.Desc.IsMap()) {
+			capacity := "len(" + path.Path + ")"
+			if perEntity > 1 {
+				capacity = fmt.Sprintf("%s*%d", capacity, perEntity)
+			}
+			if resource.maxChecks > 0 {
+				// Larger requests are denied, so there's no use allocating for them
+				capacity = fmt.Sprintf("min(%s, %d)", capacity, resource.maxChecks)
+			}
+			return capacity
+		}
+	}
+	return fmt.Sprint(perEntity)
+}
+
+// hasIdSource reports whether the resource's id is read from a field or from the key of a map holding it.
+func (resource *Resource) hasIdSource() bool {
+	if resource.IdPath != nil || resource.IdResolver != nil {
+		return true
+	}
+	for path := resource.Path; path != nil; path = path.Child {
+		if isKeyedMap(path.Field) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderIdGuard denies the request when the id of the resource is empty, in strict mode or when its id resolver
+// resolved nothing, unless the resource allows an empty id.
+func (resource *Resource) renderIdGuard(nestingLevel int, key *mapKey) {
+	if resource.AllowEmptyId || (!resource.strict() && resource.IdResolver == nil) {
+		return
+	}
+	var missing string
+	if key != nil {
+		missing = fmt.Sprintf("map %s has an empty key", resource.fieldPath(nil))
+	} else if resource.IdPath != nil {
+		missing = unsetReason(resource.fieldPath(resource.IdPath))
+	} else if resource.IdResolver == nil {
+		return
+	}
+	renderEmptyGuard(resource.file, nestingLevel, "id", sourcesReason(missing, resource.IdResolver))
+}
+
+// fieldPath returns the field path in the request of the field that path, relative to the resource, leads to, or of
+// the resource itself when path is nil.
+func (resource *Resource) fieldPath(path *Path) string {
+	return joinFieldPath(resource.location, resource.Path.FieldPath(), path.FieldPath())
+}
+
+// presenceFieldPath returns the field path in the request of the field tracking the presence of path, a node of the
+// resource's path.
+func (resource *Resource) presenceFieldPath(path *Path) string {
+	names := []string{resource.location}
+	for node := resource.Path; node != nil && node != path; node = node.Child {
+		names = append(names, fieldNames(node.Fields))
+	}
+	presence := slices.Index(path.Fields, path.Presence)
+	return joinFieldPath(append(names, fieldNames(path.Fields[:presence+1]))...)
+}
+
+// renderTenantId declares tenantId. Resources without a tenant field or resolver use the default tenant, which strict
+// mode never generates, as do those whose tenant field is unset outside of strict mode. A resolver that resolves
+// nothing denies the request, rather than falling back to the default tenant.
+func (resource *Resource) renderTenantId(nestingLevel int) {
+	file := resource.file
+	resolver := resource.TenantResolver
+	tenantPath := resource.tenantPath()
+	if tenantPath == nil && resolver == nil {
 		file.P(util.Indent(nestingLevel), `tenantId := "default"`)
-		if resource.TenantIdPath != nil {
-			resource.renderIdPath(resource.TenantIdPath, nestingLevel, "tenantId")
+		return
+	}
+	if !resource.strict() && resolver == nil {
+		file.P(util.Indent(nestingLevel), `tenantId := "default"`)
+		resource.renderIdPath(tenantPath, nestingLevel, "tenantId")
+		return
+	}
+
+	// Each source is tried in turn while the tenant is still unset
+	var missing string
+	if tenantPath == nil {
+		file.P(util.Indent(nestingLevel), "tenantId := ", resolver.expression(file))
+	} else {
+		missing = unsetReason(resource.tenantFieldPath())
+		file.P(util.Indent(nestingLevel), `var tenantId string`)
+		resource.renderIdPath(tenantPath, nestingLevel, "tenantId")
+		if resolver != nil {
+			resolver.render(file, nestingLevel, "tenantId")
 		}
-		resource.renderAttributes(nestingLevel)
-		resource.renderCheck(nestingLevel, idPath)
-		file.P(util.Indent(nestingLevel), "checks = append(checks, check)")
 	}
+	renderEmptyGuard(file, nestingLevel, "tenantId", sourcesReason(missing, resolver))
+}
+
+func (resource *Resource) strict() bool {
+	return resource.options != nil && resource.options.Strict
+}
+
+// renderEmptyGuard denies the request for reason when variable is empty.
+func renderEmptyGuard(file *protogen.GeneratedFile, nestingLevel int, variable string, reason string) {
+	file.P(util.Indent(nestingLevel), "if ", variable, ` == "" {`)
+	renderDeny(file, nestingLevel+1, reason)
+	file.P(util.Indent(nestingLevel), "}")
+}
+
+// renderPresence guards a resource held in a field that tracks presence, handling it according to the field's
+// missing resource policy when any message on the way to it is unset.
+func (resource *Resource) renderPresence(path *Path, nestingLevel int, key *mapKey) {
+	file := resource.file
+
+	var present, missing []string
+	segments := strings.Split(path.Path, ".")
+	for idx := 1; idx < len(segments); idx++ {
+		segment := strings.Join(segments[:idx+1], ".")
+		present = append(present, segment+" != nil")
+		missing = append(missing, segment+" == nil")
+	}
+
+	switch resource.missingResourcePolicy(path.Presence) {
+	case pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_SKIP:
+		file.P(util.Indent(nestingLevel), "if ", strings.Join(present, " && "), " {")
+		resource.renderResource(path.Path, nestingLevel+1, key)
+		file.P(util.Indent(nestingLevel), "}")
+	case pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_DENY:
+		file.P(util.Indent(nestingLevel), "if ", strings.Join(missing, " || "), " {")
+		renderDeny(file, nestingLevel+1, unsetReason(resource.presenceFieldPath(path)))
+		file.P(util.Indent(nestingLevel), "}")
+		resource.renderResource(path.Path, nestingLevel, key)
+	default:
+		file.P(util.Indent(nestingLevel), "if ", strings.Join(present, " && "), " {")
+		resource.renderResource(path.Path, nestingLevel+1, key)
+		file.P(util.Indent(nestingLevel), "} else {")
+		resource.renderMissing(nestingLevel+1, key, resource.scopes, unsetReason(resource.presenceFieldPath(path)))
+		file.P(util.Indent(nestingLevel), "}")
+	}
+}
+
+// renderMissing handles the resource when the field holding it isn't set. Without the resource, its type is still
+// checked, with an empty id and the default tenant, unless strict mode denies the request for reason. The tenant is
+// only inherited from the messages bound by scopes.
+func (resource *Resource) renderMissing(nestingLevel int, key *mapKey, scopes []string, reason string) {
+	if resource.Oneof != nil {
+		resource.Oneof.renderMissing(nestingLevel, scopes)
+		return
+	}
+	if resource.strict() && !resource.AllowEmptyId {
+		renderDeny(resource.file, nestingLevel, reason)
+		return
+	}
+	inheritedTenant := resource.InheritedTenant
+	if inheritedTenant != nil && inheritedTenant.Scope >= len(scopes) {
+		inheritedTenant = nil
+	}
+	missingResource := &Resource{
+		file:            resource.file,
+		options:         resource.options,
+		Type:            resource.Type,
+		AllowEmptyId:    resource.AllowEmptyId,
+		TenantResolver:  resource.TenantResolver,
+		IdResolver:      resource.IdResolver,
+		InheritedTenant: inheritedTenant,
+		permissionCount: resource.permissionCount,
+		scopes:          scopes,
+	}
+	missingResource.renderResource("", nestingLevel, key)
+}
+
+func (resource *Resource) missingResourcePolicy(field *protogen.Field) pluginv1.MissingResourcePolicy {
+	opts := field.Desc.Options()
+	if proto.HasExtension(opts, pluginv1.E_MissingResource) {
+		if policy := proto.GetExtension(opts, pluginv1.E_MissingResource).(pluginv1.MissingResourcePolicy); policy != pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_UNSPECIFIED {
+			return policy
+		}
+	}
+	if resource.options != nil {
+		return resource.options.MissingResource
+	}
+	return pluginv1.MissingResourcePolicy_MISSING_RESOURCE_POLICY_CHECK
+}
+
+// usesResource reports whether the code generated for the resource reads the value at the end of its path.
+func (resource *Resource) usesResource() bool {
+	return resource.Oneof != nil || resource.IdPath != nil || resource.TenantIdPath != nil ||
+		len(resource.AttributePaths) > 0 || resource.Parent != nil
 }
 
 func (resource *Resource) renderIdPath(path *Path, nestingLevel int, varName string) {
@@ -163,10 +601,70 @@ func (resource *Resource) renderIdPath(path *Path, nestingLevel int, varName str
 		file = resource.file
 	)
 	file.P(util.Indent(nestingLevel), "if ", resource.renderNilChecks(&sb, path, ""), "{")
-	file.P(util.Indent(nestingLevel+1), varName, " = ", path)
+	file.P(util.Indent(nestingLevel+1), varName, " = ", renderIdValue(file, path.String(), path.Leaf().Field))
 	file.P(util.Indent(nestingLevel), "}")
 }
 
+// renderIdValue renders expr, the Go expression for an id field, as a string, unwrapping well-known wrapper types.
+func renderIdValue(file *protogen.GeneratedFile, expr string, field *protogen.Field) string {
+	if field == nil {
+		return expr
+	}
+	if util.IsWrapper(field) {
+		expr += ".Value"
+	} else if util.IsOptionalScalar(field) {
+		expr = "*" + expr
+	}
+	return renderIdConversion(file, expr, util.ValueKind(field))
+}
+
+func renderIdConversion(file *protogen.GeneratedFile, expr string, kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return fmt.Sprintf("%s(%s, 10)", file.QualifiedGoIdent(strconvIdent("FormatInt")), expr)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return fmt.Sprintf("%s(int64(%s), 10)", file.QualifiedGoIdent(strconvIdent("FormatInt")), expr)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return fmt.Sprintf("%s(%s, 10)", file.QualifiedGoIdent(strconvIdent("FormatUint")), expr)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return fmt.Sprintf("%s(uint64(%s), 10)", file.QualifiedGoIdent(strconvIdent("FormatUint")), expr)
+	default:
+		return expr
+	}
+}
+
+// idConditions returns the conditions under which the id at the end of path, a path without collections, is set:
+// every message traversed to reach it must be set, as must the id itself.
+func idConditions(path *Path) []string {
+	var conditions []string
+	segments := strings.Split(path.Path, ".")
+	for idx := 1; idx < len(segments)-1; idx++ {
+		conditions = append(conditions, strings.Join(segments[:idx+1], ".")+" != nil")
+	}
+	return append(conditions, path.Path+idPresence(path.Field))
+}
+
+// idPresence renders the comparison that tells whether an id field has been set.
+func idPresence(field *protogen.Field) string {
+	switch {
+	case field == nil:
+		return ` != ""`
+	case util.IsWrapper(field), util.IsOptionalScalar(field):
+		return " != nil"
+	case util.ValueKind(field) == protoreflect.StringKind:
+		return ` != ""`
+	default:
+		return " != 0"
+	}
+}
+
+func strconvIdent(name string) protogen.GoIdent {
+	return protogen.GoIdent{
+		GoName:       name,
+		GoImportPath: "strconv",
+	}
+}
+
 func (resource *Resource) renderNilChecks(sb *strings.Builder, remainingPath *Path, checkedPath string) string {
 	var cumulativePath string
 	if checkedPath == "" {
@@ -175,67 +673,57 @@ func (resource *Resource) renderNilChecks(sb *strings.Builder, remainingPath *Pa
 		cumulativePath = fmt.Sprintf("%s.%s", checkedPath, remainingPath.Path)
 	}
 
-	sb.WriteString(cumulativePath)
 	if remainingPath.Child != nil {
+		sb.WriteString(cumulativePath)
 		sb.WriteString(" != nil &&")
 		return resource.renderNilChecks(sb, remainingPath.Child, cumulativePath)
-	} else {
-		sb.WriteString(` != ""`)
 	}
-	return sb.String()
-}
-
-func (resource *Resource) renderAttributes(nestingLevel int) {
-	file := resource.file
 
-	file.P(util.Indent(nestingLevel), "attributes := make(map[string]any)")
-	keys := maps.Keys(resource.AttributePaths)
-	sortedKeys := slices.Sorted(keys)
-	for _, name := range sortedKeys {
-		resource.renderAttribute(name, resource.AttributePaths[name], nestingLevel, false)
+	// Message fields traversed within the last node also need checking before the id is dereferenced.
+	if checkedPath != "" {
+		segments := strings.Split(remainingPath.Path, ".")
+		intermediatePath := checkedPath
+		for _, segment := range segments[:len(segments)-1] {
+			intermediatePath = fmt.Sprintf("%s.%s", intermediatePath, segment)
+			sb.WriteString(intermediatePath)
+			sb.WriteString(" != nil &&")
+		}
 	}
+	sb.WriteString(cumulativePath)
+	sb.WriteString(idPresence(remainingPath.Field))
+	return sb.String()
 }
 
-func (resource *Resource) renderAttribute(name string, remainingPath *Path, nestingLevel int, isNested bool) {
-	file := resource.file
-
-	if remainingPath.Child != nil {
-		// We have a nested collection, need to collect values into a slice
-		varName := util.VariableName()
-		if !isNested {
-			// First time entering a collection, initialize the slice
-			file.P(util.Indent(nestingLevel), "var ", name, "Values []any")
-		}
-		file.P(util.Indent(nestingLevel), "for _, ", varName, " := range ", remainingPath.Path, "{")
-		resource.renderAttribute(name, remainingPath.Child.WithPrefix(varName), nestingLevel+1, true)
-		file.P(util.Indent(nestingLevel), "}")
-		if !isNested {
-			// After collecting all values, assign to attributes
-			file.P(util.Indent(nestingLevel), `if len(`, name, `Values) > 0 {`)
-			file.P(util.Indent(nestingLevel+1), `attributes["`, name, `"] = `, name, `Values`)
-			file.P(util.Indent(nestingLevel), "}")
-		}
-	} else {
-		if isNested {
-			// We're inside a collection, append to the slice
-			file.P(util.Indent(nestingLevel), name, `Values = append(`, name, `Values, `, remainingPath.Path, `)`)
-		} else {
-			// Direct assignment for non-nested attributes
-			file.P(util.Indent(nestingLevel), `attributes["`, name, `"] = `, remainingPath.Path)
-		}
+// renderAttributes declares and fills the attributes of the check, unless the resource has none.
+func (resource *Resource) renderAttributes(nestingLevel int) {
+	if len(resource.AttributePaths) == 0 {
+		return
 	}
+	renderer := &attributeRenderer{file: resource.file, options: resource.options, variable: "attributes"}
+	renderer.render(resource.AttributePaths, nestingLevel)
 }
 
+// renderCheck appends the check of the resource, once for each permission when there are several.
 func (resource *Resource) renderCheck(nestingLevel int, idPath string) {
 	file := resource.file
 
+	if resource.multiplePermissions() {
+		file.P(util.Indent(nestingLevel), "for _, permission := range permissions {")
+		nestingLevel++
+	}
 	file.P(util.Indent(nestingLevel), "check := pkg.Check {")
 	file.P(util.Indent(nestingLevel+1), "TenantID:     tenantId,")
 	file.P(util.Indent(nestingLevel+1), "Permission:   permission,")
 	file.P(util.Indent(nestingLevel+1), "Entity: &pkg.Resource {")
 	file.P(util.Indent(nestingLevel+2), `Type:       "`, resource.Type, `",`)
 	file.P(util.Indent(nestingLevel+2), `ID:         id,`)
-	file.P(util.Indent(nestingLevel+2), `Attributes: attributes,`)
+	if len(resource.AttributePaths) > 0 {
+		file.P(util.Indent(nestingLevel+2), `Attributes: attributes,`)
+	}
 	file.P(util.Indent(nestingLevel+1), "},")
 	file.P(util.Indent(nestingLevel), "}")
+	file.P(util.Indent(nestingLevel), "checks = append(checks, check)")
+	if resource.multiplePermissions() {
+		file.P(util.Indent(nestingLevel-1), "}")
+	}
 }
@@ -0,0 +1,418 @@
+package model
+
+import (
+	"io"
+	stdlog "log"
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func newTestGeneratedFile(t *testing.T) *protogen.GeneratedFile {
+	t.Helper()
+
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{})
+	require.NoError(t, err)
+	file := plugin.NewGeneratedFile("test_permit.pb.go", "test/v1")
+	file.P("package testv1")
+	file.P()
+	return file
+}
+
+// testFileDescriptor describes test/v1/model.proto, whose messages are used to test the parts of the model that walk
+// real descriptors.
+const testFileDescriptor = `
+name: "test/v1/model.proto"
+package: "test.v1"
+dependency: "google/protobuf/wrappers.proto"
+syntax: "proto3"
+options { go_package: "test/v1;testv1" }
+message_type {
+  name: "Draft"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+  field { name: "team_id" number: 2 label: LABEL_OPTIONAL type: TYPE_INT64 json_name: "teamId" }
+  field { name: "tags" number: 3 label: LABEL_REPEATED type: TYPE_STRING json_name: "tags" }
+  field { name: "score" number: 4 label: LABEL_OPTIONAL type: TYPE_DOUBLE json_name: "score" }
+  field { name: "stage" number: 5 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".test.v1.Stage" json_name: "stage" }
+}
+enum_type {
+  name: "Stage"
+  value { name: "STAGE_UNSPECIFIED" number: 0 }
+  value { name: "STAGE_REVIEW" number: 1 }
+  value { name: "STAGE_PUBLISH" number: 2 }
+}
+message_type {
+  name: "CreateDraftRequest"
+  field { name: "draft" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.v1.Draft" json_name: "draft" }
+  field {
+    name: "reviewer_id" number: 2 label: LABEL_OPTIONAL type: TYPE_MESSAGE
+    type_name: ".google.protobuf.StringValue" json_name: "reviewerId"
+  }
+  field { name: "drafts" number: 3 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".test.v1.Draft" json_name: "drafts" }
+  field { name: "folder_id" number: 4 label: LABEL_OPTIONAL type: TYPE_STRING oneof_index: 0 json_name: "folderId" }
+  oneof_decl { name: "parent" }
+}
+message_type {
+  name: "Board"
+  field { name: "by_flag" number: 1 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".test.v1.Board.ByFlagEntry" json_name: "byFlag" }
+  field { name: "by_name" number: 2 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".test.v1.Board.ByNameEntry" json_name: "byName" }
+  nested_type {
+    name: "ByFlagEntry"
+    field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_BOOL json_name: "key" }
+    field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.v1.Draft" json_name: "value" }
+    options { map_entry: true }
+  }
+  nested_type {
+    name: "ByNameEntry"
+    field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "key" }
+    field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.v1.Draft" json_name: "value" }
+    options { map_entry: true }
+  }
+}
+`
+
+// newTestMessage returns the message called name from testFileDescriptor.
+func newTestMessage(t *testing.T, name string) *protogen.Message {
+	t.Helper()
+
+	var file descriptorpb.FileDescriptorProto
+	require.NoError(t, prototext.Unmarshal([]byte(testFileDescriptor), &file))
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto),
+			&file,
+		},
+	})
+	require.NoError(t, err)
+
+	for _, message := range plugin.FilesByPath[file.GetName()].Messages {
+		if message.GoIdent.GoName == name {
+			return message
+		}
+	}
+	require.FailNow(t, "no message "+name)
+	return nil
+}
+
+func TestRenderIdConversion(t *testing.T) {
+	file := newTestGeneratedFile(t)
+
+	tests := []struct {
+		name     string
+		kind     protoreflect.Kind
+		expected string
+	}{
+		{
+			name:     "string is used as is",
+			kind:     protoreflect.StringKind,
+			expected: "resource.Id",
+		},
+		{
+			name:     "int64 is formatted directly",
+			kind:     protoreflect.Int64Kind,
+			expected: "strconv.FormatInt(resource.Id, 10)",
+		},
+		{
+			name:     "int32 is widened",
+			kind:     protoreflect.Sfixed32Kind,
+			expected: "strconv.FormatInt(int64(resource.Id), 10)",
+		},
+		{
+			name:     "uint64 is formatted directly",
+			kind:     protoreflect.Fixed64Kind,
+			expected: "strconv.FormatUint(resource.Id, 10)",
+		},
+		{
+			name:     "uint32 is widened",
+			kind:     protoreflect.Uint32Kind,
+			expected: "strconv.FormatUint(uint64(resource.Id), 10)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, renderIdConversion(file, "resource.Id", tt.kind))
+		})
+	}
+}
+
+func TestRenderIdValueWithoutField(t *testing.T) {
+	file := newTestGeneratedFile(t)
+
+	assert.Equal(t, "resource.Id", renderIdValue(file, "resource.Id", nil))
+	assert.Equal(t, ` != ""`, idPresence(nil))
+}
+
+func TestIdConditions(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	path, err := NewFieldPath(file, "req", request, "draft.team_id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"req.Draft != nil", "req.Draft.TeamId != 0"}, idConditions(path))
+
+	path, err = NewFieldPath(file, "req", request, "reviewer_id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"req.ReviewerId != nil"}, idConditions(path))
+}
+
+func TestRenderTenantId(t *testing.T) {
+	draft := newTestMessage(t, "Draft")
+
+	tests := []struct {
+		name     string
+		strict   bool
+		expected string
+	}{
+		{name: "default tenant", expected: "\ttenantId := \"default\"\n\tif resource.Id != \"\" {"},
+		{
+			name:     "strict",
+			strict:   true,
+			expected: "\tvar tenantId string\n\tif resource.Id != \"\" {\n\t\ttenantId = resource.Id\n\t}\n\tif tenantId == \"\" {",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := newTestGeneratedFile(t)
+			options := NewOptions()
+			options.Strict = tt.strict
+			resource := &Resource{
+				file:         file,
+				options:      options,
+				TenantIdPath: NewRootPathBuilder("resource", file).AddField(draft.Fields[0]).Build(),
+			}
+
+			file.P("func f() pkg.CheckConfig {")
+			resource.renderTenantId(1)
+			file.P("}")
+
+			content, err := file.Content()
+			require.NoError(t, err)
+			assert.Contains(t, string(content), tt.expected)
+			if tt.strict {
+				assert.Contains(t, string(content), `"deny_reason": "field id is not set",`)
+			}
+		})
+	}
+}
+
+func TestResourceHasIdSource(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	draft := newTestMessage(t, "Draft")
+
+	assert.False(t, (&Resource{Path: NewRootPathBuilder("req", file).Build()}).hasIdSource())
+	assert.True(t, (&Resource{IdPath: NewRootPathBuilder("resource", file).AddField(draft.Fields[0]).Build()}).hasIdSource())
+}
+
+func TestResourceParentPermission(t *testing.T) {
+	own := &Parent{Type: "Folder", Permission: "create"}
+	inherited := &Parent{Type: "Folder", Check: pluginv1.ParentCheck_PARENT_CHECK_ALSO}
+
+	assert.Empty(t, (&Resource{}).parentPermission())
+	assert.Empty(t, (&Resource{Parent: inherited}).parentPermission())
+	assert.Equal(t, "create", (&Resource{Parent: own}).parentPermission())
+	assert.Equal(t, "create", (&Resource{Oneof: &Oneof{Cases: []*OneofCase{
+		{Resource: &Resource{Parent: inherited}},
+		{Resource: &Resource{Parent: own}},
+	}}}).parentPermission())
+}
+
+func TestResourceCheckCapacity(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+	drafts := NewPathBuilder(NewRootPathBuilder("req", file).AddField(request.Fields[2])).Build()
+	also := &Parent{Type: "Folder", Check: pluginv1.ParentCheck_PARENT_CHECK_ALSO}
+	replaces := &Parent{Type: "Folder", Permission: "create"}
+
+	tests := []struct {
+		name     string
+		resource *Resource
+		expected string
+	}{
+		{name: "single resource", resource: &Resource{Path: NewRootPathBuilder("req", file).Build()}, expected: "1"},
+		{name: "collection", resource: &Resource{Path: drafts}, expected: "len(req.Drafts)"},
+		{
+			name:     "collection checked for several permissions",
+			resource: &Resource{Path: drafts, permissionCount: 2},
+			expected: "len(req.Drafts)*2",
+		},
+		{
+			name:     "parent also checked for each permission",
+			resource: &Resource{Path: drafts, permissionCount: 2, Parent: also},
+			expected: "len(req.Drafts)*4",
+		},
+		{
+			name:     "parent replacing the resource",
+			resource: &Resource{Path: drafts, Parent: replaces},
+			expected: "len(req.Drafts)",
+		},
+		{
+			name:     "limited collection",
+			resource: &Resource{Path: drafts, permissionCount: 2, maxChecks: 10},
+			expected: "min(len(req.Drafts)*2, 10)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.resource.checkCapacity())
+		})
+	}
+}
+
+func TestResourceWithoutAttributes(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	resource := &Resource{file: file, options: NewOptions(), Type: "Draft", Path: NewRootPathBuilder("req", file).Build()}
+
+	file.P("func checks() []pkg.Check {")
+	file.P(`permission := "read"`)
+	file.P("var checks []pkg.Check")
+	resource.Generate(1)
+	file.P("return checks")
+	file.P("}")
+
+	content, err := file.Content()
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "attributes")
+}
+
+// keyedMapDescriptor describes test/v1/keyed.proto, whose Team map is keyed by id but holds messages which only
+// nest the resources.
+const keyedMapDescriptor = `
+name: "test/v1/keyed.proto"
+package: "test.v1"
+syntax: "proto3"
+options { go_package: "test/v1;testv1" }
+message_type {
+  name: "Project"
+  options { [nrf110.permify.v1.resource_type]: "Project" }
+  field {
+    name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id"
+    options { [nrf110.permify.v1.resource_id]: true }
+  }
+}
+message_type {
+  name: "Team"
+  field { name: "projects" number: 1 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".test.v1.Project" json_name: "projects" }
+}
+message_type {
+  name: "UpdateTeamsRequest"
+  field {
+    name: "teams" number: 1 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".test.v1.UpdateTeamsRequest.TeamsEntry"
+    json_name: "teams" options { [nrf110.permify.plugin.v1.map_key_resource_id]: true }
+  }
+  nested_type {
+    name: "TeamsEntry"
+    field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "key" }
+    field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.v1.Team" json_name: "value" }
+    options { map_entry: true }
+  }
+}
+`
+
+func TestNewResourceRejectsKeysOfNestedResources(t *testing.T) {
+	log := util.Log
+	util.Log = stdlog.New(io.Discard, "", 0)
+	t.Cleanup(func() { util.Log = log })
+
+	var file descriptorpb.FileDescriptorProto
+	require.NoError(t, prototext.Unmarshal([]byte(keyedMapDescriptor), &file))
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{&file},
+	})
+	require.NoError(t, err)
+	request := plugin.FilesByPath[file.GetName()].Messages[2]
+
+	NewResource(plugin, newTestGeneratedFile(t), request, NewOptions())
+
+	assert.Equal(t, "Teams must be a map of resources to use its key as the resource id, and test.v1.Team isn't one",
+		plugin.Response().GetError())
+}
+
+func TestNewResourceStrict(t *testing.T) {
+	log := util.Log
+	util.Log = stdlog.New(io.Discard, "", 0)
+	t.Cleanup(func() { util.Log = log })
+
+	var file descriptorpb.FileDescriptorProto
+	require.NoError(t, prototext.Unmarshal([]byte(keyedMapDescriptor), &file))
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{&file},
+	})
+	require.NoError(t, err)
+	team := plugin.FilesByPath[file.GetName()].Messages[1]
+	options := NewOptions()
+	options.Strict = true
+	generated := newTestGeneratedFile(t)
+
+	resource := NewResource(plugin, generated, team, options)
+	generated.P("func f() pkg.CheckConfig {")
+	resource.Generate(1)
+	generated.P("}")
+
+	assert.Equal(t, "resource test.v1.Project has no tenant_id field, inherited tenant or tenant_resolver, which strict mode requires",
+		plugin.Response().GetError())
+	content, err := generated.Content()
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"deny_reason": "field projects.id is not set",`)
+}
+
+func TestRenderRangeSortedMaps(t *testing.T) {
+	board := newTestMessage(t, "Board")
+
+	tests := []struct {
+		name     string
+		sorted   bool
+		field    *protogen.Field
+		expected string
+	}{
+		{
+			name:     "unsorted",
+			field:    board.Fields[1],
+			expected: "\tfor k, v := range req.ByName {\n",
+		},
+		{
+			name:     "sorted",
+			sorted:   true,
+			field:    board.Fields[1],
+			expected: "\tfor _, k := range slices.Sorted(maps.Keys(req.ByName)) {\n\t\tv := req.ByName[k]\n",
+		},
+		{
+			name:     "sorted bool keys",
+			sorted:   true,
+			field:    board.Fields[0],
+			expected: "\tfor _, k := range []bool{false, true} {\n\t\tv, ok := req.ByFlag[k]\n\t\tif !ok {\n\t\t\tcontinue\n\t\t}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := newTestGeneratedFile(t)
+			options := NewOptions()
+			options.SortedMaps = tt.sorted
+			path := &Path{Path: "req." + tt.field.GoName, Field: tt.field}
+
+			file.P("func f(req *Board) {")
+			renderRange(file, options, path, 1, "k", "v")
+			file.P("_, _ = k, v")
+			file.P("}")
+			file.P("}")
+
+			content, err := file.Content()
+			require.NoError(t, err)
+			assert.Contains(t, string(content), tt.expected)
+		})
+	}
+}
@@ -9,10 +9,10 @@ type Service struct {
 	Methods []*Method
 }
 
-func NewService(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Service) *Service {
+func NewService(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Service, options *Options) *Service {
 	var methods []*Method
 	for _, method := range pb.Methods {
-		methods = append(methods, NewMethod(plugin, file, method))
+		methods = append(methods, NewMethod(plugin, file, method, options))
 	}
 	return &Service{
 		file:    file,
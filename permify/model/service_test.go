@@ -3,6 +3,7 @@ package model
 import (
 	"testing"
 
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/compiler/protogen"
 )
@@ -13,11 +14,11 @@ func TestServiceStruct(t *testing.T) {
 
 	methods := []*Method{
 		{
-			IsPublic:    true,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 			RequestType: "PublicRequest",
 		},
 		{
-			IsPublic:    false,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			Permission:  "read",
 			RequestType: "UserRequest",
 		},
@@ -54,7 +55,7 @@ func TestServiceWithSingleMethod(t *testing.T) {
 	mockFile := &protogen.GeneratedFile{}
 
 	method := &Method{
-		IsPublic:    true,
+		Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 		RequestType: "SingleRequest",
 	}
 
@@ -66,7 +67,7 @@ func TestServiceWithSingleMethod(t *testing.T) {
 	assert.Equal(t, mockFile, service.file)
 	assert.Len(t, service.Methods, 1)
 	assert.Equal(t, method, service.Methods[0])
-	assert.True(t, service.Methods[0].IsPublic)
+	assert.True(t, service.Methods[0].IsPublic())
 	assert.Equal(t, "SingleRequest", service.Methods[0].RequestType)
 }
 
@@ -76,23 +77,23 @@ func TestServiceWithMultipleMethods(t *testing.T) {
 
 	methods := []*Method{
 		{
-			IsPublic:    true,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 			RequestType: "PublicRequest",
 		},
 		{
-			IsPublic:    false,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			Permission:  "read",
 			RequestType: "ReadRequest",
 			Resource:    &Resource{Type: "Document"},
 		},
 		{
-			IsPublic:    false,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			Permission:  "write",
 			RequestType: "WriteRequest",
 			Resource:    &Resource{Type: "Document"},
 		},
 		{
-			IsPublic:    false,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			Permission:  "admin",
 			RequestType: "AdminRequest",
 			Resource:    &Resource{Type: "System"},
@@ -111,7 +112,7 @@ func TestServiceWithMultipleMethods(t *testing.T) {
 	// Verify each method
 	for i, expectedMethod := range methods {
 		assert.Equal(t, expectedMethod, service.Methods[i])
-		assert.Equal(t, expectedMethod.IsPublic, service.Methods[i].IsPublic)
+		assert.Equal(t, expectedMethod.IsPublic(), service.Methods[i].IsPublic())
 		assert.Equal(t, expectedMethod.RequestType, service.Methods[i].RequestType)
 		assert.Equal(t, expectedMethod.Permission, service.Methods[i].Permission)
 	}
@@ -138,7 +139,7 @@ func TestServiceGenerate(t *testing.T) {
 			service: &Service{
 				Methods: []*Method{
 					{
-						IsPublic:    true,
+						Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 						RequestType: "PublicRequest",
 					},
 				},
@@ -150,11 +151,11 @@ func TestServiceGenerate(t *testing.T) {
 			service: &Service{
 				Methods: []*Method{
 					{
-						IsPublic:    true,
+						Access:      pluginv1.AccessMode_ACCESS_MODE_PUBLIC,
 						RequestType: "PublicRequest",
 					},
 					{
-						IsPublic:    false,
+						Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 						Permission:  "read",
 						RequestType: "ProtectedRequest",
 					},
@@ -174,7 +175,7 @@ func TestServiceGenerate(t *testing.T) {
 			protectedCount := 0
 
 			for _, method := range tt.service.Methods {
-				if method.IsPublic {
+				if method.IsPublic() {
 					publicCount++
 				} else {
 					protectedCount++
@@ -188,7 +189,7 @@ func TestServiceGenerate(t *testing.T) {
 			for _, method := range tt.service.Methods {
 				assert.NotEmpty(t, method.RequestType, "Each method should have a request type")
 
-				if method.IsPublic {
+				if method.IsPublic() {
 					assert.Empty(t, method.Permission, "Public methods should not have permissions")
 				} else {
 					assert.NotEmpty(t, method.Permission, "Protected methods should have permissions")
@@ -210,9 +211,9 @@ func TestServiceMethodTypes(t *testing.T) {
 		{
 			name: "all public methods",
 			methods: []*Method{
-				{IsPublic: true, RequestType: "Public1"},
-				{IsPublic: true, RequestType: "Public2"},
-				{IsPublic: true, RequestType: "Public3"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "Public1"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "Public2"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "Public3"},
 			},
 			expectedPublic:  3,
 			expectedPrivate: 0,
@@ -221,9 +222,9 @@ func TestServiceMethodTypes(t *testing.T) {
 		{
 			name: "all protected methods",
 			methods: []*Method{
-				{IsPublic: false, Permission: "read", RequestType: "Protected1"},
-				{IsPublic: false, Permission: "write", RequestType: "Protected2"},
-				{IsPublic: false, Permission: "admin", RequestType: "Protected3"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "read", RequestType: "Protected1"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "write", RequestType: "Protected2"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "admin", RequestType: "Protected3"},
 			},
 			expectedPublic:  0,
 			expectedPrivate: 3,
@@ -232,10 +233,10 @@ func TestServiceMethodTypes(t *testing.T) {
 		{
 			name: "mixed methods",
 			methods: []*Method{
-				{IsPublic: true, RequestType: "Public1"},
-				{IsPublic: false, Permission: "read", RequestType: "Protected1"},
-				{IsPublic: true, RequestType: "Public2"},
-				{IsPublic: false, Permission: "write", RequestType: "Protected2"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "Public1"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "read", RequestType: "Protected1"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "Public2"},
+				{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "write", RequestType: "Protected2"},
 			},
 			expectedPublic:  2,
 			expectedPrivate: 2,
@@ -256,7 +257,7 @@ func TestServiceMethodTypes(t *testing.T) {
 			privateCount := 0
 
 			for _, method := range service.Methods {
-				if method.IsPublic {
+				if method.IsPublic() {
 					publicCount++
 				} else {
 					privateCount++
@@ -280,7 +281,7 @@ func TestServicePermissionVariety(t *testing.T) {
 	// Create a method for each permission
 	for _, perm := range permissions {
 		method := &Method{
-			IsPublic:    false,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			Permission:  perm,
 			RequestType: "Request" + perm,
 			Resource:    &Resource{Type: "TestResource"},
@@ -298,7 +299,7 @@ func TestServicePermissionVariety(t *testing.T) {
 	// Verify each permission is correctly set
 	for i, expectedPerm := range permissions {
 		assert.Equal(t, expectedPerm, service.Methods[i].Permission)
-		assert.False(t, service.Methods[i].IsPublic)
+		assert.False(t, service.Methods[i].IsPublic())
 		assert.NotNil(t, service.Methods[i].Resource)
 	}
 }
@@ -317,7 +318,7 @@ func TestServiceResourceAssociations(t *testing.T) {
 	var methods []*Method
 	for _, resource := range resources {
 		method := &Method{
-			IsPublic:    false,
+			Access:      pluginv1.AccessMode_ACCESS_MODE_CHECKED,
 			Permission:  "read",
 			RequestType: resource.GoName + "Request",
 			Resource:    resource,
@@ -345,11 +346,11 @@ func TestServiceMethodOrder(t *testing.T) {
 	mockFile := &protogen.GeneratedFile{}
 
 	methods := []*Method{
-		{IsPublic: true, RequestType: "First"},
-		{IsPublic: false, Permission: "read", RequestType: "Second"},
-		{IsPublic: true, RequestType: "Third"},
-		{IsPublic: false, Permission: "write", RequestType: "Fourth"},
-		{IsPublic: false, Permission: "admin", RequestType: "Fifth"},
+		{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "First"},
+		{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "read", RequestType: "Second"},
+		{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "Third"},
+		{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "write", RequestType: "Fourth"},
+		{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "admin", RequestType: "Fifth"},
 	}
 
 	service := &Service{
@@ -393,7 +394,7 @@ func TestServiceValidation(t *testing.T) {
 			name: "valid service with public methods",
 			service: &Service{
 				Methods: []*Method{
-					{IsPublic: true, RequestType: "PublicRequest"},
+					{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "PublicRequest"},
 				},
 			},
 			isValid:     true,
@@ -403,7 +404,7 @@ func TestServiceValidation(t *testing.T) {
 			name: "valid service with protected methods",
 			service: &Service{
 				Methods: []*Method{
-					{IsPublic: false, Permission: "read", RequestType: "ProtectedRequest", Resource: &Resource{Type: "User"}},
+					{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "read", RequestType: "ProtectedRequest", Resource: &Resource{Type: "User"}},
 				},
 			},
 			isValid:     true,
@@ -413,8 +414,8 @@ func TestServiceValidation(t *testing.T) {
 			name: "service with mixed valid methods",
 			service: &Service{
 				Methods: []*Method{
-					{IsPublic: true, RequestType: "PublicRequest"},
-					{IsPublic: false, Permission: "write", RequestType: "ProtectedRequest", Resource: &Resource{Type: "Document"}},
+					{Access: pluginv1.AccessMode_ACCESS_MODE_PUBLIC, RequestType: "PublicRequest"},
+					{Access: pluginv1.AccessMode_ACCESS_MODE_CHECKED, Permission: "write", RequestType: "ProtectedRequest", Resource: &Resource{Type: "Document"}},
 				},
 			},
 			isValid:     true,
@@ -437,11 +438,11 @@ func TestServiceValidation(t *testing.T) {
 
 			for _, method := range tt.service.Methods {
 				// Basic validation rules
-				if !method.IsPublic && method.Permission == "" {
+				if !method.IsPublic() && method.Permission == "" {
 					isValid = false
 					break
 				}
-				if !method.IsPublic && method.Resource == nil {
+				if !method.IsPublic() && method.Resource == nil {
 					isValid = false
 					break
 				}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// Subject is checked in place of the caller, with its id read from the request.
+type Subject struct {
+	Type     string
+	IdPath   *Path
+	Relation string
+}
+
+// newSubject finds the request field annotated as the subject of the checks of pb, if any.
+func newSubject(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Method) *Subject {
+	idPath, err := findSingleField(pb.Input, pluginv1.E_Subject, NewRootPathBuilder("req", file), "subject")
+	if err == nil && idPath != nil {
+		annotation := proto.GetExtension(idPath.Field.Desc.Options(), pluginv1.E_Subject).(*pluginv1.Subject)
+		var subject *Subject
+		if subject, err = newSubjectField(idPath, annotation); err == nil {
+			return subject
+		}
+		err = fmt.Errorf("subject %s: %w", idPath.Field.Desc.FullName(), err)
+	}
+	if err != nil {
+		plugin.Error(fmt.Errorf("method %s in service %s: %w", pb.GoName, pb.Parent.GoName, err))
+	}
+	return nil
+}
+
+func newSubjectField(idPath *Path, annotation *pluginv1.Subject) (*Subject, error) {
+	field := idPath.Field
+	switch {
+	case annotation.GetType() == "":
+		return nil, errors.New("type is required")
+	case field.Desc.IsList() || field.Desc.IsMap():
+		return nil, errors.New("must be a singular field")
+	case util.IsOneofField(field):
+		return nil, errors.New("must be outside of a oneof")
+	case !util.IsIdField(field):
+		return nil, errors.New("must be a string, an integer or a wrapper of one")
+	}
+	return &Subject{
+		Type:     annotation.GetType(),
+		IdPath:   idPath,
+		Relation: annotation.GetRelation(),
+	}, nil
+}
+
+// generateSubject adds GetCheckSubject, returning the subject of the checks of GetChecks.
+func (method *Method) generateSubject() {
+	file := method.file
+	ident := func(name string) string {
+		return file.QualifiedGoIdent(protogen.GoIdent{GoName: name, GoImportPath: permifyBasePackage})
+	}
+	subject := method.Subject
+
+	file.P("// GetCheckSubject returns the subject of every check returned by GetChecks in place of the caller, or nil when")
+	file.P("// ", subject.IdPath.Field.Desc.Name(), " is unset.")
+	file.P("func (req *", method.RequestType, ") GetCheckSubject() *", ident("Subject"), " {")
+	file.P(util.Indent(1), "if ", strings.Join(idConditions(subject.IdPath), " && "), " {")
+	file.P(util.Indent(2), "return ", ident("Subject_builder"), "{")
+	file.P(util.Indent(3), `Type: "`, subject.Type, `",`)
+	file.P(util.Indent(3), "Id: ", renderIdValue(file, subject.IdPath.Path, subject.IdPath.Field), ",")
+	if subject.Relation != "" {
+		file.P(util.Indent(3), `Relation: "`, subject.Relation, `",`)
+	}
+	file.P(util.Indent(2), "}.Build()")
+	file.P(util.Indent(1), "}")
+	file.P(util.Indent(1), "return nil")
+	file.P("}")
+}
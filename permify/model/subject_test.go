@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubjectField(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	idPath, err := NewFieldPath(file, "req", request, "draft.team_id")
+	require.NoError(t, err)
+	subject, err := newSubjectField(idPath, &pluginv1.Subject{Type: "team", Relation: "member"})
+	require.NoError(t, err)
+	assert.Equal(t, "team", subject.Type)
+	assert.Equal(t, "req.Draft.TeamId", subject.IdPath.Path)
+	assert.Equal(t, "member", subject.Relation)
+}
+
+func TestNewSubjectFieldErrors(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+	// Annotated fields are found by walking the request, which reaches fields that field paths can't lead to
+	walk := func(names ...string) *Path {
+		builder := NewRootPathBuilder("req", file)
+		message := request
+		for _, name := range names {
+			for _, field := range message.Fields {
+				if string(field.Desc.Name()) == name {
+					builder = builder.AddField(field)
+					message = field.Message
+				}
+			}
+		}
+		return builder.Build()
+	}
+
+	tests := []struct {
+		name    string
+		idPath  *Path
+		subject *pluginv1.Subject
+		err     string
+	}{
+		{name: "missing type", idPath: walk("reviewer_id"), subject: &pluginv1.Subject{}, err: "type is required"},
+		{name: "list", idPath: walk("draft", "tags"), subject: &pluginv1.Subject{Type: "user"}, err: "must be a singular field"},
+		{name: "oneof", idPath: walk("folder_id"), subject: &pluginv1.Subject{Type: "user"}, err: "must be outside of a oneof"},
+		{name: "not an id", idPath: walk("draft", "score"), subject: &pluginv1.Subject{Type: "user"}, err: "must be a string, an integer or a wrapper of one"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newSubjectField(tt.idPath, tt.subject)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+
+	permifyv1 "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// InheritedTenant is the tenant_id field of a message enclosing a resource, such as a container of the resource, a
+// sibling resource or the request itself. A resource without a tenant_id field of its own uses the one of the nearest
+// enclosing message that has one.
+type InheritedTenant struct {
+	// Scope is the number of collections and oneofs entered on the way from the request to the enclosing message.
+	// Beyond scope 0, Path is relative to the loop or switch variable binding the element that holds the message.
+	Scope int
+	Path  *Path
+	// Message is the enclosing message.
+	Message *protogen.Message
+	// fieldPath names the tenant_id field as the request does, for deny reasons.
+	fieldPath string
+}
+
+// ancestry is what a resource inherits from the messages enclosing it.
+type ancestry struct {
+	// scopeOffset is the number of collections and oneofs entered before the root of the path being built.
+	scopeOffset int
+	// location is the field path from the request to the root of the path being built.
+	location string
+	tenant   *InheritedTenant
+}
+
+// enclosing returns the ancestry of the resources within pb, reached with path, which inherit its tenant_id field, or
+// that of a message it holds, if it has one.
+func (a ancestry) enclosing(plugin *protogen.Plugin, pb *protogen.Message, path *PathBuilder) ancestry {
+	// Each field leading to pb gets a node of its own, so the messages holding it are checked for nil before the
+	// tenant is read.
+	scopeRoot := &PathBuilder{file: path.file}
+	for idx, holder := range path.fields {
+		if idx > 0 {
+			scopeRoot = NewPathBuilder(scopeRoot)
+		}
+		scopeRoot.fields = []fieldHolder{holder}
+	}
+	if tenantPath := withoutEmptyNodes(findEnclosingTenant(plugin, pb, scopeRoot, nil)); tenantPath != nil {
+		var parent string
+		if path.parent != nil {
+			parent = path.parent.fieldPath()
+		}
+		a.tenant = &InheritedTenant{
+			Scope:     a.scopeOffset + path.depth(),
+			Path:      tenantPath,
+			Message:   pb,
+			fieldPath: joinFieldPath(a.location, parent, tenantPath.FieldPath()),
+		}
+	}
+	return a
+}
+
+// oneofCase returns the ancestry of the resources in the case field of a oneof reached with path, whose paths are
+// rooted at the case.
+func (a ancestry) oneofCase(path *PathBuilder, field *protogen.Field) ancestry {
+	a.scopeOffset += path.depth() + 1
+	a.location = joinFieldPath(a.location, path.fieldPath(), string(field.Desc.Name()))
+	return a
+}
+
+// depth returns the number of collections entered by the path being built.
+func (node *PathBuilder) depth() int {
+	depth := 0
+	for parent := node.parent; parent != nil; parent = parent.parent {
+		depth++
+	}
+	return depth
+}
+
+// findEnclosingTenant finds the tenant_id field of pb, or of the singular messages it holds, including sibling
+// resources whose tenant_id field the resources within pb share. Messages already being visited are skipped so
+// recursive messages terminate.
+func findEnclosingTenant(plugin *protogen.Plugin, pb *protogen.Message, path *PathBuilder, visiting []*protogen.Message) *Path {
+	visiting = append(visiting, pb)
+	for _, field := range pb.Fields {
+		if util.GetBoolExtension(field.Desc, permifyv1.E_TenantId) {
+			if !util.IsIdField(field) {
+				plugin.Error(fmt.Errorf("%s must be a string or integer type", field.GoName))
+			}
+			return path.AddField(field).Build()
+		}
+	}
+	for _, field := range pb.Fields {
+		if !util.IsMessage(field) || field.Desc.IsList() || field.Desc.IsMap() || util.IsOneofField(field) ||
+			slices.Contains(visiting, field.Message) {
+			continue
+		}
+		if result := findEnclosingTenant(plugin, field.Message, NewPathBuilder(path).AddField(field), visiting); result != nil {
+			return result
+		}
+	}
+	return nil
+}
+
+// withoutEmptyNodes drops the nodes of path that hold no field, left by a scope whose root is the element of a
+// collection.
+func withoutEmptyNodes(path *Path) *Path {
+	if path == nil {
+		return nil
+	}
+	if path.Path == "" {
+		return withoutEmptyNodes(path.Child)
+	}
+	node := *path
+	node.Child = withoutEmptyNodes(path.Child)
+	return &node
+}
+
+// tenantPath returns the path of the resource's tenant, either its own tenant_id field or else the one it inherits.
+// Beyond scope 0, the inherited one is read from the variable binding the element that holds it.
+func (resource *Resource) tenantPath() *Path {
+	inherited := resource.InheritedTenant
+	if resource.TenantIdPath != nil || inherited == nil {
+		return resource.TenantIdPath
+	}
+	if inherited.Scope == 0 {
+		return inherited.Path
+	}
+	return &Path{Path: resource.scopes[inherited.Scope], Child: inherited.Path}
+}
+
+// bindsScope reports whether the resource reads the element of the collection or oneof case at scope, which is
+// otherwise left unbound.
+func (resource *Resource) bindsScope(scope int) bool {
+	return resource.TenantIdPath == nil && resource.InheritedTenant != nil && resource.InheritedTenant.Scope == scope
+}
+
+// tenantField returns the field the resource's tenant is read from, and whether it's inherited, or nil when it has
+// none.
+func (resource *Resource) tenantField() (*protogen.Field, bool) {
+	switch {
+	case resource.TenantIdPath != nil:
+		return resource.TenantIdPath.Leaf().Field, false
+	case resource.InheritedTenant != nil:
+		return resource.InheritedTenant.Path.Leaf().Field, true
+	default:
+		return nil, false
+	}
+}
+
+// tenantFieldPath returns the field path in the request of the field the resource's tenant is read from.
+func (resource *Resource) tenantFieldPath() string {
+	if resource.TenantIdPath == nil && resource.InheritedTenant != nil {
+		return resource.InheritedTenant.fieldPath
+	}
+	return resource.fieldPath(resource.TenantIdPath)
+}
+
+// hasTenantSource reports whether the resource, or any resource in the cases of its oneof, has a tenant of its own,
+// an inherited one or a tenant resolver.
+func (resource *Resource) hasTenantSource() bool {
+	if resource.TenantIdPath != nil || resource.InheritedTenant != nil || resource.TenantResolver != nil {
+		return true
+	}
+	if resource.Oneof != nil {
+		for _, c := range resource.Oneof.Cases {
+			if c.Resource.hasTenantSource() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// describeTenant describes where the resource's tenant comes from, in order of precedence.
+func (resource *Resource) describeTenant() string {
+	var source string
+	if field, inherited := resource.tenantField(); field != nil {
+		source = fmt.Sprintf("field %s", field.Desc.FullName())
+		if inherited {
+			source = "inherited " + source
+		}
+	}
+	fallback := `"default"`
+	if resource.TenantResolver != nil || (resource.strict() && source != "") {
+		fallback = "denied"
+	}
+	return describeSources(source, resource.TenantResolver, fallback)
+}
+
+// logTenantSource reports where the tenant of the resource, and of those in the cases of its oneof, comes from.
+func (resource *Resource) logTenantSource(method string) {
+	if resource.Oneof != nil {
+		for _, c := range resource.Oneof.Cases {
+			c.Resource.logTenantSource(method)
+		}
+		return
+	}
+	util.Log.Printf("the tenant of %s in %s comes from %s", resource.Type, method, resource.describeTenant())
+}
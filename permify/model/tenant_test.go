@@ -0,0 +1,126 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceTenantPath(t *testing.T) {
+	draft := newTestMessage(t, "Draft")
+	file := newTestGeneratedFile(t)
+	own := NewRootPathBuilder("resource", file).AddField(draft.Fields[0]).Build()
+	request := NewRootPathBuilder("req", file).AddField(draft.Fields[0]).Build()
+	element := (&PathBuilder{file: file}).AddField(draft.Fields[0]).Build()
+
+	tests := []struct {
+		name      string
+		own       *Path
+		inherited *InheritedTenant
+		expected  string
+	}{
+		{name: "none"},
+		{name: "own field", own: own, inherited: &InheritedTenant{Path: request}, expected: "resource.Id"},
+		{name: "inherited from the request", inherited: &InheritedTenant{Path: request}, expected: "req.Id"},
+		{name: "inherited from an element", inherited: &InheritedTenant{Scope: 1, Path: element}, expected: "v1.Id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &Resource{file: file, TenantIdPath: tt.own, InheritedTenant: tt.inherited, scopes: []string{"", "v1"}}
+			path := resource.tenantPath()
+			if tt.expected == "" {
+				assert.Nil(t, path)
+				return
+			}
+			assert.Equal(t, tt.expected, path.String())
+		})
+	}
+}
+
+func TestWithoutEmptyNodes(t *testing.T) {
+	path := &Path{Path: "", Child: &Path{Path: "Queue", Child: &Path{Path: "", Child: &Path{Path: "TenantId"}}}}
+
+	assert.Equal(t, "Queue.TenantId", withoutEmptyNodes(path).String())
+	assert.Nil(t, withoutEmptyNodes(&Path{}))
+}
+
+func TestRenderInheritedTenantId(t *testing.T) {
+	draft := newTestMessage(t, "Draft")
+
+	tests := []struct {
+		name        string
+		strict      bool
+		expected    string
+		description string
+	}{
+		{
+			name:        "default",
+			expected:    "\ttenantId := \"default\"\n\tif v1 != nil && v1.Id != \"\" {\n\t\ttenantId = v1.Id\n\t}\n",
+			description: `inherited field test.v1.Draft.id, then "default"`,
+		},
+		{
+			name:        "strict",
+			strict:      true,
+			expected:    `"deny_reason": "field drafts.id is not set",`,
+			description: "inherited field test.v1.Draft.id, then denied",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := newTestGeneratedFile(t)
+			options := NewOptions()
+			options.Strict = tt.strict
+			resource := &Resource{
+				file:    file,
+				options: options,
+				InheritedTenant: &InheritedTenant{
+					Scope:     1,
+					Path:      (&PathBuilder{file: file}).AddField(draft.Fields[0]).Build(),
+					Message:   draft,
+					fieldPath: "drafts.id",
+				},
+				scopes: []string{"", "v1"},
+			}
+
+			file.P("func f() pkg.CheckConfig {")
+			resource.renderTenantId(1)
+			file.P("}")
+
+			content, err := file.Content()
+			require.NoError(t, err)
+			assert.Contains(t, string(content), tt.expected)
+			assert.Equal(t, tt.description, resource.describeTenant())
+		})
+	}
+}
+
+func TestResourceBindsScope(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	inherited := &InheritedTenant{Scope: 1, Path: &Path{Path: "TenantId"}}
+
+	assert.True(t, (&Resource{file: file, InheritedTenant: inherited}).bindsScope(1))
+	assert.False(t, (&Resource{file: file, InheritedTenant: inherited}).bindsScope(2))
+	assert.False(t, (&Resource{file: file, InheritedTenant: inherited, TenantIdPath: &Path{Path: "resource.TenantId"}}).bindsScope(1))
+	assert.False(t, (&Resource{file: file}).bindsScope(0))
+}
+
+func TestResourceHasTenantSource(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	tenantless := &Resource{file: file}
+	inherited := &Resource{file: file, InheritedTenant: &InheritedTenant{Path: &Path{Path: "req.TenantId"}}}
+	oneof := func(resources ...*Resource) *Resource {
+		var cases []*OneofCase
+		for _, resource := range resources {
+			cases = append(cases, &OneofCase{Resource: resource})
+		}
+		return &Resource{file: file, Oneof: &Oneof{file: file, Cases: cases}}
+	}
+
+	assert.False(t, tenantless.hasTenantSource())
+	assert.True(t, (&Resource{file: file, TenantIdPath: &Path{Path: "resource.TenantId"}}).hasTenantSource())
+	assert.True(t, inherited.hasTenantSource())
+	assert.True(t, (&Resource{file: file, TenantResolver: &Resolver{Name: "principal"}}).hasTenantSource())
+	assert.False(t, oneof(tenantless, tenantless).hasTenantSource())
+	assert.True(t, oneof(tenantless, inherited).hasTenantSource())
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/nrf110/protoc-gen-connectrpc-permify/permify/util"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// permifyBasePackage holds Permify's own API types, which connectrpc-permify depends on.
+const permifyBasePackage = protogen.GoImportPath("buf.build/gen/go/permifyco/permify/protocolbuffers/go/base/v1")
+
+// ContextualTuple is a relationship sent to Permify with every check of a method, with ids read from the request.
+type ContextualTuple struct {
+	EntityType      string
+	EntityId        *Path
+	Relation        string
+	SubjectType     string
+	SubjectId       *Path
+	SubjectRelation string
+}
+
+// newContextualTuples reads the contextual_tuple annotations of pb, resolving their field paths from the request.
+func newContextualTuples(plugin *protogen.Plugin, file *protogen.GeneratedFile, pb *protogen.Method) []*ContextualTuple {
+	annotations, _ := proto.GetExtension(pb.Desc.Options(), pluginv1.E_ContextualTuple).([]*pluginv1.ContextualTuple)
+
+	var tuples []*ContextualTuple
+	for idx, annotation := range annotations {
+		tuple, err := newContextualTuple(file, pb.Input, annotation)
+		if err != nil {
+			plugin.Error(fmt.Errorf("contextual tuple %d of method %s in service %s: %w", idx+1, pb.GoName, pb.Parent.GoName, err))
+			continue
+		}
+		tuples = append(tuples, tuple)
+	}
+	return tuples
+}
+
+func newContextualTuple(file *protogen.GeneratedFile, request *protogen.Message, annotation *pluginv1.ContextualTuple) (*ContextualTuple, error) {
+	switch {
+	case annotation.GetEntityType() == "":
+		return nil, fmt.Errorf("entity_type is required")
+	case annotation.GetRelation() == "":
+		return nil, fmt.Errorf("relation is required")
+	case annotation.GetSubjectType() == "":
+		return nil, fmt.Errorf("subject_type is required")
+	}
+
+	entityId, err := newIdFieldPath(file, "req", request, annotation.GetEntityId())
+	if err != nil {
+		return nil, fmt.Errorf("entity_id: %w", err)
+	}
+	subjectId, err := newIdFieldPath(file, "req", request, annotation.GetSubjectId())
+	if err != nil {
+		return nil, fmt.Errorf("subject_id: %w", err)
+	}
+
+	return &ContextualTuple{
+		EntityType:      annotation.GetEntityType(),
+		EntityId:        entityId,
+		Relation:        annotation.GetRelation(),
+		SubjectType:     annotation.GetSubjectType(),
+		SubjectId:       subjectId,
+		SubjectRelation: annotation.GetSubjectRelation(),
+	}, nil
+}
+
+// newIdFieldPath resolves fieldPath from message, bound to root, checking it leads to a field that can be used as
+// an id.
+func newIdFieldPath(file *protogen.GeneratedFile, root string, message *protogen.Message, fieldPath string) (*Path, error) {
+	path, err := NewFieldPath(file, root, message, fieldPath)
+	if err != nil {
+		return nil, err
+	}
+	if !util.IsIdField(path.Field) {
+		return nil, fmt.Errorf("field path %q must lead to a string, an integer or a wrapper of one", fieldPath)
+	}
+	return path, nil
+}
+
+// generateTuples adds GetContextualTuples, returning the relationships to send to Permify with the checks of
+// GetChecks.
+func (method *Method) generateTuples() {
+	file := method.file
+	ident := func(name string) string {
+		return file.QualifiedGoIdent(protogen.GoIdent{GoName: name, GoImportPath: permifyBasePackage})
+	}
+
+	file.P("// GetContextualTuples returns the relationships sent to Permify with every check returned by GetChecks.")
+	file.P("func (req *", method.RequestType, ") GetContextualTuples() []*", ident("Tuple"), " {")
+	file.P(util.Indent(1), "var tuples []*", ident("Tuple"))
+	for _, tuple := range method.ContextualTuples {
+		var conditions []string
+		for _, condition := range append(idConditions(tuple.EntityId), idConditions(tuple.SubjectId)...) {
+			if !slices.Contains(conditions, condition) {
+				conditions = append(conditions, condition)
+			}
+		}
+		file.P(util.Indent(1), "if ", strings.Join(conditions, " && "), " {")
+		file.P(util.Indent(2), "tuples = append(tuples, ", ident("Tuple_builder"), "{")
+		file.P(util.Indent(3), "Entity: ", ident("Entity_builder"), "{")
+		file.P(util.Indent(4), `Type: "`, tuple.EntityType, `",`)
+		file.P(util.Indent(4), "Id: ", renderIdValue(file, tuple.EntityId.Path, tuple.EntityId.Field), ",")
+		file.P(util.Indent(3), "}.Build(),")
+		file.P(util.Indent(3), `Relation: "`, tuple.Relation, `",`)
+		file.P(util.Indent(3), "Subject: ", ident("Subject_builder"), "{")
+		file.P(util.Indent(4), `Type: "`, tuple.SubjectType, `",`)
+		file.P(util.Indent(4), "Id: ", renderIdValue(file, tuple.SubjectId.Path, tuple.SubjectId.Field), ",")
+		if tuple.SubjectRelation != "" {
+			file.P(util.Indent(4), `Relation: "`, tuple.SubjectRelation, `",`)
+		}
+		file.P(util.Indent(3), "}.Build(),")
+		file.P(util.Indent(2), "}.Build())")
+		file.P(util.Indent(1), "}")
+	}
+	file.P(util.Indent(1), "return tuples")
+	file.P("}")
+}
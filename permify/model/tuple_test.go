@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewContextualTuple(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+
+	tuple, err := newContextualTuple(file, request, &pluginv1.ContextualTuple{
+		EntityType:      "document",
+		EntityId:        "draft.id",
+		Relation:        "editor",
+		SubjectType:     "team",
+		SubjectId:       "draft.team_id",
+		SubjectRelation: "member",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "req.Draft.Id", tuple.EntityId.Path)
+	assert.Equal(t, "req.Draft.TeamId", tuple.SubjectId.Path)
+	assert.Equal(t, "member", tuple.SubjectRelation)
+}
+
+func TestNewContextualTupleErrors(t *testing.T) {
+	file := newTestGeneratedFile(t)
+	request := newTestMessage(t, "CreateDraftRequest")
+	valid := func() *pluginv1.ContextualTuple {
+		return &pluginv1.ContextualTuple{
+			EntityType:  "document",
+			EntityId:    "draft.id",
+			Relation:    "parent",
+			SubjectType: "folder",
+			SubjectId:   "reviewer_id",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*pluginv1.ContextualTuple)
+		err    string
+	}{
+		{name: "missing entity type", modify: func(tuple *pluginv1.ContextualTuple) { tuple.EntityType = "" }, err: "entity_type is required"},
+		{name: "missing relation", modify: func(tuple *pluginv1.ContextualTuple) { tuple.Relation = "" }, err: "relation is required"},
+		{name: "missing subject type", modify: func(tuple *pluginv1.ContextualTuple) { tuple.SubjectType = "" }, err: "subject_type is required"},
+		{name: "missing entity id", modify: func(tuple *pluginv1.ContextualTuple) { tuple.EntityId = "" }, err: "entity_id: field path is empty"},
+		{name: "unknown subject id", modify: func(tuple *pluginv1.ContextualTuple) { tuple.SubjectId = "owner_id" }, err: "subject_id: field path"},
+		{name: "id isn't an id", modify: func(tuple *pluginv1.ContextualTuple) { tuple.EntityId = "draft.score" }, err: "must lead to a string, an integer or a wrapper of one"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotation := valid()
+			tt.modify(annotation)
+			_, err := newContextualTuple(file, request, annotation)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -3,7 +3,9 @@ package util
 import (
 	"slices"
 
+	pluginv1 "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -21,18 +23,66 @@ var IdKinds = []protoreflect.Kind{
 	protoreflect.StringKind,
 }
 
+// WrapperKinds maps the well-known wrapper messages accepted as identifiers to the kind of their Value field.
+var WrapperKinds = map[protoreflect.FullName]protoreflect.Kind{
+	"google.protobuf.StringValue": protoreflect.StringKind,
+	"google.protobuf.Int32Value":  protoreflect.Int32Kind,
+	"google.protobuf.Int64Value":  protoreflect.Int64Kind,
+	"google.protobuf.UInt32Value": protoreflect.Uint32Kind,
+	"google.protobuf.UInt64Value": protoreflect.Uint64Kind,
+}
+
 func IsIdKind(kind protoreflect.Kind) bool {
 	return slices.Contains(IdKinds, kind)
 }
 
 func IsIdField(field *protogen.Field) bool {
-	return IsIdKind(field.Desc.Kind())
+	return IsIdKind(field.Desc.Kind()) || IsWrapper(field)
+}
+
+// IsWrapper reports whether field is a singular well-known wrapper message such as google.protobuf.StringValue.
+func IsWrapper(field *protogen.Field) bool {
+	if !IsMessage(field) || field.Desc.IsList() || field.Desc.IsMap() {
+		return false
+	}
+	_, ok := WrapperKinds[field.Message.Desc.FullName()]
+	return ok
+}
+
+// ValueKind returns the kind of the value held by field, looking through well-known wrapper messages.
+func ValueKind(field *protogen.Field) protoreflect.Kind {
+	if IsWrapper(field) {
+		return WrapperKinds[field.Message.Desc.FullName()]
+	}
+	return field.Desc.Kind()
 }
 
 func IsMessage(field *protogen.Field) bool {
 	return field.Desc.Kind() == protoreflect.MessageKind
 }
 
+// IsOneofField reports whether field is a case of a oneof declared in the proto, as opposed to the synthetic
+// oneof backing a proto3 optional field.
+func IsOneofField(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
+
+// IsOptionalScalar reports whether field is a singular scalar with explicit presence, which protoc-gen-go generates
+// as a pointer.
+func IsOptionalScalar(field *protogen.Field) bool {
+	return field.Desc.HasPresence() && !IsMessage(field) && !field.Desc.IsList() &&
+		field.Desc.Kind() != protoreflect.BytesKind && !IsOneofField(field)
+}
+
+// TracksPresence reports whether the resource reached through the message field is handled by a missing resource
+// policy when the field is unset.
+func TracksPresence(field *protogen.Field) bool {
+	if !IsMessage(field) || field.Desc.IsList() || field.Desc.IsMap() {
+		return false
+	}
+	return field.Desc.HasOptionalKeyword() || proto.HasExtension(field.Desc.Options(), pluginv1.E_MissingResource)
+}
+
 func GetMapFieldValue(field *protogen.Field) *protogen.Message {
 	for _, field := range field.Message.Fields {
 		if field.GoName == "Value" {
@@ -0,0 +1,139 @@
+// Package permifytest supports the benchmarks and tests generated with the benchmarks plugin parameter.
+package permifytest
+
+import (
+	permifyv1 "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Populate sets every field of msg to a value other than its zero value, so that the checks of a request are built
+// from all of its resources, ids, tenants and attributes. Repeated and map fields get a single element, and oneofs
+// get their first case. A message type isn't populated again within itself, so recursive types end.
+func Populate(msg proto.Message) {
+	populate(msg.ProtoReflect(), make(map[protoreflect.FullName]bool))
+}
+
+func populate(msg protoreflect.Message, visiting map[protoreflect.FullName]bool) {
+	name := msg.Descriptor().FullName()
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	fields := msg.Descriptor().Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() && oneof.Fields().Get(0) != field {
+			continue
+		}
+		if isRecursive(field, visiting) {
+			continue
+		}
+
+		switch {
+		case field.IsList():
+			list := msg.Mutable(field).List()
+			list.Append(populateValue(field, list.NewElement(), visiting))
+		case field.IsMap():
+			entries := msg.Mutable(field).Map()
+			key := scalar(field.MapKey()).MapKey()
+			entries.Set(key, populateValue(field.MapValue(), entries.NewValue(), visiting))
+		case field.Message() != nil:
+			populate(msg.Mutable(field).Message(), visiting)
+		default:
+			msg.Set(field, scalar(field))
+		}
+	}
+}
+
+// isRecursive reports whether the messages held in field are of a type already being populated.
+func isRecursive(field protoreflect.FieldDescriptor, visiting map[protoreflect.FullName]bool) bool {
+	if field.IsMap() {
+		field = field.MapValue()
+	}
+	return field.Message() != nil && visiting[field.Message().FullName()]
+}
+
+// populateValue populates value, a new element of a collection held in field.
+func populateValue(field protoreflect.FieldDescriptor, value protoreflect.Value, visiting map[protoreflect.FullName]bool) protoreflect.Value {
+	if field.Message() != nil {
+		populate(value.Message(), visiting)
+		return value
+	}
+	return scalar(field)
+}
+
+// scalar returns a value of the scalar field that isn't its zero value, where there is one. Strings and bytes hold
+// the field's name, and enums their first non-zero value.
+func scalar(field protoreflect.FieldDescriptor) protoreflect.Value {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		for i := range values.Len() {
+			if number := values.Get(i).Number(); number != 0 {
+				return protoreflect.ValueOfEnum(number)
+			}
+		}
+		return protoreflect.ValueOfEnum(0)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(1)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(1)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(1)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(1)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(1)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(1)
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(field.Name()))
+	default:
+		return protoreflect.ValueOfString(string(field.Name()))
+	}
+}
+
+// Repeat appends a copy of each resource held in the repeated fields of msg, and of those in the messages it holds,
+// so a request refers to every resource it holds twice. Other repeated fields, such as attributes or context data,
+// are left as they are, since repeating them would change the checks rather than duplicate them. Map keys can't
+// repeat, so only the messages in maps are repeated within.
+func Repeat(msg proto.Message) {
+	repeat(msg.ProtoReflect())
+}
+
+func repeat(msg protoreflect.Message) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			if field.Message() == nil {
+				return true
+			}
+			list := msg.Mutable(field).List()
+			length := list.Len()
+			for i := range length {
+				element := list.Get(i)
+				repeat(element.Message())
+				if isResource(field.Message()) {
+					list.Append(protoreflect.ValueOfMessage(proto.Clone(element.Message().Interface()).ProtoReflect()))
+				}
+			}
+		case field.IsMap():
+			if field.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, entry protoreflect.Value) bool {
+					repeat(entry.Message())
+					return true
+				})
+			}
+		case field.Message() != nil:
+			repeat(value.Message())
+		}
+		return true
+	})
+}
+
+// isResource reports whether message is a resource, which has a resource_type.
+func isResource(message protoreflect.MessageDescriptor) bool {
+	return proto.HasExtension(message.Options(), permifyv1.E_ResourceType)
+}
@@ -0,0 +1,167 @@
+package permifytest
+
+import (
+	"testing"
+
+	permifyv1 "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPopulateScalarsAndCollections(t *testing.T) {
+	file := &descriptorpb.FileDescriptorProto{}
+	Populate(file)
+
+	assert.Equal(t, "name", file.GetName())
+	assert.Equal(t, []string{"dependency"}, file.GetDependency())
+	assert.Equal(t, []int32{1}, file.GetPublicDependency())
+	require.Len(t, file.GetMessageType(), 1)
+	require.Len(t, file.GetMessageType()[0].GetField(), 1)
+	assert.Equal(t, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, file.GetMessageType()[0].GetField()[0].GetType())
+	assert.True(t, file.GetOptions().GetJavaMultipleFiles())
+}
+
+func TestPopulateStopsAtRecursiveTypes(t *testing.T) {
+	message := &descriptorpb.DescriptorProto{}
+	Populate(message)
+
+	assert.Equal(t, "name", message.GetName())
+	assert.Empty(t, message.GetNestedType())
+}
+
+func TestPopulateMapsAndOneofs(t *testing.T) {
+	value := &structpb.Struct{}
+	Populate(value)
+
+	require.Contains(t, value.GetFields(), "key")
+	// Only the first case of the oneof is set, and the struct isn't populated again within itself
+	assert.IsType(t, &structpb.Value_NullValue{}, value.GetFields()["key"].GetKind())
+}
+
+func TestPopulateWellKnownTypes(t *testing.T) {
+	timestamp := &timestamppb.Timestamp{}
+	Populate(timestamp)
+
+	assert.NoError(t, timestamp.CheckValid())
+	assert.Equal(t, int64(1), timestamp.GetSeconds())
+}
+
+// newRepeatRequest returns an empty Request of a file holding Folder resources in a list, a map and a list of
+// messages that aren't resources, as well as lists of scalars and of messages held by the resources.
+func newRepeatRequest(t *testing.T) protoreflect.Message {
+	t.Helper()
+
+	resource := &descriptorpb.MessageOptions{}
+	proto.SetExtension(resource, permifyv1.E_ResourceType, "Folder")
+	field := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, typeName string) *descriptorpb.FieldDescriptorProto {
+		field := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  label.Enum(),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		}
+		if typeName != "" {
+			field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			field.TypeName = proto.String(typeName)
+		}
+		return field
+	}
+	optional, repeated := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("repeat.proto"),
+		Package: proto.String("repeat"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Note"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("text", 1, optional, ""),
+				},
+			},
+			{
+				Name: proto.String("Folder"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("id", 1, optional, ""),
+					field("labels", 2, repeated, ""),
+					field("notes", 3, repeated, ".repeat.Note"),
+				},
+				Options: resource,
+			},
+			{
+				Name: proto.String("Shelf"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("folders", 1, repeated, ".repeat.Folder"),
+					field("tags", 2, repeated, ""),
+				},
+			},
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("folders", 1, repeated, ".repeat.Folder"),
+					field("shelves", 2, repeated, ".repeat.Shelf"),
+					field("shelves_by_name", 3, repeated, ".repeat.Request.ShelvesByNameEntry"),
+					field("tags", 4, repeated, ""),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					{
+						Name: proto.String("ShelvesByNameEntry"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							field("key", 1, optional, ""),
+							field("value", 2, optional, ".repeat.Shelf"),
+						},
+						Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+					},
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return dynamicpb.NewMessage(file.Messages().ByName("Request"))
+}
+
+// list returns the list held in the field called name of msg.
+func list(msg protoreflect.Message, name protoreflect.Name) protoreflect.List {
+	return msg.Get(msg.Descriptor().Fields().ByName(name)).List()
+}
+
+func TestRepeat(t *testing.T) {
+	req := newRepeatRequest(t)
+	Populate(req.Interface())
+	Repeat(req.Interface())
+
+	folders := list(req, "folders")
+	require.Equal(t, 2, folders.Len())
+	assert.True(t, proto.Equal(folders.Get(0).Message().Interface(), folders.Get(1).Message().Interface()))
+	assert.NotSame(t, folders.Get(0).Message().Interface(), folders.Get(1).Message().Interface())
+	// The values of a resource, such as its attributes, aren't repeated within it
+	assert.Equal(t, 1, list(folders.Get(0).Message(), "labels").Len())
+	assert.Equal(t, 1, list(folders.Get(0).Message(), "notes").Len())
+	assert.Equal(t, 1, list(req, "tags").Len())
+
+	// Messages that aren't resources aren't repeated, but the resources they hold are
+	shelves := list(req, "shelves")
+	require.Equal(t, 1, shelves.Len())
+	assert.Equal(t, 2, list(shelves.Get(0).Message(), "folders").Len())
+	assert.Equal(t, 1, list(shelves.Get(0).Message(), "tags").Len())
+}
+
+func TestRepeatMaps(t *testing.T) {
+	req := newRepeatRequest(t)
+	Populate(req.Interface())
+	Repeat(req.Interface())
+
+	shelves := req.Get(req.Descriptor().Fields().ByName("shelves_by_name")).Map()
+	require.Equal(t, 1, shelves.Len())
+	shelves.Range(func(_ protoreflect.MapKey, shelf protoreflect.Value) bool {
+		assert.Equal(t, 2, list(shelf.Message(), "folders").Len())
+		return true
+	})
+}
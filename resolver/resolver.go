@@ -0,0 +1,53 @@
+// Package resolver supplies the tenants and resource ids that requests don't carry to the GetChecksContext methods
+// generated for resources annotated with tenant_resolver or id_resolver. The generated code imports it, making it a
+// runtime dependency of those services, so it only depends on the standard library.
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Func resolves a tenant or resource id from the context of a call, returning "" when it can't.
+type Func func(ctx context.Context) string
+
+var (
+	mu        sync.RWMutex
+	resolvers = make(map[string]Func)
+)
+
+// Register makes fn available to resolver annotations under name. It panics if name is already registered, so it's
+// best called from an init function.
+func Register(name string, fn Func) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := resolvers[name]; ok {
+		panic(fmt.Sprintf("resolver: %q is already registered", name))
+	}
+	resolvers[name] = fn
+}
+
+// Resolve calls the resolver registered under name, returning "" when there's none.
+func Resolve(ctx context.Context, name string) string {
+	mu.RLock()
+	fn, ok := resolvers[name]
+	mu.RUnlock()
+	if !ok {
+		return ""
+	}
+	return fn(ctx)
+}
+
+type contextKey string
+
+// WithValue returns a copy of ctx holding value under key, for annotations naming key as their context_key.
+func WithValue(ctx context.Context, key string, value string) context.Context {
+	return context.WithValue(ctx, contextKey(key), value)
+}
+
+// Value returns the value ctx holds under key, or "" when it holds none.
+func Value(ctx context.Context, key string) string {
+	value, _ := ctx.Value(contextKey(key)).(string)
+	return value
+}
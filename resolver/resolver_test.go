@@ -0,0 +1,40 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type principalKey struct{}
+
+func TestResolve(t *testing.T) {
+	Register("test.principal", func(ctx context.Context) string {
+		principal, _ := ctx.Value(principalKey{}).(string)
+		return principal
+	})
+
+	ctx := context.WithValue(context.Background(), principalKey{}, "acme")
+	assert.Equal(t, "acme", Resolve(ctx, "test.principal"))
+	assert.Empty(t, Resolve(context.Background(), "test.principal"))
+	assert.Empty(t, Resolve(ctx, "test.unregistered"))
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	Register("test.twice", func(context.Context) string { return "" })
+
+	assert.Panics(t, func() {
+		Register("test.twice", func(context.Context) string { return "" })
+	})
+}
+
+func TestValue(t *testing.T) {
+	ctx := WithValue(context.Background(), "tenant", "acme")
+
+	assert.Equal(t, "acme", Value(ctx, "tenant"))
+	assert.Empty(t, Value(ctx, "other"))
+	assert.Empty(t, Value(context.Background(), "tenant"))
+	// Only values set with WithValue are read, not other values under an equal string key
+	assert.Empty(t, Value(context.WithValue(context.Background(), "tenant", "acme"), "tenant"))
+}
@@ -3,15 +3,16 @@ module github.com/example/example-service
 go 1.25.0
 
 require (
+	buf.build/gen/go/permifyco/permify/protocolbuffers/go v1.36.8-20250821104952-d45a0df11d45.1
 	connectrpc.com/connect v1.18.1
 	github.com/nrf110/connectrpc-permify v0.6.0
+	github.com/nrf110/protoc-gen-connectrpc-permify v0.0.0
 	google.golang.org/protobuf v1.36.8
 )
 
 require (
 	buf.build/gen/go/envoyproxy/protoc-gen-validate/protocolbuffers/go v1.36.8-20240617172848-daf171c6cdb5.1 // indirect
 	buf.build/gen/go/grpc-ecosystem/grpc-gateway/protocolbuffers/go v1.36.8-20241220201140-4c5ba75caaf8.1 // indirect
-	buf.build/gen/go/permifyco/permify/protocolbuffers/go v1.36.8-20250821104952-d45a0df11d45.1 // indirect
 	golang.org/x/net v0.43.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
@@ -19,3 +20,5 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250826171959-ef028d996bc1 // indirect
 	google.golang.org/grpc v1.75.1 // indirect
 )
+
+replace github.com/nrf110/protoc-gen-connectrpc-permify => ../
@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/access_modes.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Member struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_test_v1_access_modes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_access_modes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_test_v1_access_modes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Member) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMyMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyMemberRequest) Reset() {
+	*x = GetMyMemberRequest{}
+	mi := &file_test_v1_access_modes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyMemberRequest) ProtoMessage() {}
+
+func (x *GetMyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_access_modes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetMyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_access_modes_proto_rawDescGZIP(), []int{1}
+}
+
+type LegacyExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegacyExportRequest) Reset() {
+	*x = LegacyExportRequest{}
+	mi := &file_test_v1_access_modes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyExportRequest) ProtoMessage() {}
+
+func (x *LegacyExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_access_modes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyExportRequest.ProtoReflect.Descriptor instead.
+func (*LegacyExportRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_access_modes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LegacyExportRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type SyncMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*Member              `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncMembersRequest) Reset() {
+	*x = SyncMembersRequest{}
+	mi := &file_test_v1_access_modes_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMembersRequest) ProtoMessage() {}
+
+func (x *SyncMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_access_modes_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMembersRequest.ProtoReflect.Descriptor instead.
+func (*SyncMembersRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_access_modes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SyncMembersRequest) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetPublicMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicMemberRequest) Reset() {
+	*x = GetPublicMemberRequest{}
+	mi := &file_test_v1_access_modes_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicMemberRequest) ProtoMessage() {}
+
+func (x *GetPublicMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_access_modes_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicMemberRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_access_modes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPublicMemberRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRequest) Reset() {
+	*x = UpdateMemberRequest{}
+	mi := &file_test_v1_access_modes_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_access_modes_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_access_modes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMemberRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+var File_test_v1_access_modes_proto protoreflect.FileDescriptor
+
+const file_test_v1_access_modes_proto_rawDesc = "" +
+	"\n" +
+	"\x1atest/v1/access_modes.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"*\n" +
+	"\x06Member\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\n" +
+	"»\x01\x06Member\"\x14\n" +
+	"\x12GetMyMemberRequest\">\n" +
+	"\x13LegacyExportRequest\x12'\n" +
+	"\x06member\x18\x01 \x01(\v2\x0f.test.v1.MemberR\x06member\"?\n" +
+	"\x12SyncMembersRequest\x12)\n" +
+	"\amembers\x18\x01 \x03(\v2\x0f.test.v1.MemberR\amembers\"A\n" +
+	"\x16GetPublicMemberRequest\x12'\n" +
+	"\x06member\x18\x01 \x01(\v2\x0f.test.v1.MemberR\x06member\">\n" +
+	"\x13UpdateMemberRequest\x12'\n" +
+	"\x06member\x18\x01 \x01(\v2\x0f.test.v1.MemberR\x06member2\xf6\x02\n" +
+	"\aMembers\x12C\n" +
+	"\vGetMyMember\x12\x1b.test.v1.GetMyMemberRequest\x1a\x11.test.v1.Response\"\x04\x80\xc2\x01\x02\x12E\n" +
+	"\fLegacyExport\x12\x1c.test.v1.LegacyExportRequest\x1a\x11.test.v1.Response\"\x04\x80\xc2\x01\x03\x12C\n" +
+	"\vSyncMembers\x12\x1b.test.v1.SyncMembersRequest\x1a\x11.test.v1.Response\"\x04\x80\xc2\x01\x04\x12K\n" +
+	"\x0fGetPublicMember\x12\x1f.test.v1.GetPublicMemberRequest\x1a\x11.test.v1.Response\"\x04\x80\xc2\x01\x01\x12M\n" +
+	"\fUpdateMember\x12\x1c.test.v1.UpdateMemberRequest\x1a\x11.test.v1.Response\"\f»\x01\x04edit\x80\xc2\x01\x05B\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_access_modes_proto_rawDescOnce sync.Once
+	file_test_v1_access_modes_proto_rawDescData []byte
+)
+
+func file_test_v1_access_modes_proto_rawDescGZIP() []byte {
+	file_test_v1_access_modes_proto_rawDescOnce.Do(func() {
+		file_test_v1_access_modes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_access_modes_proto_rawDesc), len(file_test_v1_access_modes_proto_rawDesc)))
+	})
+	return file_test_v1_access_modes_proto_rawDescData
+}
+
+var file_test_v1_access_modes_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_test_v1_access_modes_proto_goTypes = []any{
+	(*Member)(nil),                 // 0: test.v1.Member
+	(*GetMyMemberRequest)(nil),     // 1: test.v1.GetMyMemberRequest
+	(*LegacyExportRequest)(nil),    // 2: test.v1.LegacyExportRequest
+	(*SyncMembersRequest)(nil),     // 3: test.v1.SyncMembersRequest
+	(*GetPublicMemberRequest)(nil), // 4: test.v1.GetPublicMemberRequest
+	(*UpdateMemberRequest)(nil),    // 5: test.v1.UpdateMemberRequest
+	(*Response)(nil),               // 6: test.v1.Response
+}
+var file_test_v1_access_modes_proto_depIdxs = []int32{
+	0, // 0: test.v1.LegacyExportRequest.member:type_name -> test.v1.Member
+	0, // 1: test.v1.SyncMembersRequest.members:type_name -> test.v1.Member
+	0, // 2: test.v1.GetPublicMemberRequest.member:type_name -> test.v1.Member
+	0, // 3: test.v1.UpdateMemberRequest.member:type_name -> test.v1.Member
+	1, // 4: test.v1.Members.GetMyMember:input_type -> test.v1.GetMyMemberRequest
+	2, // 5: test.v1.Members.LegacyExport:input_type -> test.v1.LegacyExportRequest
+	3, // 6: test.v1.Members.SyncMembers:input_type -> test.v1.SyncMembersRequest
+	4, // 7: test.v1.Members.GetPublicMember:input_type -> test.v1.GetPublicMemberRequest
+	5, // 8: test.v1.Members.UpdateMember:input_type -> test.v1.UpdateMemberRequest
+	6, // 9: test.v1.Members.GetMyMember:output_type -> test.v1.Response
+	6, // 10: test.v1.Members.LegacyExport:output_type -> test.v1.Response
+	6, // 11: test.v1.Members.SyncMembers:output_type -> test.v1.Response
+	6, // 12: test.v1.Members.GetPublicMember:output_type -> test.v1.Response
+	6, // 13: test.v1.Members.UpdateMember:output_type -> test.v1.Response
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_access_modes_proto_init() }
+func file_test_v1_access_modes_proto_init() {
+	if File_test_v1_access_modes_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_access_modes_proto_rawDesc), len(file_test_v1_access_modes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_access_modes_proto_goTypes,
+		DependencyIndexes: file_test_v1_access_modes_proto_depIdxs,
+		MessageInfos:      file_test_v1_access_modes_proto_msgTypes,
+	}.Build()
+	File_test_v1_access_modes_proto = out.File
+	file_test_v1_access_modes_proto_goTypes = nil
+	file_test_v1_access_modes_proto_depIdxs = nil
+}
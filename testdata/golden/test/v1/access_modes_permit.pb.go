@@ -0,0 +1,79 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+func (req *GetMyMemberRequest) GetChecks() pkg.CheckConfig {
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   []pkg.Check{},
+	}
+}
+
+func (req *LegacyExportRequest) GetChecks() pkg.CheckConfig {
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks: []pkg.Check{
+			{
+				Entity: &pkg.Resource{
+					Attributes: map[string]any{
+						"deny_reason": "method denies every call",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (req *SyncMembersRequest) GetChecks() pkg.CheckConfig {
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks: []pkg.Check{
+			{
+				Entity: &pkg.Resource{
+					Attributes: map[string]any{
+						"deny_reason": "method is internal",
+					},
+				},
+			},
+		},
+	}
+}
+
+// GetAccessMode returns how the method is authorized. GetChecks denies every call, so an interceptor allowing
+// internal calls must check it first.
+func (req *SyncMembersRequest) GetAccessMode() string {
+	return "internal"
+}
+
+func (req *GetPublicMemberRequest) GetChecks() pkg.CheckConfig {
+	return pkg.CheckConfig{
+		IsPublic: true,
+		Checks:   []pkg.Check{},
+	}
+}
+
+func (req *UpdateMemberRequest) GetChecks() pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Member
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Member",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
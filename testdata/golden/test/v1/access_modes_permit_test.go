@@ -0,0 +1,51 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkMembersGetMyMemberGetChecks(b *testing.B) {
+	req := &GetMyMemberRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMembersLegacyExportGetChecks(b *testing.B) {
+	req := &LegacyExportRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMembersSyncMembersGetChecks(b *testing.B) {
+	req := &SyncMembersRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMembersGetPublicMemberGetChecks(b *testing.B) {
+	req := &GetPublicMemberRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMembersUpdateMemberGetChecks(b *testing.B) {
+	req := &UpdateMemberRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
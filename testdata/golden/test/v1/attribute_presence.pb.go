@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/attribute_presence.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Milestone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Milestone) Reset() {
+	*x = Milestone{}
+	mi := &file_test_v1_attribute_presence_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Milestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Milestone) ProtoMessage() {}
+
+func (x *Milestone) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_attribute_presence_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Milestone.ProtoReflect.Descriptor instead.
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return file_test_v1_attribute_presence_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Milestone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Department    string                 `protobuf:"bytes,2,opt,name=department,proto3" json:"department,omitempty"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Stage         string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	Owner         *string                `protobuf:"bytes,5,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Level         *int32                 `protobuf:"varint,6,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	Confidential  *bool                  `protobuf:"varint,7,opt,name=confidential,proto3,oneof" json:"confidential,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Milestones    []*Milestone           `protobuf:"bytes,9,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,11,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Budget        float64                `protobuf:"fixed64,12,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceRequest) Reset() {
+	*x = PresenceRequest{}
+	mi := &file_test_v1_attribute_presence_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceRequest) ProtoMessage() {}
+
+func (x *PresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_attribute_presence_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceRequest.ProtoReflect.Descriptor instead.
+func (*PresenceRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_attribute_presence_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PresenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PresenceRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *PresenceRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *PresenceRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *PresenceRequest) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *PresenceRequest) GetLevel() int32 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
+func (x *PresenceRequest) GetConfidential() bool {
+	if x != nil && x.Confidential != nil {
+		return *x.Confidential
+	}
+	return false
+}
+
+func (x *PresenceRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PresenceRequest) GetMilestones() []*Milestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+func (x *PresenceRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PresenceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PresenceRequest) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+var File_test_v1_attribute_presence_proto protoreflect.FileDescriptor
+
+const file_test_v1_attribute_presence_proto_rawDesc = "" +
+	"\n" +
+	" test/v1/attribute_presence.proto\x12\atest.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"3\n" +
+	"\tMilestone\x12&\n" +
+	"\x04name\x18\x01 \x01(\tB\x12һ\x01\n" +
+	"milestones\x80\xc2\x01\x03R\x04name\"\xa2\x05\n" +
+	"\x0fPresenceRequest\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12.\n" +
+	"\n" +
+	"department\x18\x02 \x01(\tB\x0eһ\x01\n" +
+	"departmentR\n" +
+	"department\x12,\n" +
+	"\bnickname\x18\x03 \x01(\tB\x10һ\x01\bnickname\x80\xc2\x01\x02R\bnickname\x12,\n" +
+	"\x05stage\x18\x04 \x01(\tB\x16һ\x01\x05stage\x80\xc2\x01\x02\x8a\xc2\x01\x05draftR\x05stage\x12(\n" +
+	"\x05owner\x18\x05 \x01(\tB\rһ\x01\x05owner\x80\xc2\x01\x03H\x00R\x05owner\x88\x01\x01\x12)\n" +
+	"\x05level\x18\x06 \x01(\x05B\x0eһ\x01\x05level\x8a\xc2\x01\x011H\x01R\x05level\x88\x01\x01\x12=\n" +
+	"\fconfidential\x18\a \x01(\bB\x14һ\x01\fconfidential\x80\xc2\x01\x02H\x02R\fconfidential\x88\x01\x01\x12 \n" +
+	"\x04tags\x18\b \x03(\tB\fһ\x01\x04tags\x80\xc2\x01\x03R\x04tags\x122\n" +
+	"\n" +
+	"milestones\x18\t \x03(\v2\x12.test.v1.MilestoneR\n" +
+	"milestones\x12M\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x12һ\x01\n" +
+	"created_at\x80\xc2\x01\x03R\tcreatedAt\x12P\n" +
+	"\vupdate_mask\x18\v \x01(\v2\x1a.google.protobuf.FieldMaskB\x13һ\x01\vupdate_mask\x80\xc2\x01\x02R\n" +
+	"updateMask\x120\n" +
+	"\x06budget\x18\f \x01(\x01B\x18һ\x01\x06budget\x80\xc2\x01\x02\x8a\xc2\x01\x061000.5R\x06budget:\v»\x01\aProjectB\b\n" +
+	"\x06_ownerB\b\n" +
+	"\x06_levelB\x0f\n" +
+	"\r_confidential2R\n" +
+	"\bPresence\x12F\n" +
+	"\rUpdateProject\x12\x18.test.v1.PresenceRequest\x1a\x11.test.v1.Response\"\b»\x01\x04editB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_attribute_presence_proto_rawDescOnce sync.Once
+	file_test_v1_attribute_presence_proto_rawDescData []byte
+)
+
+func file_test_v1_attribute_presence_proto_rawDescGZIP() []byte {
+	file_test_v1_attribute_presence_proto_rawDescOnce.Do(func() {
+		file_test_v1_attribute_presence_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_attribute_presence_proto_rawDesc), len(file_test_v1_attribute_presence_proto_rawDesc)))
+	})
+	return file_test_v1_attribute_presence_proto_rawDescData
+}
+
+var file_test_v1_attribute_presence_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_test_v1_attribute_presence_proto_goTypes = []any{
+	(*Milestone)(nil),             // 0: test.v1.Milestone
+	(*PresenceRequest)(nil),       // 1: test.v1.PresenceRequest
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 3: google.protobuf.FieldMask
+	(*Response)(nil),              // 4: test.v1.Response
+}
+var file_test_v1_attribute_presence_proto_depIdxs = []int32{
+	0, // 0: test.v1.PresenceRequest.milestones:type_name -> test.v1.Milestone
+	2, // 1: test.v1.PresenceRequest.created_at:type_name -> google.protobuf.Timestamp
+	3, // 2: test.v1.PresenceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1, // 3: test.v1.Presence.UpdateProject:input_type -> test.v1.PresenceRequest
+	4, // 4: test.v1.Presence.UpdateProject:output_type -> test.v1.Response
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_attribute_presence_proto_init() }
+func file_test_v1_attribute_presence_proto_init() {
+	if File_test_v1_attribute_presence_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_attribute_presence_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_attribute_presence_proto_rawDesc), len(file_test_v1_attribute_presence_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_attribute_presence_proto_goTypes,
+		DependencyIndexes: file_test_v1_attribute_presence_proto_depIdxs,
+		MessageInfos:      file_test_v1_attribute_presence_proto_msgTypes,
+	}.Build()
+	File_test_v1_attribute_presence_proto = out.File
+	file_test_v1_attribute_presence_proto_goTypes = nil
+	file_test_v1_attribute_presence_proto_depIdxs = nil
+}
@@ -0,0 +1,77 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+func (req *PresenceRequest) GetChecks() pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	attributes := make(map[string]any, 11)
+	attributes["budget"] = float64(1000.5)
+	if v1 := resource.Budget; v1 != 0 {
+		attributes["budget"] = v1
+	}
+	if resource.Confidential != nil {
+		if v2 := *resource.Confidential; v2 {
+			attributes["confidential"] = v2
+		}
+	}
+	attributes["created_at"] = float64(0)
+	if resource.CreatedAt != nil {
+		attributes["created_at"] = float64(resource.CreatedAt.GetSeconds())
+	}
+	attributes["department"] = resource.Department
+	attributes["level"] = int32(1)
+	if resource.Level != nil {
+		attributes["level"] = *resource.Level
+	}
+	attributes["milestones"] = []string{}
+	milestonesValues := make([]string, 0, len(resource.Milestones))
+	for _, v3 := range resource.Milestones {
+		milestonesValues = append(milestonesValues, v3.Name)
+	}
+	if len(milestonesValues) > 0 {
+		attributes["milestones"] = milestonesValues
+	}
+	if v4 := resource.Nickname; v4 != "" {
+		attributes["nickname"] = v4
+	}
+	attributes["owner"] = ""
+	if resource.Owner != nil {
+		attributes["owner"] = *resource.Owner
+	}
+	attributes["stage"] = "draft"
+	if v5 := resource.Stage; v5 != "" {
+		attributes["stage"] = v5
+	}
+	attributes["tags"] = []string{}
+	if len(resource.Tags) > 0 {
+		attributes["tags"] = resource.Tags
+	}
+	if resource.UpdateMask != nil {
+		if v6 := resource.UpdateMask.GetPaths(); len(v6) > 0 {
+			attributes["update_mask"] = v6
+		}
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type:       "Project",
+			ID:         id,
+			Attributes: attributes,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
@@ -0,0 +1,15 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkPresenceUpdateProjectGetChecks(b *testing.B) {
+	req := &PresenceRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
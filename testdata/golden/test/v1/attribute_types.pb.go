@@ -0,0 +1,444 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/attribute_types.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Visibility int32
+
+const (
+	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
+	Visibility_VISIBILITY_PRIVATE     Visibility = 1
+	Visibility_VISIBILITY_PUBLIC      Visibility = 2
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "VISIBILITY_PRIVATE",
+		2: "VISIBILITY_PUBLIC",
+	}
+	Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"VISIBILITY_PRIVATE":     1,
+		"VISIBILITY_PUBLIC":      2,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_v1_attribute_types_proto_enumTypes[0].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_test_v1_attribute_types_proto_enumTypes[0]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_test_v1_attribute_types_proto_rawDescGZIP(), []int{0}
+}
+
+type Label struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	mi := &file_test_v1_attribute_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_attribute_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_test_v1_attribute_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Label) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Label) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Revision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float32                `protobuf:"fixed32,1,opt,name=score,proto3" json:"score,omitempty"`
+	Reviewers     []string               `protobuf:"bytes,2,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Stage         Visibility             `protobuf:"varint,3,opt,name=stage,proto3,enum=test.v1.Visibility" json:"stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_test_v1_attribute_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_attribute_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_test_v1_attribute_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Revision) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Revision) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *Revision) GetStage() Visibility {
+	if x != nil {
+		return x.Stage
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type TypedAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Archived      bool                   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	Visibility    Visibility             `protobuf:"varint,3,opt,name=visibility,proto3,enum=test.v1.Visibility" json:"visibility,omitempty"`
+	Weight        float32                `protobuf:"fixed32,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Version       uint32                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Checksum      uint64                 `protobuf:"varint,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Audiences     []Visibility           `protobuf:"varint,8,rep,packed,name=audiences,proto3,enum=test.v1.Visibility" json:"audiences,omitempty"`
+	Quotas        map[string]int32       `protobuf:"bytes,9,rep,name=quotas,proto3" json:"quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Topics        []string               `protobuf:"bytes,10,rep,name=topics,proto3" json:"topics,omitempty"`
+	Labels        []*Label               `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty"`
+	Revisions     []*Revision            `protobuf:"bytes,12,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	Status        Visibility             `protobuf:"varint,13,opt,name=status,proto3,enum=test.v1.Visibility" json:"status,omitempty"`
+	Channels      []Visibility           `protobuf:"varint,14,rep,packed,name=channels,proto3,enum=test.v1.Visibility" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypedAttributesRequest) Reset() {
+	*x = TypedAttributesRequest{}
+	mi := &file_test_v1_attribute_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypedAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedAttributesRequest) ProtoMessage() {}
+
+func (x *TypedAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_attribute_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedAttributesRequest.ProtoReflect.Descriptor instead.
+func (*TypedAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_attribute_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TypedAttributesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TypedAttributesRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *TypedAttributesRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *TypedAttributesRequest) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TypedAttributesRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TypedAttributesRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TypedAttributesRequest) GetChecksum() uint64 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
+func (x *TypedAttributesRequest) GetAudiences() []Visibility {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (x *TypedAttributesRequest) GetQuotas() map[string]int32 {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *TypedAttributesRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *TypedAttributesRequest) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *TypedAttributesRequest) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *TypedAttributesRequest) GetStatus() Visibility {
+	if x != nil {
+		return x.Status
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *TypedAttributesRequest) GetChannels() []Visibility {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+var File_test_v1_attribute_types_proto protoreflect.FileDescriptor
+
+const file_test_v1_attribute_types_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtest/v1/attribute_types.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"/\n" +
+	"\x05Label\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x94\x01\n" +
+	"\bRevision\x12 \n" +
+	"\x05score\x18\x01 \x01(\x02B\n" +
+	"һ\x01\x06scoresR\x05score\x12+\n" +
+	"\treviewers\x18\x02 \x03(\tB\rһ\x01\treviewersR\treviewers\x129\n" +
+	"\x05stage\x18\x03 \x01(\x0e2\x13.test.v1.VisibilityB\x0eһ\x01\x06stages\xf0\xc1\x01\x02R\x05stage\"\xeb\x06\n" +
+	"\x16TypedAttributesRequest\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12(\n" +
+	"\barchived\x18\x02 \x01(\bB\fһ\x01\barchivedR\barchived\x12C\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x0e2\x13.test.v1.VisibilityB\x0eһ\x01\n" +
+	"visibilityR\n" +
+	"visibility\x12\"\n" +
+	"\x06weight\x18\x04 \x01(\x02B\n" +
+	"һ\x01\x06weightR\x06weight\x12 \n" +
+	"\x04size\x18\x05 \x01(\x03B\fһ\x01\x04size\xf0\xc1\x01\x04R\x04size\x12)\n" +
+	"\aversion\x18\x06 \x01(\rB\x0fһ\x01\aversion\xf0\xc1\x01\x04R\aversion\x12,\n" +
+	"\bchecksum\x18\a \x01(\x04B\x10һ\x01\bchecksum\xf0\xc1\x01\x02R\bchecksum\x12@\n" +
+	"\taudiences\x18\b \x03(\x0e2\x13.test.v1.VisibilityB\rһ\x01\taudiencesR\taudiences\x12a\n" +
+	"\x06quotas\x18\t \x03(\v2+.test.v1.TypedAttributesRequest.QuotasEntryB\x1cһ\x01\x06quotas\xfa\xc1\x01\x0equotaAttributeR\x06quotas\x12\"\n" +
+	"\x06topics\x18\n" +
+	" \x03(\tB\n" +
+	"һ\x01\x06topicsR\x06topics\x12j\n" +
+	"\x06labels\x18\v \x03(\v2\x0e.test.v1.LabelBBһ\x01\x06labels\xfa\xc1\x014google.golang.org/protobuf/encoding/prototext.FormatR\x06labels\x12/\n" +
+	"\trevisions\x18\f \x03(\v2\x11.test.v1.RevisionR\trevisions\x12;\n" +
+	"\x06status\x18\r \x01(\x0e2\x13.test.v1.VisibilityB\x0eһ\x01\x06status\xf0\xc1\x01\x02R\x06status\x12A\n" +
+	"\bchannels\x18\x0e \x03(\x0e2\x13.test.v1.VisibilityB\x10һ\x01\bchannels\xf0\xc1\x01\x02R\bchannels\x1a9\n" +
+	"\vQuotasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01:\f»\x01\bDocument*W\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12VISIBILITY_PRIVATE\x10\x01\x12\x15\n" +
+	"\x11VISIBILITY_PUBLIC\x10\x022a\n" +
+	"\x0fTypedAttributes\x12N\n" +
+	"\x0eUpdateDocument\x12\x1f.test.v1.TypedAttributesRequest\x1a\x11.test.v1.Response\"\b»\x01\x04editB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_attribute_types_proto_rawDescOnce sync.Once
+	file_test_v1_attribute_types_proto_rawDescData []byte
+)
+
+func file_test_v1_attribute_types_proto_rawDescGZIP() []byte {
+	file_test_v1_attribute_types_proto_rawDescOnce.Do(func() {
+		file_test_v1_attribute_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_attribute_types_proto_rawDesc), len(file_test_v1_attribute_types_proto_rawDesc)))
+	})
+	return file_test_v1_attribute_types_proto_rawDescData
+}
+
+var file_test_v1_attribute_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_v1_attribute_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_test_v1_attribute_types_proto_goTypes = []any{
+	(Visibility)(0),                // 0: test.v1.Visibility
+	(*Label)(nil),                  // 1: test.v1.Label
+	(*Revision)(nil),               // 2: test.v1.Revision
+	(*TypedAttributesRequest)(nil), // 3: test.v1.TypedAttributesRequest
+	nil,                            // 4: test.v1.TypedAttributesRequest.QuotasEntry
+	(*Response)(nil),               // 5: test.v1.Response
+}
+var file_test_v1_attribute_types_proto_depIdxs = []int32{
+	0, // 0: test.v1.Revision.stage:type_name -> test.v1.Visibility
+	0, // 1: test.v1.TypedAttributesRequest.visibility:type_name -> test.v1.Visibility
+	0, // 2: test.v1.TypedAttributesRequest.audiences:type_name -> test.v1.Visibility
+	4, // 3: test.v1.TypedAttributesRequest.quotas:type_name -> test.v1.TypedAttributesRequest.QuotasEntry
+	1, // 4: test.v1.TypedAttributesRequest.labels:type_name -> test.v1.Label
+	2, // 5: test.v1.TypedAttributesRequest.revisions:type_name -> test.v1.Revision
+	0, // 6: test.v1.TypedAttributesRequest.status:type_name -> test.v1.Visibility
+	0, // 7: test.v1.TypedAttributesRequest.channels:type_name -> test.v1.Visibility
+	3, // 8: test.v1.TypedAttributes.UpdateDocument:input_type -> test.v1.TypedAttributesRequest
+	5, // 9: test.v1.TypedAttributes.UpdateDocument:output_type -> test.v1.Response
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_attribute_types_proto_init() }
+func file_test_v1_attribute_types_proto_init() {
+	if File_test_v1_attribute_types_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_attribute_types_proto_rawDesc), len(file_test_v1_attribute_types_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_attribute_types_proto_goTypes,
+		DependencyIndexes: file_test_v1_attribute_types_proto_depIdxs,
+		EnumInfos:         file_test_v1_attribute_types_proto_enumTypes,
+		MessageInfos:      file_test_v1_attribute_types_proto_msgTypes,
+	}.Build()
+	File_test_v1_attribute_types_proto = out.File
+	file_test_v1_attribute_types_proto_goTypes = nil
+	file_test_v1_attribute_types_proto_depIdxs = nil
+}
@@ -0,0 +1,95 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	prototext "google.golang.org/protobuf/encoding/prototext"
+	maps "maps"
+	slices "slices"
+	strconv "strconv"
+)
+
+func (req *TypedAttributesRequest) GetChecks() pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	attributes := make(map[string]any, 15)
+	attributes["archived"] = resource.Archived
+	audiencesValues := make([]int32, 0, len(resource.Audiences))
+	for _, v1 := range resource.Audiences {
+		audiencesValues = append(audiencesValues, int32(v1))
+	}
+	if len(audiencesValues) > 0 {
+		attributes["audiences"] = audiencesValues
+	}
+	channelsValues := make([]string, 0, len(resource.Channels))
+	for _, v2 := range resource.Channels {
+		channelsValues = append(channelsValues, v2.String())
+	}
+	if len(channelsValues) > 0 {
+		attributes["channels"] = channelsValues
+	}
+	attributes["checksum"] = strconv.FormatUint(resource.Checksum, 10)
+	labelsValues := make([]any, 0, len(resource.Labels))
+	for _, v3 := range resource.Labels {
+		labelsValues = append(labelsValues, prototext.Format(v3))
+	}
+	if len(labelsValues) > 0 {
+		attributes["labels"] = labelsValues
+	}
+	quotasValues := make([]any, 0, len(resource.Quotas))
+	for _, v4 := range slices.Sorted(maps.Keys(resource.Quotas)) {
+		v5 := resource.Quotas[v4]
+		quotasValues = append(quotasValues, quotaAttribute(v4, v5))
+	}
+	if len(quotasValues) > 0 {
+		attributes["quotas"] = quotasValues
+	}
+	reviewersValues := make([]string, 0, len(resource.Revisions))
+	for _, v6 := range resource.Revisions {
+		reviewersValues = append(reviewersValues, v6.Reviewers...)
+	}
+	if len(reviewersValues) > 0 {
+		attributes["reviewers"] = reviewersValues
+	}
+	scoresValues := make([]float64, 0, len(resource.Revisions))
+	for _, v7 := range resource.Revisions {
+		scoresValues = append(scoresValues, float64(v7.Score))
+	}
+	if len(scoresValues) > 0 {
+		attributes["scores"] = scoresValues
+	}
+	attributes["size"] = float64(resource.Size)
+	stagesValues := make([]string, 0, len(resource.Revisions))
+	for _, v8 := range resource.Revisions {
+		stagesValues = append(stagesValues, v8.Stage.String())
+	}
+	if len(stagesValues) > 0 {
+		attributes["stages"] = stagesValues
+	}
+	attributes["status"] = resource.Status.String()
+	if len(resource.Topics) > 0 {
+		attributes["topics"] = resource.Topics
+	}
+	attributes["version"] = float64(resource.Version)
+	attributes["visibility"] = int32(resource.Visibility)
+	attributes["weight"] = float64(resource.Weight)
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type:       "Document",
+			ID:         id,
+			Attributes: attributes,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
@@ -0,0 +1,15 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkTypedAttributesUpdateDocumentGetChecks(b *testing.B) {
+	req := &TypedAttributesRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -8,6 +8,7 @@ package testv1
 
 import (
 	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -242,16 +243,16 @@ var File_test_v1_attributes_proto protoreflect.FileDescriptor
 
 const file_test_v1_attributes_proto_rawDesc = "" +
 	"\n" +
-	"\x18test/v1/attributes.proto\x12\atest.v1\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\",\n" +
+	"\x18test/v1/attributes.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\",\n" +
 	"\x0fNestedAttribute\x12\x19\n" +
 	"\x03foo\x18\x01 \x01(\tB\aһ\x01\x03fooR\x03foo\",\n" +
 	"\x0fMappedAttribute\x12\x19\n" +
 	"\x03bar\x18\x01 \x01(\tB\aһ\x01\x03fooR\x03bar\":\n" +
 	"\x10ComplexAttribute\x12\x12\n" +
 	"\x04blah\x18\x01 \x03(\tR\x04blah\x12\x12\n" +
-	"\x04blar\x18\x02 \x01(\x01R\x04blar\"\xe7\x02\n" +
-	"\x11AttributesRequest\x12@\n" +
-	"\acomplex\x18\x01 \x03(\v2\x19.test.v1.ComplexAttributeB\vһ\x01\acomplexR\acomplex\x120\n" +
+	"\x04blar\x18\x02 \x01(\x01R\x04blar\"\x80\x03\n" +
+	"\x11AttributesRequest\x12Y\n" +
+	"\acomplex\x18\x01 \x03(\v2\x19.test.v1.ComplexAttributeB$һ\x01\acomplex\xfa\xc1\x01\x15complexAttributeValueR\acomplex\x120\n" +
 	"\x06nested\x18\x02 \x03(\v2\x18.test.v1.NestedAttributeR\x06nested\x12>\n" +
 	"\x06mapped\x18\x03 \x03(\v2&.test.v1.AttributesRequest.MappedEntryR\x06mapped\x12\x14\n" +
 	"\x02id\x18\x04 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12#\n" +
@@ -2,11 +2,13 @@ package testv1
 
 import (
 	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	maps "maps"
+	slices "slices"
 )
 
 func (req *AttributesRequest) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
@@ -16,11 +18,18 @@ func (req *AttributesRequest) GetChecks() pkg.CheckConfig {
 	if resource.CompanyId != "" {
 		tenantId = resource.CompanyId
 	}
-	attributes := make(map[string]any)
-	attributes["complex"] = resource.Complex
-	var fooValues []any
-	for _, v1 := range resource.Mapped {
-		fooValues = append(fooValues, v1.Bar)
+	attributes := make(map[string]any, 2)
+	complexValues := make([]any, 0, len(resource.Complex))
+	for _, v1 := range resource.Complex {
+		complexValues = append(complexValues, complexAttributeValue(v1))
+	}
+	if len(complexValues) > 0 {
+		attributes["complex"] = complexValues
+	}
+	fooValues := make([]string, 0, len(resource.Mapped))
+	for _, v3 := range slices.Sorted(maps.Keys(resource.Mapped)) {
+		v2 := resource.Mapped[v3]
+		fooValues = append(fooValues, v2.Bar)
 	}
 	if len(fooValues) > 0 {
 		attributes["foo"] = fooValues
@@ -0,0 +1,15 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkAttributesWithAttributesGetChecks(b *testing.B) {
+	req := &AttributesRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/check_limits.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Shipment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_test_v1_check_limits_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_limits_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_limits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shipment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shipment) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type TrackShipmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackShipmentsRequest) Reset() {
+	*x = TrackShipmentsRequest{}
+	mi := &file_test_v1_check_limits_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackShipmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackShipmentsRequest) ProtoMessage() {}
+
+func (x *TrackShipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_limits_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackShipmentsRequest.ProtoReflect.Descriptor instead.
+func (*TrackShipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_limits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrackShipmentsRequest) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+type Parcel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Parcel) Reset() {
+	*x = Parcel{}
+	mi := &file_test_v1_check_limits_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Parcel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Parcel) ProtoMessage() {}
+
+func (x *Parcel) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_limits_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Parcel.ProtoReflect.Descriptor instead.
+func (*Parcel) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_limits_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Parcel) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type LabelParcelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parcels       map[string]*Parcel     `protobuf:"bytes,1,rep,name=parcels,proto3" json:"parcels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelParcelsRequest) Reset() {
+	*x = LabelParcelsRequest{}
+	mi := &file_test_v1_check_limits_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelParcelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelParcelsRequest) ProtoMessage() {}
+
+func (x *LabelParcelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_limits_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelParcelsRequest.ProtoReflect.Descriptor instead.
+func (*LabelParcelsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_limits_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LabelParcelsRequest) GetParcels() map[string]*Parcel {
+	if x != nil {
+		return x.Parcels
+	}
+	return nil
+}
+
+type ManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestRequest) Reset() {
+	*x = ManifestRequest{}
+	mi := &file_test_v1_check_limits_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestRequest) ProtoMessage() {}
+
+func (x *ManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_limits_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestRequest.ProtoReflect.Descriptor instead.
+func (*ManifestRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_limits_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ManifestRequest) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+var File_test_v1_check_limits_proto protoreflect.FileDescriptor
+
+const file_test_v1_check_limits_proto_rawDesc = "" +
+	"\n" +
+	"\x1atest/v1/check_limits.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"Q\n" +
+	"\bShipment\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\f»\x01\bShipment\"H\n" +
+	"\x15TrackShipmentsRequest\x12/\n" +
+	"\tshipments\x18\x01 \x03(\v2\x11.test.v1.ShipmentR\tshipments\"7\n" +
+	"\x06Parcel\x12!\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\n" +
+	"»\x01\x06Parcel\"\xad\x01\n" +
+	"\x13LabelParcelsRequest\x12I\n" +
+	"\aparcels\x18\x01 \x03(\v2).test.v1.LabelParcelsRequest.ParcelsEntryB\x04\xe8\xc1\x01\x01R\aparcels\x1aK\n" +
+	"\fParcelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12%\n" +
+	"\x05value\x18\x02 \x01(\v2\x0f.test.v1.ParcelR\x05value:\x028\x01\"B\n" +
+	"\x0fManifestRequest\x12/\n" +
+	"\tshipments\x18\x01 \x03(\v2\x11.test.v1.ShipmentR\tshipments2\x83\x02\n" +
+	"\bShipping\x12R\n" +
+	"\x0eTrackShipments\x12\x1e.test.v1.TrackShipmentsRequest\x1a\x11.test.v1.Response\"\r»\x01\x05track\x88\xc2\x012\x12N\n" +
+	"\fLabelParcels\x12\x1c.test.v1.LabelParcelsRequest\x1a\x11.test.v1.Response\"\r»\x01\x05label\x88\xc2\x01\n" +
+	"\x12S\n" +
+	"\bManifest\x12\x18.test.v1.ManifestRequest\x1a\x11.test.v1.Response\"\x1a\xf2\xc1\x01\x04view\xf2\xc1\x01\x06export\xf8\xc1\x01\x01\x88\xc2\x01\x14B\x14\x80\xc2\x01\x00Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_check_limits_proto_rawDescOnce sync.Once
+	file_test_v1_check_limits_proto_rawDescData []byte
+)
+
+func file_test_v1_check_limits_proto_rawDescGZIP() []byte {
+	file_test_v1_check_limits_proto_rawDescOnce.Do(func() {
+		file_test_v1_check_limits_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_check_limits_proto_rawDesc), len(file_test_v1_check_limits_proto_rawDesc)))
+	})
+	return file_test_v1_check_limits_proto_rawDescData
+}
+
+var file_test_v1_check_limits_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_test_v1_check_limits_proto_goTypes = []any{
+	(*Shipment)(nil),              // 0: test.v1.Shipment
+	(*TrackShipmentsRequest)(nil), // 1: test.v1.TrackShipmentsRequest
+	(*Parcel)(nil),                // 2: test.v1.Parcel
+	(*LabelParcelsRequest)(nil),   // 3: test.v1.LabelParcelsRequest
+	(*ManifestRequest)(nil),       // 4: test.v1.ManifestRequest
+	nil,                           // 5: test.v1.LabelParcelsRequest.ParcelsEntry
+	(*Response)(nil),              // 6: test.v1.Response
+}
+var file_test_v1_check_limits_proto_depIdxs = []int32{
+	0, // 0: test.v1.TrackShipmentsRequest.shipments:type_name -> test.v1.Shipment
+	5, // 1: test.v1.LabelParcelsRequest.parcels:type_name -> test.v1.LabelParcelsRequest.ParcelsEntry
+	0, // 2: test.v1.ManifestRequest.shipments:type_name -> test.v1.Shipment
+	2, // 3: test.v1.LabelParcelsRequest.ParcelsEntry.value:type_name -> test.v1.Parcel
+	1, // 4: test.v1.Shipping.TrackShipments:input_type -> test.v1.TrackShipmentsRequest
+	3, // 5: test.v1.Shipping.LabelParcels:input_type -> test.v1.LabelParcelsRequest
+	4, // 6: test.v1.Shipping.Manifest:input_type -> test.v1.ManifestRequest
+	6, // 7: test.v1.Shipping.TrackShipments:output_type -> test.v1.Response
+	6, // 8: test.v1.Shipping.LabelParcels:output_type -> test.v1.Response
+	6, // 9: test.v1.Shipping.Manifest:output_type -> test.v1.Response
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_check_limits_proto_init() }
+func file_test_v1_check_limits_proto_init() {
+	if File_test_v1_check_limits_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_check_limits_proto_rawDesc), len(file_test_v1_check_limits_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_check_limits_proto_goTypes,
+		DependencyIndexes: file_test_v1_check_limits_proto_depIdxs,
+		MessageInfos:      file_test_v1_check_limits_proto_msgTypes,
+	}.Build()
+	File_test_v1_check_limits_proto = out.File
+	file_test_v1_check_limits_proto_goTypes = nil
+	file_test_v1_check_limits_proto_depIdxs = nil
+}
@@ -0,0 +1,187 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+// GetChecks denies requests that would produce more than 50 checks.
+func (req *TrackShipmentsRequest) GetChecks() pkg.CheckConfig {
+	permission := "track"
+	checks := make([]pkg.Check, 0, min(len(req.Shipments), 50))
+	if len(checks)+len(req.Shipments) > 50 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 50 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	for _, v1 := range req.Shipments {
+		resource := v1
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Shipment",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	if len(checks) > 50 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 50 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks denies requests that would produce more than 10 checks.
+func (req *LabelParcelsRequest) GetChecks() pkg.CheckConfig {
+	permission := "label"
+	checks := make([]pkg.Check, 0, min(len(req.Parcels), 10))
+	if len(checks)+len(req.Parcels) > 10 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 10 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	for v2, v3 := range req.Parcels {
+		resource := v3
+		var id string
+		if v2 != "" {
+			id = v2
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Parcel",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	if len(checks) > 10 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 10 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks denies requests that would produce more than 20 checks.
+func (req *ManifestRequest) GetChecks() pkg.CheckConfig {
+	permissions := []string{"view", "export"}
+	checks := make([]pkg.Check, 0, min(len(req.Shipments)*2, 20))
+	if len(checks)+len(req.Shipments) > 20 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 20 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	for _, v4 := range req.Shipments {
+		resource := v4
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		for _, permission := range permissions {
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Shipment",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		}
+	}
+	if len(checks) > 20 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 20 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetPermissionCombinator returns how the checks returned by GetChecks combine, all_of or any_of. Each entity
+// has one consecutive check per permission, in the order view, export.
+func (req *ManifestRequest) GetPermissionCombinator() string {
+	return "all_of"
+}
@@ -0,0 +1,33 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkShippingTrackShipmentsGetChecks(b *testing.B) {
+	req := &TrackShipmentsRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkShippingLabelParcelsGetChecks(b *testing.B) {
+	req := &LabelParcelsRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkShippingManifestGetChecks(b *testing.B) {
+	req := &ManifestRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,352 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/check_metadata.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Invoice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_test_v1_check_metadata_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_metadata_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Consistency struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SnapToken     *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=snap_token,json=snapToken,proto3" json:"snap_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Consistency) Reset() {
+	*x = Consistency{}
+	mi := &file_test_v1_check_metadata_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Consistency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consistency) ProtoMessage() {}
+
+func (x *Consistency) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_metadata_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consistency.ProtoReflect.Descriptor instead.
+func (*Consistency) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_metadata_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Consistency) GetSnapToken() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SnapToken
+	}
+	return nil
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	SnapToken     string                 `protobuf:"bytes,2,opt,name=snap_token,json=snapToken,proto3" json:"snap_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_test_v1_check_metadata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_metadata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetInvoiceRequest) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *GetInvoiceRequest) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+type ApproveInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	Consistency   *Consistency           `protobuf:"bytes,2,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveInvoiceRequest) Reset() {
+	*x = ApproveInvoiceRequest{}
+	mi := &file_test_v1_check_metadata_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveInvoiceRequest) ProtoMessage() {}
+
+func (x *ApproveInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_metadata_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*ApproveInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_metadata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApproveInvoiceRequest) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *ApproveInvoiceRequest) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
+type AuditInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditInvoiceRequest) Reset() {
+	*x = AuditInvoiceRequest{}
+	mi := &file_test_v1_check_metadata_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInvoiceRequest) ProtoMessage() {}
+
+func (x *AuditInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_check_metadata_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*AuditInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_check_metadata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuditInvoiceRequest) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+var File_test_v1_check_metadata_proto protoreflect.FileDescriptor
+
+const file_test_v1_check_metadata_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctest/v1/check_metadata.proto\x12\atest.v1\x1a\x1egoogle/protobuf/wrappers.proto\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\",\n" +
+	"\aInvoice\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\v»\x01\aInvoice\"P\n" +
+	"\vConsistency\x12A\n" +
+	"\n" +
+	"snap_token\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueB\x04\xa0\xc2\x01\x01R\tsnapToken\"d\n" +
+	"\x11GetInvoiceRequest\x12*\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x10.test.v1.InvoiceR\ainvoice\x12#\n" +
+	"\n" +
+	"snap_token\x18\x02 \x01(\tB\x04\xa0\xc2\x01\x01R\tsnapToken\"{\n" +
+	"\x15ApproveInvoiceRequest\x12*\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x10.test.v1.InvoiceR\ainvoice\x126\n" +
+	"\vconsistency\x18\x02 \x01(\v2\x14.test.v1.ConsistencyR\vconsistency\"A\n" +
+	"\x13AuditInvoiceRequest\x12*\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x10.test.v1.InvoiceR\ainvoice2\xf7\x01\n" +
+	"\bInvoices\x12E\n" +
+	"\n" +
+	"GetInvoice\x12\x1a.test.v1.GetInvoiceRequest\x1a\x11.test.v1.Response\"\b»\x01\x04view\x12T\n" +
+	"\x0eApproveInvoice\x12\x1e.test.v1.ApproveInvoiceRequest\x1a\x11.test.v1.Response\"\x0f»\x01\aapprove\xe8\xc1\x01\x14\x12N\n" +
+	"\fAuditInvoice\x12\x1c.test.v1.AuditInvoiceRequest\x1a\x11.test.v1.Response\"\r»\x01\x05audit\xe8\xc1\x012B\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_check_metadata_proto_rawDescOnce sync.Once
+	file_test_v1_check_metadata_proto_rawDescData []byte
+)
+
+func file_test_v1_check_metadata_proto_rawDescGZIP() []byte {
+	file_test_v1_check_metadata_proto_rawDescOnce.Do(func() {
+		file_test_v1_check_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_check_metadata_proto_rawDesc), len(file_test_v1_check_metadata_proto_rawDesc)))
+	})
+	return file_test_v1_check_metadata_proto_rawDescData
+}
+
+var file_test_v1_check_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_v1_check_metadata_proto_goTypes = []any{
+	(*Invoice)(nil),                // 0: test.v1.Invoice
+	(*Consistency)(nil),            // 1: test.v1.Consistency
+	(*GetInvoiceRequest)(nil),      // 2: test.v1.GetInvoiceRequest
+	(*ApproveInvoiceRequest)(nil),  // 3: test.v1.ApproveInvoiceRequest
+	(*AuditInvoiceRequest)(nil),    // 4: test.v1.AuditInvoiceRequest
+	(*wrapperspb.StringValue)(nil), // 5: google.protobuf.StringValue
+	(*Response)(nil),               // 6: test.v1.Response
+}
+var file_test_v1_check_metadata_proto_depIdxs = []int32{
+	5, // 0: test.v1.Consistency.snap_token:type_name -> google.protobuf.StringValue
+	0, // 1: test.v1.GetInvoiceRequest.invoice:type_name -> test.v1.Invoice
+	0, // 2: test.v1.ApproveInvoiceRequest.invoice:type_name -> test.v1.Invoice
+	1, // 3: test.v1.ApproveInvoiceRequest.consistency:type_name -> test.v1.Consistency
+	0, // 4: test.v1.AuditInvoiceRequest.invoice:type_name -> test.v1.Invoice
+	2, // 5: test.v1.Invoices.GetInvoice:input_type -> test.v1.GetInvoiceRequest
+	3, // 6: test.v1.Invoices.ApproveInvoice:input_type -> test.v1.ApproveInvoiceRequest
+	4, // 7: test.v1.Invoices.AuditInvoice:input_type -> test.v1.AuditInvoiceRequest
+	6, // 8: test.v1.Invoices.GetInvoice:output_type -> test.v1.Response
+	6, // 9: test.v1.Invoices.ApproveInvoice:output_type -> test.v1.Response
+	6, // 10: test.v1.Invoices.AuditInvoice:output_type -> test.v1.Response
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_check_metadata_proto_init() }
+func file_test_v1_check_metadata_proto_init() {
+	if File_test_v1_check_metadata_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_check_metadata_proto_rawDesc), len(file_test_v1_check_metadata_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_check_metadata_proto_goTypes,
+		DependencyIndexes: file_test_v1_check_metadata_proto_depIdxs,
+		MessageInfos:      file_test_v1_check_metadata_proto_msgTypes,
+	}.Build()
+	File_test_v1_check_metadata_proto = out.File
+	file_test_v1_check_metadata_proto_goTypes = nil
+	file_test_v1_check_metadata_proto_depIdxs = nil
+}
@@ -0,0 +1,108 @@
+package testv1
+
+import (
+	v1 "buf.build/gen/go/permifyco/permify/protocolbuffers/go/base/v1"
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+func (req *GetInvoiceRequest) GetChecks() pkg.CheckConfig {
+	permission := "view"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Invoice
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Invoice",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetCheckMetadata returns the metadata sent to Permify with every check returned by GetChecks.
+func (req *GetInvoiceRequest) GetCheckMetadata() *v1.PermissionCheckRequestMetadata {
+	var snapToken string
+	if req.SnapToken != "" {
+		snapToken = req.SnapToken
+	}
+	return v1.PermissionCheckRequestMetadata_builder{
+		SnapToken: snapToken,
+	}.Build()
+}
+
+func (req *ApproveInvoiceRequest) GetChecks() pkg.CheckConfig {
+	permission := "approve"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Invoice
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Invoice",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetCheckMetadata returns the metadata sent to Permify with every check returned by GetChecks.
+func (req *ApproveInvoiceRequest) GetCheckMetadata() *v1.PermissionCheckRequestMetadata {
+	var snapToken string
+	if req.Consistency != nil && req.Consistency.SnapToken != nil {
+		snapToken = req.Consistency.SnapToken.Value
+	}
+	return v1.PermissionCheckRequestMetadata_builder{
+		SnapToken: snapToken,
+		Depth:     20,
+	}.Build()
+}
+
+func (req *AuditInvoiceRequest) GetChecks() pkg.CheckConfig {
+	permission := "audit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Invoice
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Invoice",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetCheckMetadata returns the metadata sent to Permify with every check returned by GetChecks.
+func (req *AuditInvoiceRequest) GetCheckMetadata() *v1.PermissionCheckRequestMetadata {
+	return v1.PermissionCheckRequestMetadata_builder{
+		Depth: 50,
+	}.Build()
+}
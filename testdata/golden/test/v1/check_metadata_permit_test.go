@@ -0,0 +1,33 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkInvoicesGetInvoiceGetChecks(b *testing.B) {
+	req := &GetInvoiceRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkInvoicesApproveInvoiceGetChecks(b *testing.B) {
+	req := &ApproveInvoiceRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkInvoicesAuditInvoiceGetChecks(b *testing.B) {
+	req := &AuditInvoiceRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
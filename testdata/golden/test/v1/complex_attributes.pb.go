@@ -8,6 +8,7 @@ package testv1
 
 import (
 	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -154,17 +155,17 @@ var File_test_v1_complex_attributes_proto protoreflect.FileDescriptor
 
 const file_test_v1_complex_attributes_proto_rawDesc = "" +
 	"\n" +
-	" test/v1/complex_attributes.proto\x12\atest.v1\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"c\n" +
+	" test/v1/complex_attributes.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"c\n" +
 	"\rAttributeData\x12(\n" +
 	"\bcategory\x18\x01 \x01(\tB\fһ\x01\bcategoryR\bcategory\x12(\n" +
-	"\bpriority\x18\x02 \x01(\x05B\fһ\x01\bpriorityR\bpriority\"\xbb\x02\n" +
+	"\bpriority\x18\x02 \x01(\x05B\fһ\x01\bpriorityR\bpriority\"\xcb\x02\n" +
 	"\x0fComplexResource\x12\x14\n" +
 	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
 	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x126\n" +
 	"\n" +
 	"attributes\x18\x03 \x03(\v2\x16.test.v1.AttributeDataR\n" +
-	"attributes\x12@\n" +
-	"\x04tags\x18\x04 \x03(\v2\".test.v1.ComplexResource.TagsEntryB\bһ\x01\x04tagsR\x04tags\x12.\n" +
+	"attributes\x12P\n" +
+	"\x04tags\x18\x04 \x03(\v2\".test.v1.ComplexResource.TagsEntryB\x18һ\x01\x04tags\xfa\xc1\x01\ftagAttributeR\x04tags\x12.\n" +
 	"\n" +
 	"department\x18\x05 \x01(\tB\x0eһ\x01\n" +
 	"departmentR\n" +
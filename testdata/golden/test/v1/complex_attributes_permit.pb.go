@@ -2,11 +2,13 @@ package testv1
 
 import (
 	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	maps "maps"
+	slices "slices"
 )
 
 func (req *ComplexResource) GetChecks() pkg.CheckConfig {
 	permission := "process"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
@@ -16,8 +18,8 @@ func (req *ComplexResource) GetChecks() pkg.CheckConfig {
 	if resource.TenantId != "" {
 		tenantId = resource.TenantId
 	}
-	attributes := make(map[string]any)
-	var categoryValues []any
+	attributes := make(map[string]any, 4)
+	categoryValues := make([]string, 0, len(resource.Attributes))
 	for _, v1 := range resource.Attributes {
 		categoryValues = append(categoryValues, v1.Category)
 	}
@@ -25,14 +27,21 @@ func (req *ComplexResource) GetChecks() pkg.CheckConfig {
 		attributes["category"] = categoryValues
 	}
 	attributes["department"] = resource.Department
-	var priorityValues []any
+	priorityValues := make([]int32, 0, len(resource.Attributes))
 	for _, v2 := range resource.Attributes {
 		priorityValues = append(priorityValues, v2.Priority)
 	}
 	if len(priorityValues) > 0 {
 		attributes["priority"] = priorityValues
 	}
-	attributes["tags"] = resource.Tags
+	tagsValues := make([]any, 0, len(resource.Tags))
+	for _, v3 := range slices.Sorted(maps.Keys(resource.Tags)) {
+		v4 := resource.Tags[v3]
+		tagsValues = append(tagsValues, tagAttribute(v3, v4))
+	}
+	if len(tagsValues) > 0 {
+		attributes["tags"] = tagsValues
+	}
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
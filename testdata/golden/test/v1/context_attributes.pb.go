@@ -0,0 +1,345 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/context_attributes.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LedgerAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerAccount) Reset() {
+	*x = LedgerAccount{}
+	mi := &file_test_v1_context_attributes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerAccount) ProtoMessage() {}
+
+func (x *LedgerAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_attributes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerAccount.ProtoReflect.Descriptor instead.
+func (*LedgerAccount) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_attributes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LedgerAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ClientInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpAddress     string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     *string                `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
+	mi := &file_test_v1_context_attributes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfo) ProtoMessage() {}
+
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_attributes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_attributes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClientInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+type TransferLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLine) Reset() {
+	*x = TransferLine{}
+	mi := &file_test_v1_context_attributes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLine) ProtoMessage() {}
+
+func (x *TransferLine) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_attributes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLine.ProtoReflect.Descriptor instead.
+func (*TransferLine) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_attributes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *LedgerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Client        *ClientInfo            `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Lines         []*TransferLine        `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_test_v1_context_attributes_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_attributes_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_attributes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransferRequest) GetAccount() *LedgerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *TransferRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetClient() *ClientInfo {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *TransferRequest) GetLines() []*TransferLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_test_v1_context_attributes_proto protoreflect.FileDescriptor
+
+const file_test_v1_context_attributes_proto_rawDesc = "" +
+	"\n" +
+	" test/v1/context_attributes.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"\x85\x01\n" +
+	"\rLedgerAccount\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x12(\n" +
+	"\bcurrency\x18\x03 \x01(\tB\fһ\x01\bcurrencyR\bcurrency:\x11»\x01\rLedgerAccount\"~\n" +
+	"\n" +
+	"ClientInfo\x12-\n" +
+	"\n" +
+	"ip_address\x18\x01 \x01(\tB\x0e\x92\xc2\x01\n" +
+	"ip_addressR\tipAddress\x122\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tB\x0e\x92\xc2\x01\n" +
+	"user_agentH\x00R\tuserAgent\x88\x01\x01B\r\n" +
+	"\v_user_agent\"<\n" +
+	"\fTransferLine\x12,\n" +
+	"\x06amount\x18\x01 \x01(\x03B\x14\xf0\xc1\x01\x04\x92\xc2\x01\fline_amountsR\x06amount\"\xe9\x01\n" +
+	"\x0fTransferRequest\x120\n" +
+	"\aaccount\x18\x01 \x01(\v2\x16.test.v1.LedgerAccountR\aaccount\x12\"\n" +
+	"\x06amount\x18\x02 \x01(\x01B\n" +
+	"\x92\xc2\x01\x06amountR\x06amount\x12&\n" +
+	"\x06reason\x18\x03 \x01(\tB\x0e\x80\xc2\x01\x02\x92\xc2\x01\x06reasonR\x06reason\x12+\n" +
+	"\x06client\x18\x04 \x01(\v2\x13.test.v1.ClientInfoR\x06client\x12+\n" +
+	"\x05lines\x18\x05 \x03(\v2\x15.test.v1.TransferLineR\x05lines2R\n" +
+	"\tTransfers\x12E\n" +
+	"\bTransfer\x12\x18.test.v1.TransferRequest\x1a\x11.test.v1.Response\"\f»\x01\btransferB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_context_attributes_proto_rawDescOnce sync.Once
+	file_test_v1_context_attributes_proto_rawDescData []byte
+)
+
+func file_test_v1_context_attributes_proto_rawDescGZIP() []byte {
+	file_test_v1_context_attributes_proto_rawDescOnce.Do(func() {
+		file_test_v1_context_attributes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_context_attributes_proto_rawDesc), len(file_test_v1_context_attributes_proto_rawDesc)))
+	})
+	return file_test_v1_context_attributes_proto_rawDescData
+}
+
+var file_test_v1_context_attributes_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_test_v1_context_attributes_proto_goTypes = []any{
+	(*LedgerAccount)(nil),   // 0: test.v1.LedgerAccount
+	(*ClientInfo)(nil),      // 1: test.v1.ClientInfo
+	(*TransferLine)(nil),    // 2: test.v1.TransferLine
+	(*TransferRequest)(nil), // 3: test.v1.TransferRequest
+	(*Response)(nil),        // 4: test.v1.Response
+}
+var file_test_v1_context_attributes_proto_depIdxs = []int32{
+	0, // 0: test.v1.TransferRequest.account:type_name -> test.v1.LedgerAccount
+	1, // 1: test.v1.TransferRequest.client:type_name -> test.v1.ClientInfo
+	2, // 2: test.v1.TransferRequest.lines:type_name -> test.v1.TransferLine
+	3, // 3: test.v1.Transfers.Transfer:input_type -> test.v1.TransferRequest
+	4, // 4: test.v1.Transfers.Transfer:output_type -> test.v1.Response
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_context_attributes_proto_init() }
+func file_test_v1_context_attributes_proto_init() {
+	if File_test_v1_context_attributes_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_context_attributes_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_context_attributes_proto_rawDesc), len(file_test_v1_context_attributes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_context_attributes_proto_goTypes,
+		DependencyIndexes: file_test_v1_context_attributes_proto_depIdxs,
+		MessageInfos:      file_test_v1_context_attributes_proto_msgTypes,
+	}.Build()
+	File_test_v1_context_attributes_proto = out.File
+	file_test_v1_context_attributes_proto_goTypes = nil
+	file_test_v1_context_attributes_proto_depIdxs = nil
+}
@@ -0,0 +1,58 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+func (req *TransferRequest) GetChecks() pkg.CheckConfig {
+	permission := "transfer"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Account
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	if resource.TenantId != "" {
+		tenantId = resource.TenantId
+	}
+	attributes := make(map[string]any, 1)
+	attributes["currency"] = resource.Currency
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type:       "LedgerAccount",
+			ID:         id,
+			Attributes: attributes,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetCheckContext returns the data sent to Permify as the context of every check returned by GetChecks.
+func (req *TransferRequest) GetCheckContext() map[string]any {
+	checkContext := make(map[string]any, 5)
+	checkContext["amount"] = req.Amount
+	if req.Client != nil {
+		checkContext["ip_address"] = req.Client.IpAddress
+	}
+	line_amountsValues := make([]float64, 0, len(req.Lines))
+	for _, v1 := range req.Lines {
+		line_amountsValues = append(line_amountsValues, float64(v1.Amount))
+	}
+	if len(line_amountsValues) > 0 {
+		checkContext["line_amounts"] = line_amountsValues
+	}
+	if v2 := req.Reason; v2 != "" {
+		checkContext["reason"] = v2
+	}
+	if req.Client != nil && req.Client.UserAgent != nil {
+		checkContext["user_agent"] = *req.Client.UserAgent
+	}
+	return checkContext
+}
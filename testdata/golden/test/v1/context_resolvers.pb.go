@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/context_resolvers.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Space struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Space) Reset() {
+	*x = Space{}
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Space) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Space) ProtoMessage() {}
+
+func (x *Space) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Space.ProtoReflect.Descriptor instead.
+func (*Space) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_resolvers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Space) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Space) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetSpaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Space         *Space                 `protobuf:"bytes,1,opt,name=space,proto3" json:"space,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpaceRequest) Reset() {
+	*x = GetSpaceRequest{}
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpaceRequest) ProtoMessage() {}
+
+func (x *GetSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpaceRequest.ProtoReflect.Descriptor instead.
+func (*GetSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_resolvers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSpaceRequest) GetSpace() *Space {
+	if x != nil {
+		return x.Space
+	}
+	return nil
+}
+
+type Dashboard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dashboard) Reset() {
+	*x = Dashboard{}
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dashboard) ProtoMessage() {}
+
+func (x *Dashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dashboard.ProtoReflect.Descriptor instead.
+func (*Dashboard) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_resolvers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Dashboard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Dashboard) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type RenameDashboardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dashboards    []*Dashboard           `protobuf:"bytes,1,rep,name=dashboards,proto3" json:"dashboards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameDashboardsRequest) Reset() {
+	*x = RenameDashboardsRequest{}
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameDashboardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameDashboardsRequest) ProtoMessage() {}
+
+func (x *RenameDashboardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameDashboardsRequest.ProtoReflect.Descriptor instead.
+func (*RenameDashboardsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_resolvers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RenameDashboardsRequest) GetDashboards() []*Dashboard {
+	if x != nil {
+		return x.Dashboards
+	}
+	return nil
+}
+
+type ArchiveSpaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Space         *Space                 `protobuf:"bytes,1,opt,name=space,proto3,oneof" json:"space,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveSpaceRequest) Reset() {
+	*x = ArchiveSpaceRequest{}
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveSpaceRequest) ProtoMessage() {}
+
+func (x *ArchiveSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_context_resolvers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveSpaceRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_context_resolvers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ArchiveSpaceRequest) GetSpace() *Space {
+	if x != nil {
+		return x.Space
+	}
+	return nil
+}
+
+var File_test_v1_context_resolvers_proto protoreflect.FileDescriptor
+
+const file_test_v1_context_resolvers_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftest/v1/context_resolvers.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"Z\n" +
+	"\x05Space\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\x18»\x01\x05Space\xf2\xc1\x01\v\n" +
+	"\tprincipal\"7\n" +
+	"\x0fGetSpaceRequest\x12$\n" +
+	"\x05space\x18\x01 \x01(\v2\x0e.test.v1.SpaceR\x05space\"l\n" +
+	"\tDashboard\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12\x1f\n" +
+	"\x05title\x18\x02 \x01(\tB\tһ\x01\x05titleR\x05title:(»\x01\tDashboard\xf2\xc1\x01\b\x12\x06tenant\xfa\xc1\x01\v\x12\tdashboard\"M\n" +
+	"\x17RenameDashboardsRequest\x122\n" +
+	"\n" +
+	"dashboards\x18\x01 \x03(\v2\x12.test.v1.DashboardR\n" +
+	"dashboards\"J\n" +
+	"\x13ArchiveSpaceRequest\x12)\n" +
+	"\x05space\x18\x01 \x01(\v2\x0e.test.v1.SpaceH\x00R\x05space\x88\x01\x01B\b\n" +
+	"\x06_space2\xf0\x01\n" +
+	"\x06Spaces\x12A\n" +
+	"\bGetSpace\x12\x18.test.v1.GetSpaceRequest\x1a\x11.test.v1.Response\"\b»\x01\x04view\x12U\n" +
+	"\x10RenameDashboards\x12 .test.v1.RenameDashboardsRequest\x1a\x11.test.v1.Response\"\f»\x01\x04edit\x88\xc2\x01\x19\x12L\n" +
+	"\fArchiveSpace\x12\x1c.test.v1.ArchiveSpaceRequest\x1a\x11.test.v1.Response\"\v»\x01\aarchiveB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_context_resolvers_proto_rawDescOnce sync.Once
+	file_test_v1_context_resolvers_proto_rawDescData []byte
+)
+
+func file_test_v1_context_resolvers_proto_rawDescGZIP() []byte {
+	file_test_v1_context_resolvers_proto_rawDescOnce.Do(func() {
+		file_test_v1_context_resolvers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_context_resolvers_proto_rawDesc), len(file_test_v1_context_resolvers_proto_rawDesc)))
+	})
+	return file_test_v1_context_resolvers_proto_rawDescData
+}
+
+var file_test_v1_context_resolvers_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_v1_context_resolvers_proto_goTypes = []any{
+	(*Space)(nil),                   // 0: test.v1.Space
+	(*GetSpaceRequest)(nil),         // 1: test.v1.GetSpaceRequest
+	(*Dashboard)(nil),               // 2: test.v1.Dashboard
+	(*RenameDashboardsRequest)(nil), // 3: test.v1.RenameDashboardsRequest
+	(*ArchiveSpaceRequest)(nil),     // 4: test.v1.ArchiveSpaceRequest
+	(*Response)(nil),                // 5: test.v1.Response
+}
+var file_test_v1_context_resolvers_proto_depIdxs = []int32{
+	0, // 0: test.v1.GetSpaceRequest.space:type_name -> test.v1.Space
+	2, // 1: test.v1.RenameDashboardsRequest.dashboards:type_name -> test.v1.Dashboard
+	0, // 2: test.v1.ArchiveSpaceRequest.space:type_name -> test.v1.Space
+	1, // 3: test.v1.Spaces.GetSpace:input_type -> test.v1.GetSpaceRequest
+	3, // 4: test.v1.Spaces.RenameDashboards:input_type -> test.v1.RenameDashboardsRequest
+	4, // 5: test.v1.Spaces.ArchiveSpace:input_type -> test.v1.ArchiveSpaceRequest
+	5, // 6: test.v1.Spaces.GetSpace:output_type -> test.v1.Response
+	5, // 7: test.v1.Spaces.RenameDashboards:output_type -> test.v1.Response
+	5, // 8: test.v1.Spaces.ArchiveSpace:output_type -> test.v1.Response
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_context_resolvers_proto_init() }
+func file_test_v1_context_resolvers_proto_init() {
+	if File_test_v1_context_resolvers_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_context_resolvers_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_context_resolvers_proto_rawDesc), len(file_test_v1_context_resolvers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_context_resolvers_proto_goTypes,
+		DependencyIndexes: file_test_v1_context_resolvers_proto_depIdxs,
+		MessageInfos:      file_test_v1_context_resolvers_proto_msgTypes,
+	}.Build()
+	File_test_v1_context_resolvers_proto = out.File
+	file_test_v1_context_resolvers_proto_goTypes = nil
+	file_test_v1_context_resolvers_proto_depIdxs = nil
+}
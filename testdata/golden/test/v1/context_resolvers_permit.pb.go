@@ -0,0 +1,240 @@
+package testv1
+
+import (
+	context "context"
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	resolver "github.com/nrf110/protoc-gen-connectrpc-permify/resolver"
+)
+
+// GetChecks returns the checks of GetChecksContext with a background context, so resolvers relying on a
+// request's context find nothing, and the request is denied unless it carries what they would resolve.
+func (req *GetSpaceRequest) GetChecks() pkg.CheckConfig {
+	return req.GetChecksContext(context.Background())
+}
+
+// GetChecksContext returns the checks of the request, resolving tenants and ids it doesn't carry from ctx.
+// A field's value takes precedence over its resolver. The request is denied when neither provides one.
+// The tenant of Space comes from field test.v1.Space.tenant_id, then resolver "principal", then denied.
+func (req *GetSpaceRequest) GetChecksContext(ctx context.Context) pkg.CheckConfig {
+	permission := "view"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Space
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	var tenantId string
+	if resource.TenantId != "" {
+		tenantId = resource.TenantId
+	}
+	if tenantId == "" {
+		tenantId = resolver.Resolve(ctx, "principal")
+	}
+	if tenantId == "" {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field space.tenant_id is not set and resolver \"principal\" returned no value",
+						},
+					},
+				},
+			},
+		}
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Space",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks returns the checks of GetChecksContext with a background context, so resolvers relying on a
+// request's context find nothing, and the request is denied unless it carries what they would resolve.
+func (req *RenameDashboardsRequest) GetChecks() pkg.CheckConfig {
+	return req.GetChecksContext(context.Background())
+}
+
+// GetChecksContext returns the checks of the request, resolving tenants and ids it doesn't carry from ctx.
+// A field's value takes precedence over its resolver. The request is denied when neither provides one.
+// The tenant of Dashboard comes from context key "tenant", then denied.
+// The id of Dashboard comes from field test.v1.Dashboard.id, then context key "dashboard", then denied.
+// It denies requests that would produce more than 25 checks.
+func (req *RenameDashboardsRequest) GetChecksContext(ctx context.Context) pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, min(len(req.Dashboards), 25))
+	if len(checks)+len(req.Dashboards) > 25 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 25 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	for _, v1 := range req.Dashboards {
+		resource := v1
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		if id == "" {
+			id = resolver.Value(ctx, "dashboard")
+		}
+		if id == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "field dashboards.id is not set and context key \"dashboard\" returned no value",
+							},
+						},
+					},
+				},
+			}
+		}
+		tenantId := resolver.Value(ctx, "tenant")
+		if tenantId == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "context key \"tenant\" returned no value",
+							},
+						},
+					},
+				},
+			}
+		}
+		attributes := make(map[string]any, 1)
+		attributes["title"] = resource.Title
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type:       "Dashboard",
+				ID:         id,
+				Attributes: attributes,
+			},
+		}
+		checks = append(checks, check)
+	}
+	if len(checks) > 25 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 25 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks returns the checks of GetChecksContext with a background context, so resolvers relying on a
+// request's context find nothing, and the request is denied unless it carries what they would resolve.
+func (req *ArchiveSpaceRequest) GetChecks() pkg.CheckConfig {
+	return req.GetChecksContext(context.Background())
+}
+
+// GetChecksContext returns the checks of the request, resolving tenants and ids it doesn't carry from ctx.
+// A field's value takes precedence over its resolver. The request is denied when neither provides one.
+// The tenant of Space comes from field test.v1.Space.tenant_id, then resolver "principal", then denied.
+func (req *ArchiveSpaceRequest) GetChecksContext(ctx context.Context) pkg.CheckConfig {
+	permission := "archive"
+	checks := make([]pkg.Check, 0, 1)
+	if req.Space != nil {
+		resource := req.Space
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		var tenantId string
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		if tenantId == "" {
+			tenantId = resolver.Resolve(ctx, "principal")
+		}
+		if tenantId == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "field space.tenant_id is not set and resolver \"principal\" returned no value",
+							},
+						},
+					},
+				},
+			}
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Space",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	} else {
+		var id string
+		tenantId := resolver.Resolve(ctx, "principal")
+		if tenantId == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "resolver \"principal\" returned no value",
+							},
+						},
+					},
+				},
+			}
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Space",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
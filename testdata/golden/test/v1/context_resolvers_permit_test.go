@@ -0,0 +1,33 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkSpacesGetSpaceGetChecks(b *testing.B) {
+	req := &GetSpaceRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkSpacesRenameDashboardsGetChecks(b *testing.B) {
+	req := &RenameDashboardsRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkSpacesArchiveSpaceGetChecks(b *testing.B) {
+	req := &ArchiveSpaceRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
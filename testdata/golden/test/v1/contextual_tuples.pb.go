@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/contextual_tuples.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Draft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	TeamId        int64                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Draft) Reset() {
+	*x = Draft{}
+	mi := &file_test_v1_contextual_tuples_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Draft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draft) ProtoMessage() {}
+
+func (x *Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_contextual_tuples_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
+	return file_test_v1_contextual_tuples_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Draft) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Draft) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *Draft) GetTeamId() int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type CreateDraftRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Draft         *Draft                  `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	ReviewerId    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDraftRequest) Reset() {
+	*x = CreateDraftRequest{}
+	mi := &file_test_v1_contextual_tuples_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDraftRequest) ProtoMessage() {}
+
+func (x *CreateDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_contextual_tuples_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDraftRequest.ProtoReflect.Descriptor instead.
+func (*CreateDraftRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_contextual_tuples_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDraftRequest) GetDraft() *Draft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *CreateDraftRequest) GetReviewerId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return nil
+}
+
+var File_test_v1_contextual_tuples_proto protoreflect.FileDescriptor
+
+const file_test_v1_contextual_tuples_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftest/v1/contextual_tuples.proto\x12\atest.v1\x1a\x1egoogle/protobuf/wrappers.proto\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"a\n" +
+	"\x05Draft\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\x03R\x06teamId:\f»\x01\bDocument\"y\n" +
+	"\x12CreateDraftRequest\x12$\n" +
+	"\x05draft\x18\x01 \x01(\v2\x0e.test.v1.DraftR\x05draft\x12=\n" +
+	"\vreviewer_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"reviewerId2\x80\x02\n" +
+	"\x06Drafts\x12\xf5\x01\n" +
+	"\vCreateDraft\x12\x1b.test.v1.CreateDraftRequest\x1a\x11.test.v1.Response\"\xb5\x01»\x01\x06create\xe2\xc1\x015\n" +
+	"\bdocument\x12\bdraft.id\x1a\x06parent\"\x06folder*\x0fdraft.folder_id\xe2\xc1\x019\n" +
+	"\bdocument\x12\bdraft.id\x1a\x06editor\"\x04team*\rdraft.team_id2\x06member\xe2\xc1\x011\n" +
+	"\bdocument\x12\bdraft.id\x1a\breviewer\"\x04user*\vreviewer_idB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_contextual_tuples_proto_rawDescOnce sync.Once
+	file_test_v1_contextual_tuples_proto_rawDescData []byte
+)
+
+func file_test_v1_contextual_tuples_proto_rawDescGZIP() []byte {
+	file_test_v1_contextual_tuples_proto_rawDescOnce.Do(func() {
+		file_test_v1_contextual_tuples_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_contextual_tuples_proto_rawDesc), len(file_test_v1_contextual_tuples_proto_rawDesc)))
+	})
+	return file_test_v1_contextual_tuples_proto_rawDescData
+}
+
+var file_test_v1_contextual_tuples_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_test_v1_contextual_tuples_proto_goTypes = []any{
+	(*Draft)(nil),                  // 0: test.v1.Draft
+	(*CreateDraftRequest)(nil),     // 1: test.v1.CreateDraftRequest
+	(*wrapperspb.StringValue)(nil), // 2: google.protobuf.StringValue
+	(*Response)(nil),               // 3: test.v1.Response
+}
+var file_test_v1_contextual_tuples_proto_depIdxs = []int32{
+	0, // 0: test.v1.CreateDraftRequest.draft:type_name -> test.v1.Draft
+	2, // 1: test.v1.CreateDraftRequest.reviewer_id:type_name -> google.protobuf.StringValue
+	1, // 2: test.v1.Drafts.CreateDraft:input_type -> test.v1.CreateDraftRequest
+	3, // 3: test.v1.Drafts.CreateDraft:output_type -> test.v1.Response
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_contextual_tuples_proto_init() }
+func file_test_v1_contextual_tuples_proto_init() {
+	if File_test_v1_contextual_tuples_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_contextual_tuples_proto_rawDesc), len(file_test_v1_contextual_tuples_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_contextual_tuples_proto_goTypes,
+		DependencyIndexes: file_test_v1_contextual_tuples_proto_depIdxs,
+		MessageInfos:      file_test_v1_contextual_tuples_proto_msgTypes,
+	}.Build()
+	File_test_v1_contextual_tuples_proto = out.File
+	file_test_v1_contextual_tuples_proto_goTypes = nil
+	file_test_v1_contextual_tuples_proto_depIdxs = nil
+}
@@ -0,0 +1,77 @@
+package testv1
+
+import (
+	v1 "buf.build/gen/go/permifyco/permify/protocolbuffers/go/base/v1"
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	strconv "strconv"
+)
+
+func (req *CreateDraftRequest) GetChecks() pkg.CheckConfig {
+	permission := "create"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Draft
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Document",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetContextualTuples returns the relationships sent to Permify with every check returned by GetChecks.
+func (req *CreateDraftRequest) GetContextualTuples() []*v1.Tuple {
+	var tuples []*v1.Tuple
+	if req.Draft != nil && req.Draft.Id != "" && req.Draft.FolderId != "" {
+		tuples = append(tuples, v1.Tuple_builder{
+			Entity: v1.Entity_builder{
+				Type: "document",
+				Id:   req.Draft.Id,
+			}.Build(),
+			Relation: "parent",
+			Subject: v1.Subject_builder{
+				Type: "folder",
+				Id:   req.Draft.FolderId,
+			}.Build(),
+		}.Build())
+	}
+	if req.Draft != nil && req.Draft.Id != "" && req.Draft.TeamId != 0 {
+		tuples = append(tuples, v1.Tuple_builder{
+			Entity: v1.Entity_builder{
+				Type: "document",
+				Id:   req.Draft.Id,
+			}.Build(),
+			Relation: "editor",
+			Subject: v1.Subject_builder{
+				Type:     "team",
+				Id:       strconv.FormatInt(req.Draft.TeamId, 10),
+				Relation: "member",
+			}.Build(),
+		}.Build())
+	}
+	if req.Draft != nil && req.Draft.Id != "" && req.ReviewerId != nil {
+		tuples = append(tuples, v1.Tuple_builder{
+			Entity: v1.Entity_builder{
+				Type: "document",
+				Id:   req.Draft.Id,
+			}.Build(),
+			Relation: "reviewer",
+			Subject: v1.Subject_builder{
+				Type: "user",
+				Id:   req.ReviewerId.Value,
+			}.Build(),
+		}.Build())
+	}
+	return tuples
+}
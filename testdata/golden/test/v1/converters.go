@@ -0,0 +1,26 @@
+package testv1
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// The attribute_converters named by the fixtures. They're written by hand, so that the generated package builds, and
+// are kept by the golden target of the Makefile.
+
+func complexAttributeValue(attribute *ComplexAttribute) any {
+	return attribute.GetBlar()
+}
+
+func tagAttribute(key string, value string) any {
+	return key + "=" + value
+}
+
+func quotaAttribute(name string, quota int32) any {
+	return fmt.Sprintf("%s=%d", name, quota)
+}
+
+func limitAttribute(name string, limit *durationpb.Duration) any {
+	return name + "=" + limit.AsDuration().String()
+}
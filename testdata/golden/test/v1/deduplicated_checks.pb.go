@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/deduplicated_checks.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,3,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_test_v1_deduplicated_checks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Item) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *Item) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type MoveItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveItemsRequest) Reset() {
+	*x = MoveItemsRequest{}
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemsRequest) ProtoMessage() {}
+
+func (x *MoveItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemsRequest.ProtoReflect.Descriptor instead.
+func (*MoveItemsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_deduplicated_checks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MoveItemsRequest) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_test_v1_deduplicated_checks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tag) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Tag) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+// A group of tags that isn't a resource itself, with lists of attribute values and context data alongside them.
+type TagGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Colors        []string               `protobuf:"bytes,2,rep,name=colors,proto3" json:"colors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagGroup) Reset() {
+	*x = TagGroup{}
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagGroup) ProtoMessage() {}
+
+func (x *TagGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagGroup.ProtoReflect.Descriptor instead.
+func (*TagGroup) Descriptor() ([]byte, []int) {
+	return file_test_v1_deduplicated_checks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TagGroup) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TagGroup) GetColors() []string {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+type ApplyTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*TagGroup            `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	GroupsByName  map[string]*TagGroup   `protobuf:"bytes,2,rep,name=groups_by_name,json=groupsByName,proto3" json:"groups_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Reasons       []string               `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTagsRequest) Reset() {
+	*x = ApplyTagsRequest{}
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTagsRequest) ProtoMessage() {}
+
+func (x *ApplyTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_deduplicated_checks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTagsRequest.ProtoReflect.Descriptor instead.
+func (*ApplyTagsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_deduplicated_checks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApplyTagsRequest) GetGroups() []*TagGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ApplyTagsRequest) GetGroupsByName() map[string]*TagGroup {
+	if x != nil {
+		return x.GroupsByName
+	}
+	return nil
+}
+
+func (x *ApplyTagsRequest) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+var File_test_v1_deduplicated_checks_proto protoreflect.FileDescriptor
+
+const file_test_v1_deduplicated_checks_proto_rawDesc = "" +
+	"\n" +
+	"!test/v1/deduplicated_checks.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"\xa6\x01\n" +
+	"\x04Item\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x12\x1b\n" +
+	"\tfolder_id\x18\x03 \x01(\tR\bfolderId\x12\x1f\n" +
+	"\x05label\x18\x04 \x01(\tB\tһ\x01\x05labelR\x05label:'»\x01\x04Item\xe2\xc1\x01\x1b\n" +
+	"\x06Folder\x12\tfolder_id\x1a\x04view \x01\"7\n" +
+	"\x10MoveItemsRequest\x12#\n" +
+	"\x05items\x18\x01 \x03(\v2\r.test.v1.ItemR\x05items\"n\n" +
+	"\x03Tag\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x12%\n" +
+	"\aaliases\x18\x03 \x03(\tB\vһ\x01\aaliasesR\aaliases:\a»\x01\x03Tag\"P\n" +
+	"\bTagGroup\x12 \n" +
+	"\x04tags\x18\x01 \x03(\v2\f.test.v1.TagR\x04tags\x12\"\n" +
+	"\x06colors\x18\x02 \x03(\tB\n" +
+	"\x92\xc2\x01\x06colorsR\x06colors\"\x8b\x02\n" +
+	"\x10ApplyTagsRequest\x12)\n" +
+	"\x06groups\x18\x01 \x03(\v2\x11.test.v1.TagGroupR\x06groups\x12Q\n" +
+	"\x0egroups_by_name\x18\x02 \x03(\v2+.test.v1.ApplyTagsRequest.GroupsByNameEntryR\fgroupsByName\x12%\n" +
+	"\areasons\x18\x03 \x03(\tB\v\x92\xc2\x01\areasonsR\areasons\x1aR\n" +
+	"\x11GroupsByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.test.v1.TagGroupR\x05value:\x028\x012\x96\x01\n" +
+	"\x05Items\x12G\n" +
+	"\tMoveItems\x12\x19.test.v1.MoveItemsRequest\x1a\x11.test.v1.Response\"\f»\x01\x04move\x88\xc2\x01d\x12D\n" +
+	"\tApplyTags\x12\x19.test.v1.ApplyTagsRequest\x1a\x11.test.v1.Response\"\t»\x01\x05applyB\x14\xe8\xc1\x01\x01Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_deduplicated_checks_proto_rawDescOnce sync.Once
+	file_test_v1_deduplicated_checks_proto_rawDescData []byte
+)
+
+func file_test_v1_deduplicated_checks_proto_rawDescGZIP() []byte {
+	file_test_v1_deduplicated_checks_proto_rawDescOnce.Do(func() {
+		file_test_v1_deduplicated_checks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_deduplicated_checks_proto_rawDesc), len(file_test_v1_deduplicated_checks_proto_rawDesc)))
+	})
+	return file_test_v1_deduplicated_checks_proto_rawDescData
+}
+
+var file_test_v1_deduplicated_checks_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_test_v1_deduplicated_checks_proto_goTypes = []any{
+	(*Item)(nil),             // 0: test.v1.Item
+	(*MoveItemsRequest)(nil), // 1: test.v1.MoveItemsRequest
+	(*Tag)(nil),              // 2: test.v1.Tag
+	(*TagGroup)(nil),         // 3: test.v1.TagGroup
+	(*ApplyTagsRequest)(nil), // 4: test.v1.ApplyTagsRequest
+	nil,                      // 5: test.v1.ApplyTagsRequest.GroupsByNameEntry
+	(*Response)(nil),         // 6: test.v1.Response
+}
+var file_test_v1_deduplicated_checks_proto_depIdxs = []int32{
+	0, // 0: test.v1.MoveItemsRequest.items:type_name -> test.v1.Item
+	2, // 1: test.v1.TagGroup.tags:type_name -> test.v1.Tag
+	3, // 2: test.v1.ApplyTagsRequest.groups:type_name -> test.v1.TagGroup
+	5, // 3: test.v1.ApplyTagsRequest.groups_by_name:type_name -> test.v1.ApplyTagsRequest.GroupsByNameEntry
+	3, // 4: test.v1.ApplyTagsRequest.GroupsByNameEntry.value:type_name -> test.v1.TagGroup
+	1, // 5: test.v1.Items.MoveItems:input_type -> test.v1.MoveItemsRequest
+	4, // 6: test.v1.Items.ApplyTags:input_type -> test.v1.ApplyTagsRequest
+	6, // 7: test.v1.Items.MoveItems:output_type -> test.v1.Response
+	6, // 8: test.v1.Items.ApplyTags:output_type -> test.v1.Response
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_deduplicated_checks_proto_init() }
+func file_test_v1_deduplicated_checks_proto_init() {
+	if File_test_v1_deduplicated_checks_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_deduplicated_checks_proto_rawDesc), len(file_test_v1_deduplicated_checks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_deduplicated_checks_proto_goTypes,
+		DependencyIndexes: file_test_v1_deduplicated_checks_proto_depIdxs,
+		MessageInfos:      file_test_v1_deduplicated_checks_proto_msgTypes,
+	}.Build()
+	File_test_v1_deduplicated_checks_proto = out.File
+	file_test_v1_deduplicated_checks_proto_goTypes = nil
+	file_test_v1_deduplicated_checks_proto_depIdxs = nil
+}
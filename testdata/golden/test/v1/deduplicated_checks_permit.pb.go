@@ -0,0 +1,162 @@
+package testv1
+
+import (
+	fmt "fmt"
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	maps "maps"
+	slices "slices"
+)
+
+// GetChecks denies requests that would produce more than 100 checks.
+func (req *MoveItemsRequest) GetChecks() pkg.CheckConfig {
+	permission := "move"
+	checks := make([]pkg.Check, 0, min(len(req.Items)*2, 100))
+	if len(checks)+len(req.Items) > 100 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 100 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	for _, v1 := range req.Items {
+		resource := v1
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		attributes := make(map[string]any, 1)
+		attributes["label"] = resource.Label
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type:       "Item",
+				ID:         id,
+				Attributes: attributes,
+			},
+		}
+		checks = append(checks, check)
+		var parentId string
+		if resource.FolderId != "" {
+			parentId = resource.FolderId
+		}
+		checks = append(checks, pkg.Check{
+			TenantID:   tenantId,
+			Permission: "view",
+			Entity: &pkg.Resource{
+				Type: "Folder",
+				ID:   parentId,
+			},
+		})
+	}
+	seen := make(map[[5]string]struct{}, len(checks))
+	unique := checks[:0]
+	for _, check := range checks {
+		key := [5]string{check.TenantID, check.Entity.Type, check.Entity.ID, check.Permission}
+		if len(check.Entity.Attributes) > 0 {
+			key[4] = fmt.Sprintf("%#v", check.Entity.Attributes)
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, check)
+	}
+	checks = unique
+	if len(checks) > 100 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has more than 100 checks",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *ApplyTagsRequest) GetChecks() pkg.CheckConfig {
+	permission := "apply"
+	checks := make([]pkg.Check, 0, len(req.Groups))
+	for _, v2 := range req.Groups {
+		for _, v3 := range v2.Tags {
+			resource := v3
+			var id string
+			if resource.Id != "" {
+				id = resource.Id
+			}
+			tenantId := "default"
+			if resource.TenantId != "" {
+				tenantId = resource.TenantId
+			}
+			attributes := make(map[string]any, 1)
+			if len(resource.Aliases) > 0 {
+				attributes["aliases"] = resource.Aliases
+			}
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type:       "Tag",
+					ID:         id,
+					Attributes: attributes,
+				},
+			}
+			checks = append(checks, check)
+		}
+	}
+	seen := make(map[[5]string]struct{}, len(checks))
+	unique := checks[:0]
+	for _, check := range checks {
+		key := [5]string{check.TenantID, check.Entity.Type, check.Entity.ID, check.Permission}
+		if len(check.Entity.Attributes) > 0 {
+			key[4] = fmt.Sprintf("%#v", check.Entity.Attributes)
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, check)
+	}
+	checks = unique
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetCheckContext returns the data sent to Permify as the context of every check returned by GetChecks.
+func (req *ApplyTagsRequest) GetCheckContext() map[string]any {
+	checkContext := make(map[string]any, 2)
+	colorsValues := make([]string, 0, len(req.GroupsByName))
+	for _, v5 := range slices.Sorted(maps.Keys(req.GroupsByName)) {
+		v4 := req.GroupsByName[v5]
+		colorsValues = append(colorsValues, v4.Colors...)
+	}
+	if len(colorsValues) > 0 {
+		checkContext["colors"] = colorsValues
+	}
+	if len(req.Reasons) > 0 {
+		checkContext["reasons"] = req.Reasons
+	}
+	return checkContext
+}
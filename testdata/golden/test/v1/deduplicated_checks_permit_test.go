@@ -0,0 +1,45 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	reflect "reflect"
+	testing "testing"
+)
+
+func BenchmarkItemsMoveItemsGetChecks(b *testing.B) {
+	req := &MoveItemsRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func TestItemsMoveItemsDeduplicatesChecks(t *testing.T) {
+	req := &MoveItemsRequest{}
+	permifytest.Populate(req)
+	checks := req.GetChecks().Checks
+	permifytest.Repeat(req)
+	if repeated := req.GetChecks().Checks; !reflect.DeepEqual(repeated, checks) {
+		t.Errorf("GetChecks() of the repeated request = %v, want %v", repeated, checks)
+	}
+}
+
+func BenchmarkItemsApplyTagsGetChecks(b *testing.B) {
+	req := &ApplyTagsRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func TestItemsApplyTagsDeduplicatesChecks(t *testing.T) {
+	req := &ApplyTagsRequest{}
+	permifytest.Populate(req)
+	checks := req.GetChecks().Checks
+	permifytest.Repeat(req)
+	if repeated := req.GetChecks().Checks; !reflect.DeepEqual(repeated, checks) {
+		t.Errorf("GetChecks() of the repeated request = %v, want %v", repeated, checks)
+	}
+}
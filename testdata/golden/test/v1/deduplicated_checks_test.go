@@ -0,0 +1,26 @@
+package testv1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestItemsMoveItemsKeepsFirstOfInterleavedDuplicates(t *testing.T) {
+	item := func(id string, folderId string) *Item {
+		return &Item{Id: id, TenantId: "acme", FolderId: folderId, Label: "draft"}
+	}
+	req := &MoveItemsRequest{
+		Items: []*Item{item("a", "inbox"), item("b", "inbox"), item("a", "inbox"), item("c", "archive")},
+	}
+
+	var checks []string
+	for _, check := range req.GetChecks().Checks {
+		checks = append(checks, check.Permission+" "+check.Entity.Type+":"+check.Entity.ID)
+	}
+
+	// The second a and its folder are dropped, and the rest keep the order of their first occurrence
+	expected := []string{"move Item:a", "view Folder:inbox", "move Item:b", "move Item:c", "view Folder:archive"}
+	if !reflect.DeepEqual(checks, expected) {
+		t.Errorf("GetChecks() = %v, want %v", checks, expected)
+	}
+}
@@ -6,14 +6,14 @@ import (
 
 func (req *DeepNestedRequest) GetChecks() pkg.CheckConfig {
 	permission := "process"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req.Container.Level2.Resource
 	var id string
 	if resource.Id != "" {
 		id = resource.Id
 	}
 	tenantId := "default"
-	attributes := make(map[string]any)
+	attributes := make(map[string]any, 1)
 	attributes["level3_data"] = resource.Data
 	check := pkg.Check{
 		TenantID:   tenantId,
@@ -33,24 +33,22 @@ func (req *DeepNestedRequest) GetChecks() pkg.CheckConfig {
 
 func (req *VeryDeepResource) GetChecks() pkg.CheckConfig {
 	permission := "admin"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
-	if resource != nil && resource.Level1 != nil && resource.Level1.Level2 != nil && resource.Level1.Level2.Level3 != nil && resource.Level1.Level2.Level3.Ids.DeepId != "" {
+	if resource != nil && resource.Level1 != nil && resource.Level1.Level2 != nil && resource.Level1.Level2.Level3 != nil && resource.Level1.Level2.Level3.Ids != nil && resource.Level1.Level2.Level3.Ids.DeepId != "" {
 		id = resource.Level1.Level2.Level3.Ids.DeepId
 	}
 	tenantId := "default"
-	if resource != nil && resource.Level1 != nil && resource.Level1.Level2 != nil && resource.Level1.Level2.Level3 != nil && resource.Level1.Level2.Level3.Ids.DeepTenant != "" {
+	if resource != nil && resource.Level1 != nil && resource.Level1.Level2 != nil && resource.Level1.Level2.Level3 != nil && resource.Level1.Level2.Level3.Ids != nil && resource.Level1.Level2.Level3.Ids.DeepTenant != "" {
 		tenantId = resource.Level1.Level2.Level3.Ids.DeepTenant
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "VeryDeep",
-			ID:         id,
-			Attributes: attributes,
+			Type: "VeryDeep",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
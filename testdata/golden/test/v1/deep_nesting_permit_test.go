@@ -0,0 +1,24 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkDeepNestingServiceProcessDeepNestedGetChecks(b *testing.B) {
+	req := &DeepNestedRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkDeepNestingServiceProcessVeryDeepGetChecks(b *testing.B) {
+	req := &VeryDeepResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,24 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkAllPublicServicePublicMethod1GetChecks(b *testing.B) {
+	req := &EmptyRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkAllPublicServicePublicMethod2GetChecks(b *testing.B) {
+	req := &MinimalResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
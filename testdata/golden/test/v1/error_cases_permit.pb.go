@@ -6,17 +6,15 @@ import (
 
 func (req *NoResourceId) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	var id string
 	tenantId := "default"
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "BadResource",
-			ID:         id,
-			Attributes: attributes,
+			Type: "BadResource",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -28,21 +26,19 @@ func (req *NoResourceId) GetChecks() pkg.CheckConfig {
 
 func (req *ValidResource) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
 		id = resource.Id
 	}
 	tenantId := "default"
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Valid",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Valid",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -54,7 +50,7 @@ func (req *ValidResource) GetChecks() pkg.CheckConfig {
 
 func (req *MultipleResourceIds) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id1 != "" {
@@ -64,14 +60,12 @@ func (req *MultipleResourceIds) GetChecks() pkg.CheckConfig {
 	if resource.TenantId != "" {
 		tenantId = resource.TenantId
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "MultiId",
-			ID:         id,
-			Attributes: attributes,
+			Type: "MultiId",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -83,7 +77,7 @@ func (req *MultipleResourceIds) GetChecks() pkg.CheckConfig {
 
 func (req *MultipleTenantIds) GetChecks() pkg.CheckConfig {
 	permission := "write"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
@@ -93,14 +87,12 @@ func (req *MultipleTenantIds) GetChecks() pkg.CheckConfig {
 	if resource.Tenant1 != "" {
 		tenantId = resource.Tenant1
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "MultiTenant",
-			ID:         id,
-			Attributes: attributes,
+			Type: "MultiTenant",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -0,0 +1,42 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkErrorCaseServiceBadResourceGetChecks(b *testing.B) {
+	req := &NoResourceId{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkErrorCaseServiceValidCaseGetChecks(b *testing.B) {
+	req := &ValidResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkErrorCaseServiceMultipleIdsGetChecks(b *testing.B) {
+	req := &MultipleResourceIds{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkErrorCaseServiceMultipleTenantsGetChecks(b *testing.B) {
+	req := &MultipleTenantIds{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,725 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/inherited_tenants.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Issue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Issue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Caller struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Caller) Reset() {
+	*x = Caller{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Caller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Caller) ProtoMessage() {}
+
+func (x *Caller) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Caller.ProtoReflect.Descriptor instead.
+func (*Caller) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Caller) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type Queue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Issues        []*Issue               `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Queue) Reset() {
+	*x = Queue{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Queue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queue) ProtoMessage() {}
+
+func (x *Queue) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queue.ProtoReflect.Descriptor instead.
+func (*Queue) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Queue) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Queue) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type Desk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Desk) Reset() {
+	*x = Desk{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Desk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Desk) ProtoMessage() {}
+
+func (x *Desk) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Desk.ProtoReflect.Descriptor instead.
+func (*Desk) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Desk) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Desk) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type Inbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inbox) Reset() {
+	*x = Inbox{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inbox) ProtoMessage() {}
+
+func (x *Inbox) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inbox.ProtoReflect.Descriptor instead.
+func (*Inbox) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Inbox) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type Tracker struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tracker) Reset() {
+	*x = Tracker{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tracker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracker) ProtoMessage() {}
+
+func (x *Tracker) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracker.ProtoReflect.Descriptor instead.
+func (*Tracker) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Tracker) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tracker) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Issues        []*Issue               `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesRequest) Reset() {
+	*x = ListIssuesRequest{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesRequest) ProtoMessage() {}
+
+func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListIssuesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ReopenIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caller        *Caller                `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenIssueRequest) Reset() {
+	*x = ReopenIssueRequest{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenIssueRequest) ProtoMessage() {}
+
+func (x *ReopenIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenIssueRequest.ProtoReflect.Descriptor instead.
+func (*ReopenIssueRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReopenIssueRequest) GetCaller() *Caller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *ReopenIssueRequest) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type MoveIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*Queue               `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveIssuesRequest) Reset() {
+	*x = MoveIssuesRequest{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveIssuesRequest) ProtoMessage() {}
+
+func (x *MoveIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveIssuesRequest.ProtoReflect.Descriptor instead.
+func (*MoveIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoveIssuesRequest) GetQueues() []*Queue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type FileIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	Tracker       *Tracker               `protobuf:"bytes,2,opt,name=tracker,proto3" json:"tracker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileIssuesRequest) Reset() {
+	*x = FileIssuesRequest{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileIssuesRequest) ProtoMessage() {}
+
+func (x *FileIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileIssuesRequest.ProtoReflect.Descriptor instead.
+func (*FileIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FileIssuesRequest) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *FileIssuesRequest) GetTracker() *Tracker {
+	if x != nil {
+		return x.Tracker
+	}
+	return nil
+}
+
+type RouteIssueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*RouteIssueRequest_Desk
+	//	*RouteIssueRequest_Inbox
+	Target        isRouteIssueRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteIssueRequest) Reset() {
+	*x = RouteIssueRequest{}
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteIssueRequest) ProtoMessage() {}
+
+func (x *RouteIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_inherited_tenants_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteIssueRequest.ProtoReflect.Descriptor instead.
+func (*RouteIssueRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_inherited_tenants_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RouteIssueRequest) GetTarget() isRouteIssueRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RouteIssueRequest) GetDesk() *Desk {
+	if x != nil {
+		if x, ok := x.Target.(*RouteIssueRequest_Desk); ok {
+			return x.Desk
+		}
+	}
+	return nil
+}
+
+func (x *RouteIssueRequest) GetInbox() *Inbox {
+	if x != nil {
+		if x, ok := x.Target.(*RouteIssueRequest_Inbox); ok {
+			return x.Inbox
+		}
+	}
+	return nil
+}
+
+type isRouteIssueRequest_Target interface {
+	isRouteIssueRequest_Target()
+}
+
+type RouteIssueRequest_Desk struct {
+	Desk *Desk `protobuf:"bytes,1,opt,name=desk,proto3,oneof"`
+}
+
+type RouteIssueRequest_Inbox struct {
+	Inbox *Inbox `protobuf:"bytes,2,opt,name=inbox,proto3,oneof"`
+}
+
+func (*RouteIssueRequest_Desk) isRouteIssueRequest_Target() {}
+
+func (*RouteIssueRequest_Inbox) isRouteIssueRequest_Target() {}
+
+var File_test_v1_inherited_tenants_proto protoreflect.FileDescriptor
+
+const file_test_v1_inherited_tenants_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftest/v1/inherited_tenants.proto\x12\atest.v1\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"(\n" +
+	"\x05Issue\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\t»\x01\x05Issue\"+\n" +
+	"\x06Caller\x12!\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x04Ȼ\x01\x01R\btenantId\"R\n" +
+	"\x05Queue\x12!\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x12&\n" +
+	"\x06issues\x18\x02 \x03(\v2\x0e.test.v1.IssueR\x06issues\"O\n" +
+	"\x04Desk\x12!\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x12$\n" +
+	"\x05issue\x18\x02 \x01(\v2\x0e.test.v1.IssueR\x05issue\"-\n" +
+	"\x05Inbox\x12$\n" +
+	"\x05issue\x18\x01 \x01(\v2\x0e.test.v1.IssueR\x05issue\"O\n" +
+	"\aTracker\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\v»\x01\aTracker\"^\n" +
+	"\x11ListIssuesRequest\x12!\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x12&\n" +
+	"\x06issues\x18\x02 \x03(\v2\x0e.test.v1.IssueR\x06issues\"c\n" +
+	"\x12ReopenIssueRequest\x12'\n" +
+	"\x06caller\x18\x01 \x01(\v2\x0f.test.v1.CallerR\x06caller\x12$\n" +
+	"\x05issue\x18\x02 \x01(\v2\x0e.test.v1.IssueR\x05issue\";\n" +
+	"\x11MoveIssuesRequest\x12&\n" +
+	"\x06queues\x18\x01 \x03(\v2\x0e.test.v1.QueueR\x06queues\"g\n" +
+	"\x11FileIssuesRequest\x12&\n" +
+	"\x06issues\x18\x01 \x03(\v2\x0e.test.v1.IssueR\x06issues\x12*\n" +
+	"\atracker\x18\x02 \x01(\v2\x10.test.v1.TrackerR\atracker\"j\n" +
+	"\x11RouteIssueRequest\x12#\n" +
+	"\x04desk\x18\x01 \x01(\v2\r.test.v1.DeskH\x00R\x04desk\x12&\n" +
+	"\x05inbox\x18\x02 \x01(\v2\x0e.test.v1.InboxH\x00R\x05inboxB\b\n" +
+	"\x06target2\xf0\x02\n" +
+	"\x06Issues\x12E\n" +
+	"\n" +
+	"ListIssues\x12\x1a.test.v1.ListIssuesRequest\x1a\x11.test.v1.Response\"\b»\x01\x04view\x12I\n" +
+	"\vReopenIssue\x12\x1b.test.v1.ReopenIssueRequest\x1a\x11.test.v1.Response\"\n" +
+	"»\x01\x06reopen\x12E\n" +
+	"\n" +
+	"MoveIssues\x12\x1a.test.v1.MoveIssuesRequest\x1a\x11.test.v1.Response\"\b»\x01\x04move\x12E\n" +
+	"\n" +
+	"FileIssues\x12\x1a.test.v1.FileIssuesRequest\x1a\x11.test.v1.Response\"\b»\x01\x04file\x12F\n" +
+	"\n" +
+	"RouteIssue\x12\x1a.test.v1.RouteIssueRequest\x1a\x11.test.v1.Response\"\t»\x01\x05routeB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_inherited_tenants_proto_rawDescOnce sync.Once
+	file_test_v1_inherited_tenants_proto_rawDescData []byte
+)
+
+func file_test_v1_inherited_tenants_proto_rawDescGZIP() []byte {
+	file_test_v1_inherited_tenants_proto_rawDescOnce.Do(func() {
+		file_test_v1_inherited_tenants_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_inherited_tenants_proto_rawDesc), len(file_test_v1_inherited_tenants_proto_rawDesc)))
+	})
+	return file_test_v1_inherited_tenants_proto_rawDescData
+}
+
+var file_test_v1_inherited_tenants_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_test_v1_inherited_tenants_proto_goTypes = []any{
+	(*Issue)(nil),              // 0: test.v1.Issue
+	(*Caller)(nil),             // 1: test.v1.Caller
+	(*Queue)(nil),              // 2: test.v1.Queue
+	(*Desk)(nil),               // 3: test.v1.Desk
+	(*Inbox)(nil),              // 4: test.v1.Inbox
+	(*Tracker)(nil),            // 5: test.v1.Tracker
+	(*ListIssuesRequest)(nil),  // 6: test.v1.ListIssuesRequest
+	(*ReopenIssueRequest)(nil), // 7: test.v1.ReopenIssueRequest
+	(*MoveIssuesRequest)(nil),  // 8: test.v1.MoveIssuesRequest
+	(*FileIssuesRequest)(nil),  // 9: test.v1.FileIssuesRequest
+	(*RouteIssueRequest)(nil),  // 10: test.v1.RouteIssueRequest
+	(*Response)(nil),           // 11: test.v1.Response
+}
+var file_test_v1_inherited_tenants_proto_depIdxs = []int32{
+	0,  // 0: test.v1.Queue.issues:type_name -> test.v1.Issue
+	0,  // 1: test.v1.Desk.issue:type_name -> test.v1.Issue
+	0,  // 2: test.v1.Inbox.issue:type_name -> test.v1.Issue
+	0,  // 3: test.v1.ListIssuesRequest.issues:type_name -> test.v1.Issue
+	1,  // 4: test.v1.ReopenIssueRequest.caller:type_name -> test.v1.Caller
+	0,  // 5: test.v1.ReopenIssueRequest.issue:type_name -> test.v1.Issue
+	2,  // 6: test.v1.MoveIssuesRequest.queues:type_name -> test.v1.Queue
+	0,  // 7: test.v1.FileIssuesRequest.issues:type_name -> test.v1.Issue
+	5,  // 8: test.v1.FileIssuesRequest.tracker:type_name -> test.v1.Tracker
+	3,  // 9: test.v1.RouteIssueRequest.desk:type_name -> test.v1.Desk
+	4,  // 10: test.v1.RouteIssueRequest.inbox:type_name -> test.v1.Inbox
+	6,  // 11: test.v1.Issues.ListIssues:input_type -> test.v1.ListIssuesRequest
+	7,  // 12: test.v1.Issues.ReopenIssue:input_type -> test.v1.ReopenIssueRequest
+	8,  // 13: test.v1.Issues.MoveIssues:input_type -> test.v1.MoveIssuesRequest
+	9,  // 14: test.v1.Issues.FileIssues:input_type -> test.v1.FileIssuesRequest
+	10, // 15: test.v1.Issues.RouteIssue:input_type -> test.v1.RouteIssueRequest
+	11, // 16: test.v1.Issues.ListIssues:output_type -> test.v1.Response
+	11, // 17: test.v1.Issues.ReopenIssue:output_type -> test.v1.Response
+	11, // 18: test.v1.Issues.MoveIssues:output_type -> test.v1.Response
+	11, // 19: test.v1.Issues.FileIssues:output_type -> test.v1.Response
+	11, // 20: test.v1.Issues.RouteIssue:output_type -> test.v1.Response
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_inherited_tenants_proto_init() }
+func file_test_v1_inherited_tenants_proto_init() {
+	if File_test_v1_inherited_tenants_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_inherited_tenants_proto_msgTypes[10].OneofWrappers = []any{
+		(*RouteIssueRequest_Desk)(nil),
+		(*RouteIssueRequest_Inbox)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_inherited_tenants_proto_rawDesc), len(file_test_v1_inherited_tenants_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_inherited_tenants_proto_goTypes,
+		DependencyIndexes: file_test_v1_inherited_tenants_proto_depIdxs,
+		MessageInfos:      file_test_v1_inherited_tenants_proto_msgTypes,
+	}.Build()
+	File_test_v1_inherited_tenants_proto = out.File
+	file_test_v1_inherited_tenants_proto_goTypes = nil
+	file_test_v1_inherited_tenants_proto_depIdxs = nil
+}
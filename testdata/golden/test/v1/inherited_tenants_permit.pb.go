@@ -0,0 +1,189 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+// GetChecks returns the checks of the request.
+// The tenant of Issue comes from inherited field test.v1.ListIssuesRequest.tenant_id, then "default".
+func (req *ListIssuesRequest) GetChecks() pkg.CheckConfig {
+	permission := "view"
+	checks := make([]pkg.Check, 0, len(req.Issues))
+	for _, v1 := range req.Issues {
+		resource := v1
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if req.TenantId != "" {
+			tenantId = req.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Issue",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks returns the checks of the request.
+// The tenant of Issue comes from inherited field test.v1.Caller.tenant_id, then "default".
+func (req *ReopenIssueRequest) GetChecks() pkg.CheckConfig {
+	permission := "reopen"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Issue
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	if req != nil && req.Caller != nil && req.Caller.TenantId != "" {
+		tenantId = req.Caller.TenantId
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Issue",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks returns the checks of the request.
+// The tenant of Issue comes from inherited field test.v1.Queue.tenant_id, then "default".
+func (req *MoveIssuesRequest) GetChecks() pkg.CheckConfig {
+	permission := "move"
+	checks := make([]pkg.Check, 0, len(req.Queues))
+	for _, v2 := range req.Queues {
+		for _, v3 := range v2.Issues {
+			resource := v3
+			var id string
+			if resource.Id != "" {
+				id = resource.Id
+			}
+			tenantId := "default"
+			if v2 != nil && v2.TenantId != "" {
+				tenantId = v2.TenantId
+			}
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Issue",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks returns the checks of the request.
+// The tenant of Issue comes from inherited field test.v1.Tracker.tenant_id, then "default".
+func (req *FileIssuesRequest) GetChecks() pkg.CheckConfig {
+	permission := "file"
+	checks := make([]pkg.Check, 0, len(req.Issues))
+	for _, v4 := range req.Issues {
+		resource := v4
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if req != nil && req.Tracker != nil && req.Tracker.TenantId != "" {
+			tenantId = req.Tracker.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Issue",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks returns the checks of the request.
+// The tenant of Issue comes from inherited field test.v1.Desk.tenant_id, then "default".
+func (req *RouteIssueRequest) GetChecks() pkg.CheckConfig {
+	permission := "route"
+	checks := make([]pkg.Check, 0, 1)
+	switch v5 := req.Target.(type) {
+	case *RouteIssueRequest_Desk:
+		resource := v5.Desk.Issue
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if v5 != nil && v5.Desk != nil && v5.Desk.TenantId != "" {
+			tenantId = v5.Desk.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Issue",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	case *RouteIssueRequest_Inbox:
+		resource := v5.Inbox.Issue
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Issue",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	default:
+		var id string
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Issue",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
@@ -0,0 +1,51 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkIssuesListIssuesGetChecks(b *testing.B) {
+	req := &ListIssuesRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkIssuesReopenIssueGetChecks(b *testing.B) {
+	req := &ReopenIssueRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkIssuesMoveIssuesGetChecks(b *testing.B) {
+	req := &MoveIssuesRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkIssuesFileIssuesGetChecks(b *testing.B) {
+	req := &FileIssuesRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkIssuesRouteIssueGetChecks(b *testing.B) {
+	req := &RouteIssueRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,512 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/map_keys.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type KeyedFolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyedFolder) Reset() {
+	*x = KeyedFolder{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyedFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyedFolder) ProtoMessage() {}
+
+func (x *KeyedFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyedFolder.ProtoReflect.Descriptor instead.
+func (*KeyedFolder) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyedFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeyedFolder) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type KeyedProject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyedProject) Reset() {
+	*x = KeyedProject{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyedProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyedProject) ProtoMessage() {}
+
+func (x *KeyedProject) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyedProject.ProtoReflect.Descriptor instead.
+func (*KeyedProject) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyedProject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type KeyedDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyedDocument) Reset() {
+	*x = KeyedDocument{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyedDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyedDocument) ProtoMessage() {}
+
+func (x *KeyedDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyedDocument.ProtoReflect.Descriptor instead.
+func (*KeyedDocument) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KeyedDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KeyedDocument) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type KeyedFolderRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Folders       map[string]*KeyedFolder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyedFolderRequest) Reset() {
+	*x = KeyedFolderRequest{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyedFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyedFolderRequest) ProtoMessage() {}
+
+func (x *KeyedFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyedFolderRequest.ProtoReflect.Descriptor instead.
+func (*KeyedFolderRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KeyedFolderRequest) GetFolders() map[string]*KeyedFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type KeyedProjectRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Projects      map[int64]*KeyedProject `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyedProjectRequest) Reset() {
+	*x = KeyedProjectRequest{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyedProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyedProjectRequest) ProtoMessage() {}
+
+func (x *KeyedProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyedProjectRequest.ProtoReflect.Descriptor instead.
+func (*KeyedProjectRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KeyedProjectRequest) GetProjects() map[int64]*KeyedProject {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type KeyedDocumentGroup struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Documents     map[uint32]*KeyedDocument `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyedDocumentGroup) Reset() {
+	*x = KeyedDocumentGroup{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyedDocumentGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyedDocumentGroup) ProtoMessage() {}
+
+func (x *KeyedDocumentGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyedDocumentGroup.ProtoReflect.Descriptor instead.
+func (*KeyedDocumentGroup) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KeyedDocumentGroup) GetDocuments() map[uint32]*KeyedDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type KeyedDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*KeyedDocumentGroup  `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyedDocumentRequest) Reset() {
+	*x = KeyedDocumentRequest{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyedDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyedDocumentRequest) ProtoMessage() {}
+
+func (x *KeyedDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyedDocumentRequest.ProtoReflect.Descriptor instead.
+func (*KeyedDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KeyedDocumentRequest) GetGroups() []*KeyedDocumentGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type PinnedDocumentRequest struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	DocumentsByPinned map[bool]*KeyedDocument `protobuf:"bytes,1,rep,name=documents_by_pinned,json=documentsByPinned,proto3" json:"documents_by_pinned,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PinnedDocumentRequest) Reset() {
+	*x = PinnedDocumentRequest{}
+	mi := &file_test_v1_map_keys_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinnedDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedDocumentRequest) ProtoMessage() {}
+
+func (x *PinnedDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_map_keys_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedDocumentRequest.ProtoReflect.Descriptor instead.
+func (*PinnedDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_map_keys_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PinnedDocumentRequest) GetDocumentsByPinned() map[bool]*KeyedDocument {
+	if x != nil {
+		return x.DocumentsByPinned
+	}
+	return nil
+}
+
+var File_test_v1_map_keys_proto protoreflect.FileDescriptor
+
+const file_test_v1_map_keys_proto_rawDesc = "" +
+	"\n" +
+	"\x16test/v1/map_keys.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"P\n" +
+	"\vKeyedFolder\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\n" +
+	"»\x01\x06Folder\"/\n" +
+	"\fKeyedProject\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name:\v»\x01\aProject\"T\n" +
+	"\rKeyedDocument\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12\x1f\n" +
+	"\x05title\x18\x02 \x01(\tB\tһ\x01\x05titleR\x05title:\f»\x01\bDocument\"\xb0\x01\n" +
+	"\x12KeyedFolderRequest\x12H\n" +
+	"\afolders\x18\x01 \x03(\v2(.test.v1.KeyedFolderRequest.FoldersEntryB\x04\xe8\xc1\x01\x01R\afolders\x1aP\n" +
+	"\fFoldersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.test.v1.KeyedFolderR\x05value:\x028\x01\"\xb7\x01\n" +
+	"\x13KeyedProjectRequest\x12L\n" +
+	"\bprojects\x18\x01 \x03(\v2*.test.v1.KeyedProjectRequest.ProjectsEntryB\x04\xe8\xc1\x01\x01R\bprojects\x1aR\n" +
+	"\rProjectsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.test.v1.KeyedProjectR\x05value:\x028\x01\"\xba\x01\n" +
+	"\x12KeyedDocumentGroup\x12N\n" +
+	"\tdocuments\x18\x01 \x03(\v2*.test.v1.KeyedDocumentGroup.DocumentsEntryB\x04\xe8\xc1\x01\x01R\tdocuments\x1aT\n" +
+	"\x0eDocumentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.test.v1.KeyedDocumentR\x05value:\x028\x01\"K\n" +
+	"\x14KeyedDocumentRequest\x123\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1b.test.v1.KeyedDocumentGroupR\x06groups\"\xdc\x01\n" +
+	"\x15PinnedDocumentRequest\x12e\n" +
+	"\x13documents_by_pinned\x18\x01 \x03(\v25.test.v1.PinnedDocumentRequest.DocumentsByPinnedEntryR\x11documentsByPinned\x1a\\\n" +
+	"\x16DocumentsByPinnedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\bR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.test.v1.KeyedDocumentR\x05value:\x028\x012\xd0\x02\n" +
+	"\rMapKeyService\x12J\n" +
+	"\rUpdateFolders\x12\x1b.test.v1.KeyedFolderRequest\x1a\x11.test.v1.Response\"\t»\x01\x05write\x12L\n" +
+	"\x0eUpdateProjects\x12\x1c.test.v1.KeyedProjectRequest\x1a\x11.test.v1.Response\"\t»\x01\x05write\x12N\n" +
+	"\x0fUpdateDocuments\x12\x1d.test.v1.KeyedDocumentRequest\x1a\x11.test.v1.Response\"\t»\x01\x05write\x12U\n" +
+	"\x15UpdatePinnedDocuments\x12\x1e.test.v1.PinnedDocumentRequest\x1a\x11.test.v1.Response\"\t»\x01\x05writeB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_map_keys_proto_rawDescOnce sync.Once
+	file_test_v1_map_keys_proto_rawDescData []byte
+)
+
+func file_test_v1_map_keys_proto_rawDescGZIP() []byte {
+	file_test_v1_map_keys_proto_rawDescOnce.Do(func() {
+		file_test_v1_map_keys_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_map_keys_proto_rawDesc), len(file_test_v1_map_keys_proto_rawDesc)))
+	})
+	return file_test_v1_map_keys_proto_rawDescData
+}
+
+var file_test_v1_map_keys_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_test_v1_map_keys_proto_goTypes = []any{
+	(*KeyedFolder)(nil),           // 0: test.v1.KeyedFolder
+	(*KeyedProject)(nil),          // 1: test.v1.KeyedProject
+	(*KeyedDocument)(nil),         // 2: test.v1.KeyedDocument
+	(*KeyedFolderRequest)(nil),    // 3: test.v1.KeyedFolderRequest
+	(*KeyedProjectRequest)(nil),   // 4: test.v1.KeyedProjectRequest
+	(*KeyedDocumentGroup)(nil),    // 5: test.v1.KeyedDocumentGroup
+	(*KeyedDocumentRequest)(nil),  // 6: test.v1.KeyedDocumentRequest
+	(*PinnedDocumentRequest)(nil), // 7: test.v1.PinnedDocumentRequest
+	nil,                           // 8: test.v1.KeyedFolderRequest.FoldersEntry
+	nil,                           // 9: test.v1.KeyedProjectRequest.ProjectsEntry
+	nil,                           // 10: test.v1.KeyedDocumentGroup.DocumentsEntry
+	nil,                           // 11: test.v1.PinnedDocumentRequest.DocumentsByPinnedEntry
+	(*Response)(nil),              // 12: test.v1.Response
+}
+var file_test_v1_map_keys_proto_depIdxs = []int32{
+	8,  // 0: test.v1.KeyedFolderRequest.folders:type_name -> test.v1.KeyedFolderRequest.FoldersEntry
+	9,  // 1: test.v1.KeyedProjectRequest.projects:type_name -> test.v1.KeyedProjectRequest.ProjectsEntry
+	10, // 2: test.v1.KeyedDocumentGroup.documents:type_name -> test.v1.KeyedDocumentGroup.DocumentsEntry
+	5,  // 3: test.v1.KeyedDocumentRequest.groups:type_name -> test.v1.KeyedDocumentGroup
+	11, // 4: test.v1.PinnedDocumentRequest.documents_by_pinned:type_name -> test.v1.PinnedDocumentRequest.DocumentsByPinnedEntry
+	0,  // 5: test.v1.KeyedFolderRequest.FoldersEntry.value:type_name -> test.v1.KeyedFolder
+	1,  // 6: test.v1.KeyedProjectRequest.ProjectsEntry.value:type_name -> test.v1.KeyedProject
+	2,  // 7: test.v1.KeyedDocumentGroup.DocumentsEntry.value:type_name -> test.v1.KeyedDocument
+	2,  // 8: test.v1.PinnedDocumentRequest.DocumentsByPinnedEntry.value:type_name -> test.v1.KeyedDocument
+	3,  // 9: test.v1.MapKeyService.UpdateFolders:input_type -> test.v1.KeyedFolderRequest
+	4,  // 10: test.v1.MapKeyService.UpdateProjects:input_type -> test.v1.KeyedProjectRequest
+	6,  // 11: test.v1.MapKeyService.UpdateDocuments:input_type -> test.v1.KeyedDocumentRequest
+	7,  // 12: test.v1.MapKeyService.UpdatePinnedDocuments:input_type -> test.v1.PinnedDocumentRequest
+	12, // 13: test.v1.MapKeyService.UpdateFolders:output_type -> test.v1.Response
+	12, // 14: test.v1.MapKeyService.UpdateProjects:output_type -> test.v1.Response
+	12, // 15: test.v1.MapKeyService.UpdateDocuments:output_type -> test.v1.Response
+	12, // 16: test.v1.MapKeyService.UpdatePinnedDocuments:output_type -> test.v1.Response
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_map_keys_proto_init() }
+func file_test_v1_map_keys_proto_init() {
+	if File_test_v1_map_keys_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_map_keys_proto_rawDesc), len(file_test_v1_map_keys_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_map_keys_proto_goTypes,
+		DependencyIndexes: file_test_v1_map_keys_proto_depIdxs,
+		MessageInfos:      file_test_v1_map_keys_proto_msgTypes,
+	}.Build()
+	File_test_v1_map_keys_proto = out.File
+	file_test_v1_map_keys_proto_goTypes = nil
+	file_test_v1_map_keys_proto_depIdxs = nil
+}
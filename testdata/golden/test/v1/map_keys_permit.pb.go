@@ -0,0 +1,128 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	maps "maps"
+	slices "slices"
+	strconv "strconv"
+)
+
+func (req *KeyedFolderRequest) GetChecks() pkg.CheckConfig {
+	permission := "write"
+	checks := make([]pkg.Check, 0, len(req.Folders))
+	for _, v1 := range slices.Sorted(maps.Keys(req.Folders)) {
+		v2 := req.Folders[v1]
+		resource := v2
+		var id string
+		if v1 != "" {
+			id = v1
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Folder",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *KeyedProjectRequest) GetChecks() pkg.CheckConfig {
+	permission := "write"
+	checks := make([]pkg.Check, 0, len(req.Projects))
+	for _, v3 := range slices.Sorted(maps.Keys(req.Projects)) {
+		var id string
+		if v3 != 0 {
+			id = strconv.FormatInt(v3, 10)
+		}
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Project",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *KeyedDocumentRequest) GetChecks() pkg.CheckConfig {
+	permission := "write"
+	checks := make([]pkg.Check, 0, len(req.Groups))
+	for _, v4 := range req.Groups {
+		for _, v5 := range slices.Sorted(maps.Keys(v4.Documents)) {
+			v6 := v4.Documents[v5]
+			resource := v6
+			var id string
+			if v5 != 0 {
+				id = strconv.FormatUint(uint64(v5), 10)
+			}
+			tenantId := "default"
+			attributes := make(map[string]any, 1)
+			attributes["title"] = resource.Title
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type:       "Document",
+					ID:         id,
+					Attributes: attributes,
+				},
+			}
+			checks = append(checks, check)
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *PinnedDocumentRequest) GetChecks() pkg.CheckConfig {
+	permission := "write"
+	checks := make([]pkg.Check, 0, len(req.DocumentsByPinned))
+	for _, v8 := range []bool{false, true} {
+		v7, ok := req.DocumentsByPinned[v8]
+		if !ok {
+			continue
+		}
+		resource := v7
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		attributes := make(map[string]any, 1)
+		attributes["title"] = resource.Title
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type:       "Document",
+				ID:         id,
+				Attributes: attributes,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
@@ -0,0 +1,42 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkMapKeyServiceUpdateFoldersGetChecks(b *testing.B) {
+	req := &KeyedFolderRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMapKeyServiceUpdateProjectsGetChecks(b *testing.B) {
+	req := &KeyedProjectRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMapKeyServiceUpdateDocumentsGetChecks(b *testing.B) {
+	req := &KeyedDocumentRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMapKeyServiceUpdatePinnedDocumentsGetChecks(b *testing.B) {
+	req := &PinnedDocumentRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,32 @@
+package testv1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nrf110/connectrpc-permify/pkg"
+)
+
+// checkedIds returns the entity ids of the checks of req, in their order.
+func checkedIds(req pkg.Checkable) []string {
+	var ids []string
+	for _, check := range req.GetChecks().Checks {
+		ids = append(ids, check.Entity.ID)
+	}
+	return ids
+}
+
+func TestMapKeyServiceChecksMapsInKeyOrder(t *testing.T) {
+	folders := &KeyedFolderRequest{Folders: map[string]*KeyedFolder{"c": {}, "a": {}, "b": {}}}
+	pinned := &PinnedDocumentRequest{DocumentsByPinned: map[bool]*KeyedDocument{true: {Id: "pinned"}, false: {Id: "unpinned"}}}
+
+	// Maps are sorted by default, so the order is the same on every call despite Go's random map order
+	for range 10 {
+		if ids, expected := checkedIds(folders), []string{"a", "b", "c"}; !reflect.DeepEqual(ids, expected) {
+			t.Fatalf("GetChecks() of folders checked %v, want %v", ids, expected)
+		}
+		if ids, expected := checkedIds(pinned), []string{"unpinned", "pinned"}; !reflect.DeepEqual(ids, expected) {
+			t.Fatalf("GetChecks() of pinned documents checked %v, want %v", ids, expected)
+		}
+	}
+}
@@ -13,21 +13,19 @@ func (req *SimpleRequest) GetChecks() pkg.CheckConfig {
 
 func (req *GetUserResource) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.UserId != "" {
 		id = resource.UserId
 	}
 	tenantId := "default"
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "User",
-			ID:         id,
-			Attributes: attributes,
+			Type: "User",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -39,7 +37,7 @@ func (req *GetUserResource) GetChecks() pkg.CheckConfig {
 
 func (req *UpdateUserResource) GetChecks() pkg.CheckConfig {
 	permission := "write"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.UserId != "" {
@@ -49,7 +47,7 @@ func (req *UpdateUserResource) GetChecks() pkg.CheckConfig {
 	if resource.CompanyId != "" {
 		tenantId = resource.CompanyId
 	}
-	attributes := make(map[string]any)
+	attributes := make(map[string]any, 2)
 	attributes["email"] = resource.Email
 	attributes["role"] = resource.Role
 	check := pkg.Check{
@@ -70,21 +68,19 @@ func (req *UpdateUserResource) GetChecks() pkg.CheckConfig {
 
 func (req *DeleteUserResource) GetChecks() pkg.CheckConfig {
 	permission := "admin"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.UserId != "" {
 		id = resource.UserId
 	}
 	tenantId := "default"
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "User",
-			ID:         id,
-			Attributes: attributes,
+			Type: "User",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -0,0 +1,42 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkMixedServiceGetPublicInfoGetChecks(b *testing.B) {
+	req := &SimpleRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMixedServiceGetUserGetChecks(b *testing.B) {
+	req := &GetUserResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMixedServiceUpdateUserGetChecks(b *testing.B) {
+	req := &UpdateUserResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkMixedServiceDeleteUserGetChecks(b *testing.B) {
+	req := &DeleteUserResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -6,7 +6,7 @@ import (
 
 func (req *Account) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
@@ -16,14 +16,12 @@ func (req *Account) GetChecks() pkg.CheckConfig {
 	if resource.OrgId != "" {
 		tenantId = resource.OrgId
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Account",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Account",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -42,21 +40,19 @@ func (req *PublicInfo) GetChecks() pkg.CheckConfig {
 
 func (req *Profile) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
 		id = resource.Id
 	}
 	tenantId := "default"
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Profile",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Profile",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -68,21 +64,19 @@ func (req *Profile) GetChecks() pkg.CheckConfig {
 
 func (req *Settings) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
 		id = resource.Id
 	}
 	tenantId := "default"
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Settings",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Settings",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -0,0 +1,42 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkAccountServiceGetAccountGetChecks(b *testing.B) {
+	req := &Account{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkAccountServiceGetPublicAccountInfoGetChecks(b *testing.B) {
+	req := &PublicInfo{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkProfileServiceGetProfileGetChecks(b *testing.B) {
+	req := &Profile{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkSettingsServiceGetSettingsGetChecks(b *testing.B) {
+	req := &Settings{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/multiple_permissions.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Vault struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vault) Reset() {
+	*x = Vault{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vault) ProtoMessage() {}
+
+func (x *Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vault.ProtoReflect.Descriptor instead.
+func (*Vault) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vault) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vault         *Vault                 `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferOwnershipRequest) GetVault() *Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *TransferOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type PurgeVaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vaults        []*Vault               `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVaultsRequest) Reset() {
+	*x = PurgeVaultsRequest{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVaultsRequest) ProtoMessage() {}
+
+func (x *PurgeVaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVaultsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeVaultsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PurgeVaultsRequest) GetVaults() []*Vault {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
+}
+
+type NewVault struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NewVault) Reset() {
+	*x = NewVault{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewVault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewVault) ProtoMessage() {}
+
+func (x *NewVault) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewVault.ProtoReflect.Descriptor instead.
+func (*NewVault) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NewVault) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type CreateVaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vault         *NewVault              `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVaultRequest) Reset() {
+	*x = CreateVaultRequest{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVaultRequest) ProtoMessage() {}
+
+func (x *CreateVaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVaultRequest.ProtoReflect.Descriptor instead.
+func (*CreateVaultRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateVaultRequest) GetVault() *NewVault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+type Safe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Safe) Reset() {
+	*x = Safe{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Safe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Safe) ProtoMessage() {}
+
+func (x *Safe) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Safe.ProtoReflect.Descriptor instead.
+func (*Safe) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Safe) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AuditRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*AuditRequest_Vault
+	//	*AuditRequest_Safe
+	Target        isAuditRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRequest) Reset() {
+	*x = AuditRequest{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRequest) ProtoMessage() {}
+
+func (x *AuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequest.ProtoReflect.Descriptor instead.
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuditRequest) GetTarget() isAuditRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *AuditRequest) GetVault() *Vault {
+	if x != nil {
+		if x, ok := x.Target.(*AuditRequest_Vault); ok {
+			return x.Vault
+		}
+	}
+	return nil
+}
+
+func (x *AuditRequest) GetSafe() *Safe {
+	if x != nil {
+		if x, ok := x.Target.(*AuditRequest_Safe); ok {
+			return x.Safe
+		}
+	}
+	return nil
+}
+
+type isAuditRequest_Target interface {
+	isAuditRequest_Target()
+}
+
+type AuditRequest_Vault struct {
+	Vault *Vault `protobuf:"bytes,1,opt,name=vault,proto3,oneof"`
+}
+
+type AuditRequest_Safe struct {
+	Safe *Safe `protobuf:"bytes,2,opt,name=safe,proto3,oneof"`
+}
+
+func (*AuditRequest_Vault) isAuditRequest_Target() {}
+
+func (*AuditRequest_Safe) isAuditRequest_Target() {}
+
+type ViewVaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vault         *Vault                 `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewVaultRequest) Reset() {
+	*x = ViewVaultRequest{}
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewVaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewVaultRequest) ProtoMessage() {}
+
+func (x *ViewVaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_multiple_permissions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewVaultRequest.ProtoReflect.Descriptor instead.
+func (*ViewVaultRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_multiple_permissions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ViewVaultRequest) GetVault() *Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+var File_test_v1_multiple_permissions_proto protoreflect.FileDescriptor
+
+const file_test_v1_multiple_permissions_proto_rawDesc = "" +
+	"\n" +
+	"\"test/v1/multiple_permissions.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"(\n" +
+	"\x05Vault\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\t»\x01\x05Vault\"b\n" +
+	"\x18TransferOwnershipRequest\x12$\n" +
+	"\x05vault\x18\x01 \x01(\v2\x0e.test.v1.VaultR\x05vault\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\"<\n" +
+	"\x12PurgeVaultsRequest\x12&\n" +
+	"\x06vaults\x18\x01 \x03(\v2\x0e.test.v1.VaultR\x06vaults\"a\n" +
+	"\bNewVault\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId:,»\x01\x05Vault\xe2\xc1\x01\x1f\n" +
+	"\fOrganization\x12\x0forganization_id\"=\n" +
+	"\x12CreateVaultRequest\x12'\n" +
+	"\x05vault\x18\x01 \x01(\v2\x11.test.v1.NewVaultR\x05vault\"&\n" +
+	"\x04Safe\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\b»\x01\x04Safe\"e\n" +
+	"\fAuditRequest\x12&\n" +
+	"\x05vault\x18\x01 \x01(\v2\x0e.test.v1.VaultH\x00R\x05vault\x12#\n" +
+	"\x04safe\x18\x02 \x01(\v2\r.test.v1.SafeH\x00R\x04safeB\b\n" +
+	"\x06target\"8\n" +
+	"\x10ViewVaultRequest\x12$\n" +
+	"\x05vault\x18\x01 \x01(\v2\x0e.test.v1.VaultR\x05vault2\xb0\x03\n" +
+	"\x06Vaults\x12d\n" +
+	"\x11TransferOwnership\x12!.test.v1.TransferOwnershipRequest\x1a\x11.test.v1.Response\"\x19\xf2\xc1\x01\x05write\xf2\xc1\x01\btransfer\xf8\xc1\x01\x01\x12U\n" +
+	"\vPurgeVaults\x12\x1b.test.v1.PurgeVaultsRequest\x1a\x11.test.v1.Response\"\x16\xf2\xc1\x01\x05admin\xf2\xc1\x01\x05owner\xf8\xc1\x01\x02\x12Z\n" +
+	"\vCreateVault\x12\x1b.test.v1.CreateVaultRequest\x1a\x11.test.v1.Response\"\x1b\xf2\xc1\x01\x06create\xf2\xc1\x01\tprovision\xf8\xc1\x01\x01\x12H\n" +
+	"\x05Audit\x12\x15.test.v1.AuditRequest\x1a\x11.test.v1.Response\"\x15\xf2\xc1\x01\x05audit\xf2\xc1\x01\x04view\xf8\xc1\x01\x01\x12C\n" +
+	"\tViewVault\x12\x19.test.v1.ViewVaultRequest\x1a\x11.test.v1.Response\"\b\xf2\xc1\x01\x04viewB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_multiple_permissions_proto_rawDescOnce sync.Once
+	file_test_v1_multiple_permissions_proto_rawDescData []byte
+)
+
+func file_test_v1_multiple_permissions_proto_rawDescGZIP() []byte {
+	file_test_v1_multiple_permissions_proto_rawDescOnce.Do(func() {
+		file_test_v1_multiple_permissions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_multiple_permissions_proto_rawDesc), len(file_test_v1_multiple_permissions_proto_rawDesc)))
+	})
+	return file_test_v1_multiple_permissions_proto_rawDescData
+}
+
+var file_test_v1_multiple_permissions_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_test_v1_multiple_permissions_proto_goTypes = []any{
+	(*Vault)(nil),                    // 0: test.v1.Vault
+	(*TransferOwnershipRequest)(nil), // 1: test.v1.TransferOwnershipRequest
+	(*PurgeVaultsRequest)(nil),       // 2: test.v1.PurgeVaultsRequest
+	(*NewVault)(nil),                 // 3: test.v1.NewVault
+	(*CreateVaultRequest)(nil),       // 4: test.v1.CreateVaultRequest
+	(*Safe)(nil),                     // 5: test.v1.Safe
+	(*AuditRequest)(nil),             // 6: test.v1.AuditRequest
+	(*ViewVaultRequest)(nil),         // 7: test.v1.ViewVaultRequest
+	(*Response)(nil),                 // 8: test.v1.Response
+}
+var file_test_v1_multiple_permissions_proto_depIdxs = []int32{
+	0,  // 0: test.v1.TransferOwnershipRequest.vault:type_name -> test.v1.Vault
+	0,  // 1: test.v1.PurgeVaultsRequest.vaults:type_name -> test.v1.Vault
+	3,  // 2: test.v1.CreateVaultRequest.vault:type_name -> test.v1.NewVault
+	0,  // 3: test.v1.AuditRequest.vault:type_name -> test.v1.Vault
+	5,  // 4: test.v1.AuditRequest.safe:type_name -> test.v1.Safe
+	0,  // 5: test.v1.ViewVaultRequest.vault:type_name -> test.v1.Vault
+	1,  // 6: test.v1.Vaults.TransferOwnership:input_type -> test.v1.TransferOwnershipRequest
+	2,  // 7: test.v1.Vaults.PurgeVaults:input_type -> test.v1.PurgeVaultsRequest
+	4,  // 8: test.v1.Vaults.CreateVault:input_type -> test.v1.CreateVaultRequest
+	6,  // 9: test.v1.Vaults.Audit:input_type -> test.v1.AuditRequest
+	7,  // 10: test.v1.Vaults.ViewVault:input_type -> test.v1.ViewVaultRequest
+	8,  // 11: test.v1.Vaults.TransferOwnership:output_type -> test.v1.Response
+	8,  // 12: test.v1.Vaults.PurgeVaults:output_type -> test.v1.Response
+	8,  // 13: test.v1.Vaults.CreateVault:output_type -> test.v1.Response
+	8,  // 14: test.v1.Vaults.Audit:output_type -> test.v1.Response
+	8,  // 15: test.v1.Vaults.ViewVault:output_type -> test.v1.Response
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_multiple_permissions_proto_init() }
+func file_test_v1_multiple_permissions_proto_init() {
+	if File_test_v1_multiple_permissions_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_multiple_permissions_proto_msgTypes[6].OneofWrappers = []any{
+		(*AuditRequest_Vault)(nil),
+		(*AuditRequest_Safe)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_multiple_permissions_proto_rawDesc), len(file_test_v1_multiple_permissions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_multiple_permissions_proto_goTypes,
+		DependencyIndexes: file_test_v1_multiple_permissions_proto_depIdxs,
+		MessageInfos:      file_test_v1_multiple_permissions_proto_msgTypes,
+	}.Build()
+	File_test_v1_multiple_permissions_proto = out.File
+	file_test_v1_multiple_permissions_proto_goTypes = nil
+	file_test_v1_multiple_permissions_proto_depIdxs = nil
+}
@@ -0,0 +1,210 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+func (req *TransferOwnershipRequest) GetChecks() pkg.CheckConfig {
+	permissions := []string{"write", "transfer"}
+	checks := make([]pkg.Check, 0, 2)
+	resource := req.Vault
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	for _, permission := range permissions {
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Vault",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetPermissionCombinator returns how the checks returned by GetChecks combine, all_of or any_of. Each entity
+// has one consecutive check per permission, in the order write, transfer.
+func (req *TransferOwnershipRequest) GetPermissionCombinator() string {
+	return "all_of"
+}
+
+func (req *PurgeVaultsRequest) GetChecks() pkg.CheckConfig {
+	permissions := []string{"admin", "owner"}
+	checks := make([]pkg.Check, 0, len(req.Vaults)*2)
+	for _, v1 := range req.Vaults {
+		resource := v1
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		for _, permission := range permissions {
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Vault",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetPermissionCombinator returns how the checks returned by GetChecks combine, all_of or any_of. Each entity
+// has one consecutive check per permission, in the order admin, owner.
+func (req *PurgeVaultsRequest) GetPermissionCombinator() string {
+	return "any_of"
+}
+
+func (req *CreateVaultRequest) GetChecks() pkg.CheckConfig {
+	permissions := []string{"create", "provision"}
+	checks := make([]pkg.Check, 0, 2)
+	resource := req.Vault
+	tenantId := "default"
+	var parentId string
+	if resource.OrganizationId != "" {
+		parentId = resource.OrganizationId
+	}
+	for _, permission := range permissions {
+		checks = append(checks, pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Organization",
+				ID:   parentId,
+			},
+		})
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetPermissionCombinator returns how the checks returned by GetChecks combine, all_of or any_of. Each entity
+// has one consecutive check per permission, in the order create, provision.
+func (req *CreateVaultRequest) GetPermissionCombinator() string {
+	return "all_of"
+}
+
+func (req *AuditRequest) GetChecks() pkg.CheckConfig {
+	permissions := []string{"audit", "view"}
+	checks := make([]pkg.Check, 0, 2)
+	switch v2 := req.Target.(type) {
+	case *AuditRequest_Vault:
+		resource := v2.Vault
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		for _, permission := range permissions {
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Vault",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		}
+	case *AuditRequest_Safe:
+		resource := v2.Safe
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		for _, permission := range permissions {
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Safe",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		}
+	default:
+		{
+			var id string
+			tenantId := "default"
+			for _, permission := range permissions {
+				check := pkg.Check{
+					TenantID:   tenantId,
+					Permission: permission,
+					Entity: &pkg.Resource{
+						Type: "Vault",
+						ID:   id,
+					},
+				}
+				checks = append(checks, check)
+			}
+		}
+		{
+			var id string
+			tenantId := "default"
+			for _, permission := range permissions {
+				check := pkg.Check{
+					TenantID:   tenantId,
+					Permission: permission,
+					Entity: &pkg.Resource{
+						Type: "Safe",
+						ID:   id,
+					},
+				}
+				checks = append(checks, check)
+			}
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetPermissionCombinator returns how the checks returned by GetChecks combine, all_of or any_of. Each entity
+// has one consecutive check per permission, in the order audit, view.
+func (req *AuditRequest) GetPermissionCombinator() string {
+	return "all_of"
+}
+
+func (req *ViewVaultRequest) GetChecks() pkg.CheckConfig {
+	permission := "view"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Vault
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Vault",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
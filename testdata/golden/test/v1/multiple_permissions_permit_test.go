@@ -0,0 +1,51 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkVaultsTransferOwnershipGetChecks(b *testing.B) {
+	req := &TransferOwnershipRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkVaultsPurgeVaultsGetChecks(b *testing.B) {
+	req := &PurgeVaultsRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkVaultsCreateVaultGetChecks(b *testing.B) {
+	req := &CreateVaultRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkVaultsAuditGetChecks(b *testing.B) {
+	req := &AuditRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkVaultsViewVaultGetChecks(b *testing.B) {
+	req := &ViewVaultRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -4,23 +4,26 @@ import (
 	pkg "github.com/nrf110/connectrpc-permify/pkg"
 )
 
+// GetChecks returns the checks of the request.
+// The tenant of Document comes from inherited field test.v1.Workspace.tenant_id, then "default".
 func (req *MultiResourceRequest) GetChecks() pkg.CheckConfig {
 	permission := "manage"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req.Document
 	var id string
 	if resource.Id != "" {
 		id = resource.Id
 	}
 	tenantId := "default"
-	attributes := make(map[string]any)
+	if req != nil && req.Workspace != nil && req.Workspace.TenantId != "" {
+		tenantId = req.Workspace.TenantId
+	}
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Document",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Document",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
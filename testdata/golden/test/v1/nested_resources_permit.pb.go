@@ -6,7 +6,7 @@ import (
 
 func (req *NestedRequest) GetChecks() pkg.CheckConfig {
 	permission := "manage"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req.Organization
 	var id string
 	if resource.Id != "" {
@@ -16,14 +16,12 @@ func (req *NestedRequest) GetChecks() pkg.CheckConfig {
 	if resource.TenantId != "" {
 		tenantId = resource.TenantId
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Organization",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Organization",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
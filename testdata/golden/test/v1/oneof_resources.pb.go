@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/oneof_resources.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SharedDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedDocument) Reset() {
+	*x = SharedDocument{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedDocument) ProtoMessage() {}
+
+func (x *SharedDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedDocument.ProtoReflect.Descriptor instead.
+func (*SharedDocument) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharedDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SharedDocument) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type SharedFolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedFolder) Reset() {
+	*x = SharedFolder{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedFolder) ProtoMessage() {}
+
+func (x *SharedFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedFolder.ProtoReflect.Descriptor instead.
+func (*SharedFolder) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SharedFolder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SharedLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedLink) Reset() {
+	*x = SharedLink{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedLink) ProtoMessage() {}
+
+func (x *SharedLink) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedLink.ProtoReflect.Descriptor instead.
+func (*SharedLink) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{2}
+}
+
+type ShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*ShareRequest_Document
+	//	*ShareRequest_Folder
+	Target        isShareRequest_Target `protobuf_oneof:"target"`
+	Note          string                `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareRequest) Reset() {
+	*x = ShareRequest{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareRequest) ProtoMessage() {}
+
+func (x *ShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareRequest.ProtoReflect.Descriptor instead.
+func (*ShareRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShareRequest) GetTarget() isShareRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ShareRequest) GetDocument() *SharedDocument {
+	if x != nil {
+		if x, ok := x.Target.(*ShareRequest_Document); ok {
+			return x.Document
+		}
+	}
+	return nil
+}
+
+func (x *ShareRequest) GetFolder() *SharedFolder {
+	if x != nil {
+		if x, ok := x.Target.(*ShareRequest_Folder); ok {
+			return x.Folder
+		}
+	}
+	return nil
+}
+
+func (x *ShareRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type isShareRequest_Target interface {
+	isShareRequest_Target()
+}
+
+type ShareRequest_Document struct {
+	Document *SharedDocument `protobuf:"bytes,1,opt,name=document,proto3,oneof"`
+}
+
+type ShareRequest_Folder struct {
+	Folder *SharedFolder `protobuf:"bytes,2,opt,name=folder,proto3,oneof"`
+}
+
+func (*ShareRequest_Document) isShareRequest_Target() {}
+
+func (*ShareRequest_Folder) isShareRequest_Target() {}
+
+type RequiredShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*RequiredShareRequest_Document
+	//	*RequiredShareRequest_Folder
+	//	*RequiredShareRequest_Url
+	Target        isRequiredShareRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequiredShareRequest) Reset() {
+	*x = RequiredShareRequest{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequiredShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequiredShareRequest) ProtoMessage() {}
+
+func (x *RequiredShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequiredShareRequest.ProtoReflect.Descriptor instead.
+func (*RequiredShareRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RequiredShareRequest) GetTarget() isRequiredShareRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RequiredShareRequest) GetDocument() *SharedDocument {
+	if x != nil {
+		if x, ok := x.Target.(*RequiredShareRequest_Document); ok {
+			return x.Document
+		}
+	}
+	return nil
+}
+
+func (x *RequiredShareRequest) GetFolder() *SharedFolder {
+	if x != nil {
+		if x, ok := x.Target.(*RequiredShareRequest_Folder); ok {
+			return x.Folder
+		}
+	}
+	return nil
+}
+
+func (x *RequiredShareRequest) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Target.(*RequiredShareRequest_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+type isRequiredShareRequest_Target interface {
+	isRequiredShareRequest_Target()
+}
+
+type RequiredShareRequest_Document struct {
+	Document *SharedDocument `protobuf:"bytes,1,opt,name=document,proto3,oneof"`
+}
+
+type RequiredShareRequest_Folder struct {
+	Folder *SharedFolder `protobuf:"bytes,2,opt,name=folder,proto3,oneof"`
+}
+
+type RequiredShareRequest_Url struct {
+	Url string `protobuf:"bytes,3,opt,name=url,proto3,oneof"`
+}
+
+func (*RequiredShareRequest_Document) isRequiredShareRequest_Target() {}
+
+func (*RequiredShareRequest_Folder) isRequiredShareRequest_Target() {}
+
+func (*RequiredShareRequest_Url) isRequiredShareRequest_Target() {}
+
+type BatchShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*ShareRequest        `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchShareRequest) Reset() {
+	*x = BatchShareRequest{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchShareRequest) ProtoMessage() {}
+
+func (x *BatchShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchShareRequest.ProtoReflect.Descriptor instead.
+func (*BatchShareRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchShareRequest) GetShares() []*ShareRequest {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type LinkShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*LinkShareRequest_Link
+	//	*LinkShareRequest_Url
+	Target        isLinkShareRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkShareRequest) Reset() {
+	*x = LinkShareRequest{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkShareRequest) ProtoMessage() {}
+
+func (x *LinkShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkShareRequest.ProtoReflect.Descriptor instead.
+func (*LinkShareRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LinkShareRequest) GetTarget() isLinkShareRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *LinkShareRequest) GetLink() *SharedLink {
+	if x != nil {
+		if x, ok := x.Target.(*LinkShareRequest_Link); ok {
+			return x.Link
+		}
+	}
+	return nil
+}
+
+func (x *LinkShareRequest) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Target.(*LinkShareRequest_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+type isLinkShareRequest_Target interface {
+	isLinkShareRequest_Target()
+}
+
+type LinkShareRequest_Link struct {
+	Link *SharedLink `protobuf:"bytes,1,opt,name=link,proto3,oneof"`
+}
+
+type LinkShareRequest_Url struct {
+	Url string `protobuf:"bytes,2,opt,name=url,proto3,oneof"`
+}
+
+func (*LinkShareRequest_Link) isLinkShareRequest_Target() {}
+
+func (*LinkShareRequest_Url) isLinkShareRequest_Target() {}
+
+type DeniedShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*DeniedShareRequest_Document
+	//	*DeniedShareRequest_Url
+	Target        isDeniedShareRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeniedShareRequest) Reset() {
+	*x = DeniedShareRequest{}
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeniedShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeniedShareRequest) ProtoMessage() {}
+
+func (x *DeniedShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_oneof_resources_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeniedShareRequest.ProtoReflect.Descriptor instead.
+func (*DeniedShareRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_oneof_resources_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeniedShareRequest) GetTarget() isDeniedShareRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *DeniedShareRequest) GetDocument() *SharedDocument {
+	if x != nil {
+		if x, ok := x.Target.(*DeniedShareRequest_Document); ok {
+			return x.Document
+		}
+	}
+	return nil
+}
+
+func (x *DeniedShareRequest) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Target.(*DeniedShareRequest_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+type isDeniedShareRequest_Target interface {
+	isDeniedShareRequest_Target()
+}
+
+type DeniedShareRequest_Document struct {
+	Document *SharedDocument `protobuf:"bytes,1,opt,name=document,proto3,oneof"`
+}
+
+type DeniedShareRequest_Url struct {
+	Url string `protobuf:"bytes,2,opt,name=url,proto3,oneof"`
+}
+
+func (*DeniedShareRequest_Document) isDeniedShareRequest_Target() {}
+
+func (*DeniedShareRequest_Url) isDeniedShareRequest_Target() {}
+
+var File_test_v1_oneof_resources_proto protoreflect.FileDescriptor
+
+const file_test_v1_oneof_resources_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtest/v1/oneof_resources.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"W\n" +
+	"\x0eSharedDocument\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\f»\x01\bDocument\"0\n" +
+	"\fSharedFolder\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\n" +
+	"»\x01\x06Folder\"\x16\n" +
+	"\n" +
+	"SharedLink:\b»\x01\x04Link\"\x94\x01\n" +
+	"\fShareRequest\x125\n" +
+	"\bdocument\x18\x01 \x01(\v2\x17.test.v1.SharedDocumentH\x00R\bdocument\x12/\n" +
+	"\x06folder\x18\x02 \x01(\v2\x15.test.v1.SharedFolderH\x00R\x06folder\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04noteB\b\n" +
+	"\x06target\"\xa2\x01\n" +
+	"\x14RequiredShareRequest\x125\n" +
+	"\bdocument\x18\x01 \x01(\v2\x17.test.v1.SharedDocumentH\x00R\bdocument\x12/\n" +
+	"\x06folder\x18\x02 \x01(\v2\x15.test.v1.SharedFolderH\x00R\x06folder\x12\x12\n" +
+	"\x03url\x18\x03 \x01(\tH\x00R\x03urlB\x0e\n" +
+	"\x06target\x12\x04\xe0\xc1\x01\x01\"B\n" +
+	"\x11BatchShareRequest\x12-\n" +
+	"\x06shares\x18\x01 \x03(\v2\x15.test.v1.ShareRequestR\x06shares\"[\n" +
+	"\x10LinkShareRequest\x12)\n" +
+	"\x04link\x18\x01 \x01(\v2\x13.test.v1.SharedLinkH\x00R\x04link\x12\x12\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03urlB\b\n" +
+	"\x06target\"o\n" +
+	"\x12DeniedShareRequest\x12;\n" +
+	"\bdocument\x18\x01 \x01(\v2\x17.test.v1.SharedDocumentB\x04\xe0\xc1\x01\x03H\x00R\bdocument\x12\x12\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03urlB\b\n" +
+	"\x06target2\xf2\x02\n" +
+	"\fShareService\x12<\n" +
+	"\x05Share\x12\x15.test.v1.ShareRequest\x1a\x11.test.v1.Response\"\t»\x01\x05share\x12L\n" +
+	"\rShareRequired\x12\x1d.test.v1.RequiredShareRequest\x1a\x11.test.v1.Response\"\t»\x01\x05share\x12F\n" +
+	"\n" +
+	"BatchShare\x12\x1a.test.v1.BatchShareRequest\x1a\x11.test.v1.Response\"\t»\x01\x05share\x12D\n" +
+	"\tShareLink\x12\x19.test.v1.LinkShareRequest\x1a\x11.test.v1.Response\"\t»\x01\x05share\x12H\n" +
+	"\vShareDenied\x12\x1b.test.v1.DeniedShareRequest\x1a\x11.test.v1.Response\"\t»\x01\x05shareB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_oneof_resources_proto_rawDescOnce sync.Once
+	file_test_v1_oneof_resources_proto_rawDescData []byte
+)
+
+func file_test_v1_oneof_resources_proto_rawDescGZIP() []byte {
+	file_test_v1_oneof_resources_proto_rawDescOnce.Do(func() {
+		file_test_v1_oneof_resources_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_oneof_resources_proto_rawDesc), len(file_test_v1_oneof_resources_proto_rawDesc)))
+	})
+	return file_test_v1_oneof_resources_proto_rawDescData
+}
+
+var file_test_v1_oneof_resources_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_test_v1_oneof_resources_proto_goTypes = []any{
+	(*SharedDocument)(nil),       // 0: test.v1.SharedDocument
+	(*SharedFolder)(nil),         // 1: test.v1.SharedFolder
+	(*SharedLink)(nil),           // 2: test.v1.SharedLink
+	(*ShareRequest)(nil),         // 3: test.v1.ShareRequest
+	(*RequiredShareRequest)(nil), // 4: test.v1.RequiredShareRequest
+	(*BatchShareRequest)(nil),    // 5: test.v1.BatchShareRequest
+	(*LinkShareRequest)(nil),     // 6: test.v1.LinkShareRequest
+	(*DeniedShareRequest)(nil),   // 7: test.v1.DeniedShareRequest
+	(*Response)(nil),             // 8: test.v1.Response
+}
+var file_test_v1_oneof_resources_proto_depIdxs = []int32{
+	0,  // 0: test.v1.ShareRequest.document:type_name -> test.v1.SharedDocument
+	1,  // 1: test.v1.ShareRequest.folder:type_name -> test.v1.SharedFolder
+	0,  // 2: test.v1.RequiredShareRequest.document:type_name -> test.v1.SharedDocument
+	1,  // 3: test.v1.RequiredShareRequest.folder:type_name -> test.v1.SharedFolder
+	3,  // 4: test.v1.BatchShareRequest.shares:type_name -> test.v1.ShareRequest
+	2,  // 5: test.v1.LinkShareRequest.link:type_name -> test.v1.SharedLink
+	0,  // 6: test.v1.DeniedShareRequest.document:type_name -> test.v1.SharedDocument
+	3,  // 7: test.v1.ShareService.Share:input_type -> test.v1.ShareRequest
+	4,  // 8: test.v1.ShareService.ShareRequired:input_type -> test.v1.RequiredShareRequest
+	5,  // 9: test.v1.ShareService.BatchShare:input_type -> test.v1.BatchShareRequest
+	6,  // 10: test.v1.ShareService.ShareLink:input_type -> test.v1.LinkShareRequest
+	7,  // 11: test.v1.ShareService.ShareDenied:input_type -> test.v1.DeniedShareRequest
+	8,  // 12: test.v1.ShareService.Share:output_type -> test.v1.Response
+	8,  // 13: test.v1.ShareService.ShareRequired:output_type -> test.v1.Response
+	8,  // 14: test.v1.ShareService.BatchShare:output_type -> test.v1.Response
+	8,  // 15: test.v1.ShareService.ShareLink:output_type -> test.v1.Response
+	8,  // 16: test.v1.ShareService.ShareDenied:output_type -> test.v1.Response
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_oneof_resources_proto_init() }
+func file_test_v1_oneof_resources_proto_init() {
+	if File_test_v1_oneof_resources_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_oneof_resources_proto_msgTypes[3].OneofWrappers = []any{
+		(*ShareRequest_Document)(nil),
+		(*ShareRequest_Folder)(nil),
+	}
+	file_test_v1_oneof_resources_proto_msgTypes[4].OneofWrappers = []any{
+		(*RequiredShareRequest_Document)(nil),
+		(*RequiredShareRequest_Folder)(nil),
+		(*RequiredShareRequest_Url)(nil),
+	}
+	file_test_v1_oneof_resources_proto_msgTypes[6].OneofWrappers = []any{
+		(*LinkShareRequest_Link)(nil),
+		(*LinkShareRequest_Url)(nil),
+	}
+	file_test_v1_oneof_resources_proto_msgTypes[7].OneofWrappers = []any{
+		(*DeniedShareRequest_Document)(nil),
+		(*DeniedShareRequest_Url)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_oneof_resources_proto_rawDesc), len(file_test_v1_oneof_resources_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_oneof_resources_proto_goTypes,
+		DependencyIndexes: file_test_v1_oneof_resources_proto_depIdxs,
+		MessageInfos:      file_test_v1_oneof_resources_proto_msgTypes,
+	}.Build()
+	File_test_v1_oneof_resources_proto = out.File
+	file_test_v1_oneof_resources_proto_goTypes = nil
+	file_test_v1_oneof_resources_proto_depIdxs = nil
+}
@@ -0,0 +1,303 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+func (req *ShareRequest) GetChecks() pkg.CheckConfig {
+	permission := "share"
+	checks := make([]pkg.Check, 0, 1)
+	switch v1 := req.Target.(type) {
+	case *ShareRequest_Document:
+		resource := v1.Document
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Document",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	case *ShareRequest_Folder:
+		resource := v1.Folder
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Folder",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	default:
+		{
+			var id string
+			tenantId := "default"
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Document",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		}
+		{
+			var id string
+			tenantId := "default"
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Folder",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *RequiredShareRequest) GetChecks() pkg.CheckConfig {
+	permission := "share"
+	checks := make([]pkg.Check, 0, 1)
+	switch v2 := req.Target.(type) {
+	case *RequiredShareRequest_Document:
+		resource := v2.Document
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Document",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	case *RequiredShareRequest_Folder:
+		resource := v2.Folder
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Folder",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	case nil:
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "oneof target is not set",
+						},
+					},
+				},
+			},
+		}
+	default:
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "oneof target holds no resource",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *BatchShareRequest) GetChecks() pkg.CheckConfig {
+	permission := "share"
+	checks := make([]pkg.Check, 0, len(req.Shares))
+	for _, v3 := range req.Shares {
+		switch v4 := v3.Target.(type) {
+		case *ShareRequest_Document:
+			resource := v4.Document
+			var id string
+			if resource.Id != "" {
+				id = resource.Id
+			}
+			tenantId := "default"
+			if resource.TenantId != "" {
+				tenantId = resource.TenantId
+			}
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Document",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		case *ShareRequest_Folder:
+			resource := v4.Folder
+			var id string
+			if resource.Id != "" {
+				id = resource.Id
+			}
+			tenantId := "default"
+			check := pkg.Check{
+				TenantID:   tenantId,
+				Permission: permission,
+				Entity: &pkg.Resource{
+					Type: "Folder",
+					ID:   id,
+				},
+			}
+			checks = append(checks, check)
+		default:
+			{
+				var id string
+				tenantId := "default"
+				check := pkg.Check{
+					TenantID:   tenantId,
+					Permission: permission,
+					Entity: &pkg.Resource{
+						Type: "Document",
+						ID:   id,
+					},
+				}
+				checks = append(checks, check)
+			}
+			{
+				var id string
+				tenantId := "default"
+				check := pkg.Check{
+					TenantID:   tenantId,
+					Permission: permission,
+					Entity: &pkg.Resource{
+						Type: "Folder",
+						ID:   id,
+					},
+				}
+				checks = append(checks, check)
+			}
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *LinkShareRequest) GetChecks() pkg.CheckConfig {
+	permission := "share"
+	checks := make([]pkg.Check, 0, 1)
+	switch req.Target.(type) {
+	case *LinkShareRequest_Link:
+		var id string
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Link",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	default:
+		var id string
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Link",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *DeniedShareRequest) GetChecks() pkg.CheckConfig {
+	permission := "share"
+	checks := make([]pkg.Check, 0, 1)
+	switch v5 := req.Target.(type) {
+	case *DeniedShareRequest_Document:
+		resource := v5.Document
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Document",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	default:
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "oneof target holds no resource",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
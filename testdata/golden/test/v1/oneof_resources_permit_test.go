@@ -0,0 +1,51 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkShareServiceShareGetChecks(b *testing.B) {
+	req := &ShareRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkShareServiceShareRequiredGetChecks(b *testing.B) {
+	req := &RequiredShareRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkShareServiceBatchShareGetChecks(b *testing.B) {
+	req := &BatchShareRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkShareServiceShareLinkGetChecks(b *testing.B) {
+	req := &LinkShareRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkShareServiceShareDeniedGetChecks(b *testing.B) {
+	req := &DeniedShareRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
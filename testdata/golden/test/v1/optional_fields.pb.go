@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/optional_fields.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OptionalMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         *string                `protobuf:"bytes,1,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionalMetadata) Reset() {
+	*x = OptionalMetadata{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionalMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalMetadata) ProtoMessage() {}
+
+func (x *OptionalMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalMetadata.ProtoReflect.Descriptor instead.
+func (*OptionalMetadata) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OptionalMetadata) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *OptionalMetadata) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type OptionalDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	TenantId      *int64                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Metadata      *OptionalMetadata      `protobuf:"bytes,4,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionalDocument) Reset() {
+	*x = OptionalDocument{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionalDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalDocument) ProtoMessage() {}
+
+func (x *OptionalDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalDocument.ProtoReflect.Descriptor instead.
+func (*OptionalDocument) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OptionalDocument) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *OptionalDocument) GetTenantId() int64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *OptionalDocument) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *OptionalDocument) GetMetadata() *OptionalMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type OptionalRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        *int32                 `protobuf:"varint,1,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionalRevision) Reset() {
+	*x = OptionalRevision{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionalRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalRevision) ProtoMessage() {}
+
+func (x *OptionalRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalRevision.ProtoReflect.Descriptor instead.
+func (*OptionalRevision) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OptionalRevision) GetNumber() int32 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+type OptionalHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revisions     []*OptionalRevision    `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionalHistory) Reset() {
+	*x = OptionalHistory{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionalHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalHistory) ProtoMessage() {}
+
+func (x *OptionalHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalHistory.ProtoReflect.Descriptor instead.
+func (*OptionalHistory) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OptionalHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OptionalHistory) GetRevisions() []*OptionalRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type OptionalResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *OptionalDocument      `protobuf:"bytes,1,opt,name=document,proto3,oneof" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionalResourceRequest) Reset() {
+	*x = OptionalResourceRequest{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalResourceRequest) ProtoMessage() {}
+
+func (x *OptionalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalResourceRequest.ProtoReflect.Descriptor instead.
+func (*OptionalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OptionalResourceRequest) GetDocument() *OptionalDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type SkipMissingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *OptionalDocument      `protobuf:"bytes,1,opt,name=document,proto3,oneof" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipMissingRequest) Reset() {
+	*x = SkipMissingRequest{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipMissingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipMissingRequest) ProtoMessage() {}
+
+func (x *SkipMissingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipMissingRequest.ProtoReflect.Descriptor instead.
+func (*SkipMissingRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SkipMissingRequest) GetDocument() *OptionalDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type DenyMissingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *OptionalDocument      `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyMissingRequest) Reset() {
+	*x = DenyMissingRequest{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyMissingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyMissingRequest) ProtoMessage() {}
+
+func (x *DenyMissingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyMissingRequest.ProtoReflect.Descriptor instead.
+func (*DenyMissingRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DenyMissingRequest) GetDocument() *OptionalDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type OptionalContainer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *OptionalDocument      `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionalContainer) Reset() {
+	*x = OptionalContainer{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionalContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalContainer) ProtoMessage() {}
+
+func (x *OptionalContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalContainer.ProtoReflect.Descriptor instead.
+func (*OptionalContainer) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OptionalContainer) GetDocument() *OptionalDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type NestedOptionalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Container     *OptionalContainer     `protobuf:"bytes,1,opt,name=container,proto3,oneof" json:"container,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedOptionalRequest) Reset() {
+	*x = NestedOptionalRequest{}
+	mi := &file_test_v1_optional_fields_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedOptionalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedOptionalRequest) ProtoMessage() {}
+
+func (x *NestedOptionalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_optional_fields_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedOptionalRequest.ProtoReflect.Descriptor instead.
+func (*NestedOptionalRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_optional_fields_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NestedOptionalRequest) GetContainer() *OptionalContainer {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+var File_test_v1_optional_fields_proto protoreflect.FileDescriptor
+
+const file_test_v1_optional_fields_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtest/v1/optional_fields.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"f\n" +
+	"\x10OptionalMetadata\x12$\n" +
+	"\x05owner\x18\x01 \x01(\tB\tһ\x01\x05ownerH\x00R\x05owner\x88\x01\x01\x12\"\n" +
+	"\x06region\x18\x02 \x01(\tB\n" +
+	"һ\x01\x06regionR\x06regionB\b\n" +
+	"\x06_owner\"\xf5\x01\n" +
+	"\x10OptionalDocument\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01H\x00R\x02id\x88\x01\x01\x12&\n" +
+	"\ttenant_id\x18\x02 \x01(\x03B\x04Ȼ\x01\x01H\x01R\btenantId\x88\x01\x01\x12'\n" +
+	"\x06status\x18\x03 \x01(\tB\n" +
+	"һ\x01\x06statusH\x02R\x06status\x88\x01\x01\x12:\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x19.test.v1.OptionalMetadataH\x03R\bmetadata\x88\x01\x01:\f»\x01\bDocumentB\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_tenant_idB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_metadata\"I\n" +
+	"\x10OptionalRevision\x12*\n" +
+	"\x06number\x18\x01 \x01(\x05B\rһ\x01\trevisionsH\x00R\x06number\x88\x01\x01B\t\n" +
+	"\a_number\"m\n" +
+	"\x0fOptionalHistory\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x127\n" +
+	"\trevisions\x18\x02 \x03(\v2\x19.test.v1.OptionalRevisionR\trevisions:\v»\x01\aHistory\"b\n" +
+	"\x17OptionalResourceRequest\x12:\n" +
+	"\bdocument\x18\x01 \x01(\v2\x19.test.v1.OptionalDocumentH\x00R\bdocument\x88\x01\x01B\v\n" +
+	"\t_document\"c\n" +
+	"\x12SkipMissingRequest\x12@\n" +
+	"\bdocument\x18\x01 \x01(\v2\x19.test.v1.OptionalDocumentB\x04\xe0\xc1\x01\x02H\x00R\bdocument\x88\x01\x01B\v\n" +
+	"\t_document\"Q\n" +
+	"\x12DenyMissingRequest\x12;\n" +
+	"\bdocument\x18\x01 \x01(\v2\x19.test.v1.OptionalDocumentB\x04\xe0\xc1\x01\x03R\bdocument\"J\n" +
+	"\x11OptionalContainer\x125\n" +
+	"\bdocument\x18\x01 \x01(\v2\x19.test.v1.OptionalDocumentR\bdocument\"d\n" +
+	"\x15NestedOptionalRequest\x12=\n" +
+	"\tcontainer\x18\x01 \x01(\v2\x1a.test.v1.OptionalContainerH\x00R\tcontainer\x88\x01\x01B\f\n" +
+	"\n" +
+	"_container2\x9e\x03\n" +
+	"\x14OptionalFieldService\x12L\n" +
+	"\vGetDocument\x12 .test.v1.OptionalResourceRequest\x1a\x11.test.v1.Response\"\b»\x01\x04read\x12P\n" +
+	"\x14GetDocumentIfPresent\x12\x1b.test.v1.SkipMissingRequest\x1a\x11.test.v1.Response\"\b»\x01\x04read\x12O\n" +
+	"\x13GetRequiredDocument\x12\x1b.test.v1.DenyMissingRequest\x1a\x11.test.v1.Response\"\b»\x01\x04read\x12P\n" +
+	"\x11GetNestedDocument\x12\x1e.test.v1.NestedOptionalRequest\x1a\x11.test.v1.Response\"\b»\x01\x04read\x12C\n" +
+	"\n" +
+	"GetHistory\x12\x18.test.v1.OptionalHistory\x1a\x11.test.v1.Response\"\b»\x01\x04readB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_optional_fields_proto_rawDescOnce sync.Once
+	file_test_v1_optional_fields_proto_rawDescData []byte
+)
+
+func file_test_v1_optional_fields_proto_rawDescGZIP() []byte {
+	file_test_v1_optional_fields_proto_rawDescOnce.Do(func() {
+		file_test_v1_optional_fields_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_optional_fields_proto_rawDesc), len(file_test_v1_optional_fields_proto_rawDesc)))
+	})
+	return file_test_v1_optional_fields_proto_rawDescData
+}
+
+var file_test_v1_optional_fields_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_test_v1_optional_fields_proto_goTypes = []any{
+	(*OptionalMetadata)(nil),        // 0: test.v1.OptionalMetadata
+	(*OptionalDocument)(nil),        // 1: test.v1.OptionalDocument
+	(*OptionalRevision)(nil),        // 2: test.v1.OptionalRevision
+	(*OptionalHistory)(nil),         // 3: test.v1.OptionalHistory
+	(*OptionalResourceRequest)(nil), // 4: test.v1.OptionalResourceRequest
+	(*SkipMissingRequest)(nil),      // 5: test.v1.SkipMissingRequest
+	(*DenyMissingRequest)(nil),      // 6: test.v1.DenyMissingRequest
+	(*OptionalContainer)(nil),       // 7: test.v1.OptionalContainer
+	(*NestedOptionalRequest)(nil),   // 8: test.v1.NestedOptionalRequest
+	(*Response)(nil),                // 9: test.v1.Response
+}
+var file_test_v1_optional_fields_proto_depIdxs = []int32{
+	0,  // 0: test.v1.OptionalDocument.metadata:type_name -> test.v1.OptionalMetadata
+	2,  // 1: test.v1.OptionalHistory.revisions:type_name -> test.v1.OptionalRevision
+	1,  // 2: test.v1.OptionalResourceRequest.document:type_name -> test.v1.OptionalDocument
+	1,  // 3: test.v1.SkipMissingRequest.document:type_name -> test.v1.OptionalDocument
+	1,  // 4: test.v1.DenyMissingRequest.document:type_name -> test.v1.OptionalDocument
+	1,  // 5: test.v1.OptionalContainer.document:type_name -> test.v1.OptionalDocument
+	7,  // 6: test.v1.NestedOptionalRequest.container:type_name -> test.v1.OptionalContainer
+	4,  // 7: test.v1.OptionalFieldService.GetDocument:input_type -> test.v1.OptionalResourceRequest
+	5,  // 8: test.v1.OptionalFieldService.GetDocumentIfPresent:input_type -> test.v1.SkipMissingRequest
+	6,  // 9: test.v1.OptionalFieldService.GetRequiredDocument:input_type -> test.v1.DenyMissingRequest
+	8,  // 10: test.v1.OptionalFieldService.GetNestedDocument:input_type -> test.v1.NestedOptionalRequest
+	3,  // 11: test.v1.OptionalFieldService.GetHistory:input_type -> test.v1.OptionalHistory
+	9,  // 12: test.v1.OptionalFieldService.GetDocument:output_type -> test.v1.Response
+	9,  // 13: test.v1.OptionalFieldService.GetDocumentIfPresent:output_type -> test.v1.Response
+	9,  // 14: test.v1.OptionalFieldService.GetRequiredDocument:output_type -> test.v1.Response
+	9,  // 15: test.v1.OptionalFieldService.GetNestedDocument:output_type -> test.v1.Response
+	9,  // 16: test.v1.OptionalFieldService.GetHistory:output_type -> test.v1.Response
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_optional_fields_proto_init() }
+func file_test_v1_optional_fields_proto_init() {
+	if File_test_v1_optional_fields_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_optional_fields_proto_msgTypes[0].OneofWrappers = []any{}
+	file_test_v1_optional_fields_proto_msgTypes[1].OneofWrappers = []any{}
+	file_test_v1_optional_fields_proto_msgTypes[2].OneofWrappers = []any{}
+	file_test_v1_optional_fields_proto_msgTypes[4].OneofWrappers = []any{}
+	file_test_v1_optional_fields_proto_msgTypes[5].OneofWrappers = []any{}
+	file_test_v1_optional_fields_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_optional_fields_proto_rawDesc), len(file_test_v1_optional_fields_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_optional_fields_proto_goTypes,
+		DependencyIndexes: file_test_v1_optional_fields_proto_depIdxs,
+		MessageInfos:      file_test_v1_optional_fields_proto_msgTypes,
+	}.Build()
+	File_test_v1_optional_fields_proto = out.File
+	file_test_v1_optional_fields_proto_goTypes = nil
+	file_test_v1_optional_fields_proto_depIdxs = nil
+}
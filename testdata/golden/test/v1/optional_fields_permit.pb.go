@@ -0,0 +1,237 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	strconv "strconv"
+)
+
+func (req *OptionalResourceRequest) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	if req.Document != nil {
+		resource := req.Document
+		var id string
+		if resource.Id != nil {
+			id = *resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != nil {
+			tenantId = strconv.FormatInt(*resource.TenantId, 10)
+		}
+		attributes := make(map[string]any, 3)
+		if resource.Metadata != nil && resource.Metadata.Owner != nil {
+			attributes["owner"] = *resource.Metadata.Owner
+		}
+		if resource.Metadata != nil {
+			attributes["region"] = resource.Metadata.Region
+		}
+		if resource.Status != nil {
+			attributes["status"] = *resource.Status
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type:       "Document",
+				ID:         id,
+				Attributes: attributes,
+			},
+		}
+		checks = append(checks, check)
+	} else {
+		var id string
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Document",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *SkipMissingRequest) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	if req.Document != nil {
+		resource := req.Document
+		var id string
+		if resource.Id != nil {
+			id = *resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != nil {
+			tenantId = strconv.FormatInt(*resource.TenantId, 10)
+		}
+		attributes := make(map[string]any, 3)
+		if resource.Metadata != nil && resource.Metadata.Owner != nil {
+			attributes["owner"] = *resource.Metadata.Owner
+		}
+		if resource.Metadata != nil {
+			attributes["region"] = resource.Metadata.Region
+		}
+		if resource.Status != nil {
+			attributes["status"] = *resource.Status
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type:       "Document",
+				ID:         id,
+				Attributes: attributes,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *DenyMissingRequest) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	if req.Document == nil {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field document is not set",
+						},
+					},
+				},
+			},
+		}
+	}
+	resource := req.Document
+	var id string
+	if resource.Id != nil {
+		id = *resource.Id
+	}
+	tenantId := "default"
+	if resource.TenantId != nil {
+		tenantId = strconv.FormatInt(*resource.TenantId, 10)
+	}
+	attributes := make(map[string]any, 3)
+	if resource.Metadata != nil && resource.Metadata.Owner != nil {
+		attributes["owner"] = *resource.Metadata.Owner
+	}
+	if resource.Metadata != nil {
+		attributes["region"] = resource.Metadata.Region
+	}
+	if resource.Status != nil {
+		attributes["status"] = *resource.Status
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type:       "Document",
+			ID:         id,
+			Attributes: attributes,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *NestedOptionalRequest) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	if req.Container != nil && req.Container.Document != nil {
+		resource := req.Container.Document
+		var id string
+		if resource.Id != nil {
+			id = *resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != nil {
+			tenantId = strconv.FormatInt(*resource.TenantId, 10)
+		}
+		attributes := make(map[string]any, 3)
+		if resource.Metadata != nil && resource.Metadata.Owner != nil {
+			attributes["owner"] = *resource.Metadata.Owner
+		}
+		if resource.Metadata != nil {
+			attributes["region"] = resource.Metadata.Region
+		}
+		if resource.Status != nil {
+			attributes["status"] = *resource.Status
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type:       "Document",
+				ID:         id,
+				Attributes: attributes,
+			},
+		}
+		checks = append(checks, check)
+	} else {
+		var id string
+		tenantId := "default"
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Document",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *OptionalHistory) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	attributes := make(map[string]any, 1)
+	revisionsValues := make([]int32, 0, len(resource.Revisions))
+	for _, v1 := range resource.Revisions {
+		if v1.Number != nil {
+			revisionsValues = append(revisionsValues, *v1.Number)
+		}
+	}
+	if len(revisionsValues) > 0 {
+		attributes["revisions"] = revisionsValues
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type:       "History",
+			ID:         id,
+			Attributes: attributes,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
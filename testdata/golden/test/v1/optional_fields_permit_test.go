@@ -0,0 +1,51 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkOptionalFieldServiceGetDocumentGetChecks(b *testing.B) {
+	req := &OptionalResourceRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkOptionalFieldServiceGetDocumentIfPresentGetChecks(b *testing.B) {
+	req := &SkipMissingRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkOptionalFieldServiceGetRequiredDocumentGetChecks(b *testing.B) {
+	req := &DenyMissingRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkOptionalFieldServiceGetNestedDocumentGetChecks(b *testing.B) {
+	req := &NestedOptionalRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkOptionalFieldServiceGetHistoryGetChecks(b *testing.B) {
+	req := &OptionalHistory{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/parent_resources.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NewPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewPage) Reset() {
+	*x = NewPage{}
+	mi := &file_test_v1_parent_resources_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPage) ProtoMessage() {}
+
+func (x *NewPage) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_parent_resources_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPage.ProtoReflect.Descriptor instead.
+func (*NewPage) Descriptor() ([]byte, []int) {
+	return file_test_v1_parent_resources_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NewPage) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *NewPage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type CreatePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *NewPage               `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePageRequest) Reset() {
+	*x = CreatePageRequest{}
+	mi := &file_test_v1_parent_resources_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePageRequest) ProtoMessage() {}
+
+func (x *CreatePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_parent_resources_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePageRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_parent_resources_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePageRequest) GetPage() *NewPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type PageRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRef) Reset() {
+	*x = PageRef{}
+	mi := &file_test_v1_parent_resources_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRef) ProtoMessage() {}
+
+func (x *PageRef) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_parent_resources_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRef.ProtoReflect.Descriptor instead.
+func (*PageRef) Descriptor() ([]byte, []int) {
+	return file_test_v1_parent_resources_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PageRef) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type Page struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Parent        *PageRef               `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	mi := &file_test_v1_parent_resources_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_parent_resources_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_test_v1_parent_resources_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Page) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Page) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Page) GetParent() *PageRef {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+type UpsertPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *Page                  `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertPageRequest) Reset() {
+	*x = UpsertPageRequest{}
+	mi := &file_test_v1_parent_resources_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPageRequest) ProtoMessage() {}
+
+func (x *UpsertPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_parent_resources_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPageRequest.ProtoReflect.Descriptor instead.
+func (*UpsertPageRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_parent_resources_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpsertPageRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type MovedPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DestinationId int64                  `protobuf:"varint,2,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovedPage) Reset() {
+	*x = MovedPage{}
+	mi := &file_test_v1_parent_resources_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovedPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovedPage) ProtoMessage() {}
+
+func (x *MovedPage) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_parent_resources_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovedPage.ProtoReflect.Descriptor instead.
+func (*MovedPage) Descriptor() ([]byte, []int) {
+	return file_test_v1_parent_resources_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MovedPage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MovedPage) GetDestinationId() int64 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+type MovePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *MovedPage             `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovePageRequest) Reset() {
+	*x = MovePageRequest{}
+	mi := &file_test_v1_parent_resources_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePageRequest) ProtoMessage() {}
+
+func (x *MovePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_parent_resources_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePageRequest.ProtoReflect.Descriptor instead.
+func (*MovePageRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_parent_resources_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MovePageRequest) GetPage() *MovedPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_test_v1_parent_resources_proto protoreflect.FileDescriptor
+
+const file_test_v1_parent_resources_proto_rawDesc = "" +
+	"\n" +
+	"\x1etest/v1/parent_resources.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"]\n" +
+	"\aNewPage\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title:\x1f»\x01\x04Page\xe2\xc1\x01\x13\n" +
+	"\x06Folder\x12\tfolder_id\"9\n" +
+	"\x11CreatePageRequest\x12$\n" +
+	"\x04page\x18\x01 \x01(\v2\x10.test.v1.NewPageR\x04page\"&\n" +
+	"\aPageRef\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\tR\bfolderId\"\x99\x01\n" +
+	"\x04Page\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x12(\n" +
+	"\x06parent\x18\x03 \x01(\v2\x10.test.v1.PageRefR\x06parent:.»\x01\x04Page\xe2\xc1\x01\"\n" +
+	"\x06Folder\x12\x10parent.folder_id\x1a\x06create\"6\n" +
+	"\x11UpsertPageRequest\x12!\n" +
+	"\x04page\x18\x01 \x01(\v2\r.test.v1.PageR\x04page\"v\n" +
+	"\tMovedPage\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12%\n" +
+	"\x0edestination_id\x18\x02 \x01(\x03R\rdestinationId:,»\x01\x04Page\xe2\xc1\x01 \n" +
+	"\x06Folder\x12\x0edestination_id\x1a\x04edit \x01\"9\n" +
+	"\x0fMovePageRequest\x12&\n" +
+	"\x04page\x18\x01 \x01(\v2\x12.test.v1.MovedPageR\x04page2\xda\x01\n" +
+	"\x05Pages\x12G\n" +
+	"\n" +
+	"CreatePage\x12\x1a.test.v1.CreatePageRequest\x1a\x11.test.v1.Response\"\n" +
+	"»\x01\x06create\x12E\n" +
+	"\n" +
+	"UpsertPage\x12\x1a.test.v1.UpsertPageRequest\x1a\x11.test.v1.Response\"\b»\x01\x04edit\x12A\n" +
+	"\bMovePage\x12\x18.test.v1.MovePageRequest\x1a\x11.test.v1.Response\"\b»\x01\x04moveB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_parent_resources_proto_rawDescOnce sync.Once
+	file_test_v1_parent_resources_proto_rawDescData []byte
+)
+
+func file_test_v1_parent_resources_proto_rawDescGZIP() []byte {
+	file_test_v1_parent_resources_proto_rawDescOnce.Do(func() {
+		file_test_v1_parent_resources_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_parent_resources_proto_rawDesc), len(file_test_v1_parent_resources_proto_rawDesc)))
+	})
+	return file_test_v1_parent_resources_proto_rawDescData
+}
+
+var file_test_v1_parent_resources_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_test_v1_parent_resources_proto_goTypes = []any{
+	(*NewPage)(nil),           // 0: test.v1.NewPage
+	(*CreatePageRequest)(nil), // 1: test.v1.CreatePageRequest
+	(*PageRef)(nil),           // 2: test.v1.PageRef
+	(*Page)(nil),              // 3: test.v1.Page
+	(*UpsertPageRequest)(nil), // 4: test.v1.UpsertPageRequest
+	(*MovedPage)(nil),         // 5: test.v1.MovedPage
+	(*MovePageRequest)(nil),   // 6: test.v1.MovePageRequest
+	(*Response)(nil),          // 7: test.v1.Response
+}
+var file_test_v1_parent_resources_proto_depIdxs = []int32{
+	0, // 0: test.v1.CreatePageRequest.page:type_name -> test.v1.NewPage
+	2, // 1: test.v1.Page.parent:type_name -> test.v1.PageRef
+	3, // 2: test.v1.UpsertPageRequest.page:type_name -> test.v1.Page
+	5, // 3: test.v1.MovePageRequest.page:type_name -> test.v1.MovedPage
+	1, // 4: test.v1.Pages.CreatePage:input_type -> test.v1.CreatePageRequest
+	4, // 5: test.v1.Pages.UpsertPage:input_type -> test.v1.UpsertPageRequest
+	6, // 6: test.v1.Pages.MovePage:input_type -> test.v1.MovePageRequest
+	7, // 7: test.v1.Pages.CreatePage:output_type -> test.v1.Response
+	7, // 8: test.v1.Pages.UpsertPage:output_type -> test.v1.Response
+	7, // 9: test.v1.Pages.MovePage:output_type -> test.v1.Response
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_parent_resources_proto_init() }
+func file_test_v1_parent_resources_proto_init() {
+	if File_test_v1_parent_resources_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_parent_resources_proto_rawDesc), len(file_test_v1_parent_resources_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_parent_resources_proto_goTypes,
+		DependencyIndexes: file_test_v1_parent_resources_proto_depIdxs,
+		MessageInfos:      file_test_v1_parent_resources_proto_msgTypes,
+	}.Build()
+	File_test_v1_parent_resources_proto = out.File
+	file_test_v1_parent_resources_proto_goTypes = nil
+	file_test_v1_parent_resources_proto_depIdxs = nil
+}
@@ -0,0 +1,107 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	strconv "strconv"
+)
+
+func (req *CreatePageRequest) GetChecks() pkg.CheckConfig {
+	permission := "create"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Page
+	tenantId := "default"
+	var parentId string
+	if resource.FolderId != "" {
+		parentId = resource.FolderId
+	}
+	checks = append(checks, pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Folder",
+			ID:   parentId,
+		},
+	})
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *UpsertPageRequest) GetChecks() pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Page
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	if resource.TenantId != "" {
+		tenantId = resource.TenantId
+	}
+	if id == "" {
+		var parentId string
+		if resource.Parent != nil && resource.Parent.FolderId != "" {
+			parentId = resource.Parent.FolderId
+		}
+		checks = append(checks, pkg.Check{
+			TenantID:   tenantId,
+			Permission: "create",
+			Entity: &pkg.Resource{
+				Type: "Folder",
+				ID:   parentId,
+			},
+		})
+	} else {
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Page",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *MovePageRequest) GetChecks() pkg.CheckConfig {
+	permission := "move"
+	checks := make([]pkg.Check, 0, 2)
+	resource := req.Page
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Page",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	var parentId string
+	if resource.DestinationId != 0 {
+		parentId = strconv.FormatInt(resource.DestinationId, 10)
+	}
+	checks = append(checks, pkg.Check{
+		TenantID:   tenantId,
+		Permission: "edit",
+		Entity: &pkg.Resource{
+			Type: "Folder",
+			ID:   parentId,
+		},
+	})
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
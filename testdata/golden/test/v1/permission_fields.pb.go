@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/permission_fields.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Operation int32
+
+const (
+	Operation_OPERATION_UNSPECIFIED Operation = 0
+	Operation_OPERATION_READ        Operation = 1
+	Operation_OPERATION_WRITE       Operation = 2
+	Operation_OPERATION_PURGE       Operation = 3
+)
+
+// Enum value maps for Operation.
+var (
+	Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_READ",
+		2: "OPERATION_WRITE",
+		3: "OPERATION_PURGE",
+	}
+	Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_READ":        1,
+		"OPERATION_WRITE":       2,
+		"OPERATION_PURGE":       3,
+	}
+)
+
+func (x Operation) Enum() *Operation {
+	p := new(Operation)
+	*p = x
+	return p
+}
+
+func (x Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_v1_permission_fields_proto_enumTypes[0].Descriptor()
+}
+
+func (Operation) Type() protoreflect.EnumType {
+	return &file_test_v1_permission_fields_proto_enumTypes[0]
+}
+
+func (x Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation.Descriptor instead.
+func (Operation) EnumDescriptor() ([]byte, []int) {
+	return file_test_v1_permission_fields_proto_rawDescGZIP(), []int{0}
+}
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_test_v1_permission_fields_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_permission_fields_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_test_v1_permission_fields_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AccessRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *Record                `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Operation     Operation              `protobuf:"varint,2,opt,name=operation,proto3,enum=test.v1.Operation" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRecordRequest) Reset() {
+	*x = AccessRecordRequest{}
+	mi := &file_test_v1_permission_fields_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRecordRequest) ProtoMessage() {}
+
+func (x *AccessRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_permission_fields_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRecordRequest.ProtoReflect.Descriptor instead.
+func (*AccessRecordRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_permission_fields_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccessRecordRequest) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *AccessRecordRequest) GetOperation() Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return Operation_OPERATION_UNSPECIFIED
+}
+
+type RecordAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAction) Reset() {
+	*x = RecordAction{}
+	mi := &file_test_v1_permission_fields_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAction) ProtoMessage() {}
+
+func (x *RecordAction) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_permission_fields_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAction.ProtoReflect.Descriptor instead.
+func (*RecordAction) Descriptor() ([]byte, []int) {
+	return file_test_v1_permission_fields_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecordAction) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ActOnRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *Record                `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Action        *RecordAction          `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActOnRecordRequest) Reset() {
+	*x = ActOnRecordRequest{}
+	mi := &file_test_v1_permission_fields_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActOnRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActOnRecordRequest) ProtoMessage() {}
+
+func (x *ActOnRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_permission_fields_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActOnRecordRequest.ProtoReflect.Descriptor instead.
+func (*ActOnRecordRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_permission_fields_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActOnRecordRequest) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ActOnRecordRequest) GetAction() *RecordAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type InvokeRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *Record                `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Permission    string                 `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeRecordRequest) Reset() {
+	*x = InvokeRecordRequest{}
+	mi := &file_test_v1_permission_fields_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeRecordRequest) ProtoMessage() {}
+
+func (x *InvokeRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_permission_fields_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeRecordRequest.ProtoReflect.Descriptor instead.
+func (*InvokeRecordRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_permission_fields_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InvokeRecordRequest) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *InvokeRecordRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+var File_test_v1_permission_fields_proto protoreflect.FileDescriptor
+
+const file_test_v1_permission_fields_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftest/v1/permission_fields.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"*\n" +
+	"\x06Record\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\n" +
+	"»\x01\x06Record\"\xa7\x01\n" +
+	"\x13AccessRecordRequest\x12'\n" +
+	"\x06record\x18\x01 \x01(\v2\x0f.test.v1.RecordR\x06record\x12g\n" +
+	"\toperation\x18\x02 \x01(\x0e2\x12.test.v1.OperationB5\xaa\xc2\x011\n" +
+	"\x16\n" +
+	"\x0eOPERATION_READ\x12\x04view\n" +
+	"\x17\n" +
+	"\x0fOPERATION_WRITE\x12\x04editR\toperation\"W\n" +
+	"\fRecordAction\x12>\n" +
+	"\x04name\x18\x01 \x01(\tB%\xaa\xc2\x01!\n" +
+	"\x0f\n" +
+	"\aarchive\x12\x04edit\n" +
+	"\x0e\n" +
+	"\x05share\x12\x05shareH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"l\n" +
+	"\x12ActOnRecordRequest\x12'\n" +
+	"\x06record\x18\x01 \x01(\v2\x0f.test.v1.RecordR\x06record\x12-\n" +
+	"\x06action\x18\x02 \x01(\v2\x15.test.v1.RecordActionR\x06action\"d\n" +
+	"\x13InvokeRecordRequest\x12'\n" +
+	"\x06record\x18\x01 \x01(\v2\x0f.test.v1.RecordR\x06record\x12$\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tB\x04\xaa\xc2\x01\x00R\n" +
+	"permission*d\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eOPERATION_READ\x10\x01\x12\x13\n" +
+	"\x0fOPERATION_WRITE\x10\x02\x12\x13\n" +
+	"\x0fOPERATION_PURGE\x10\x032\xd0\x01\n" +
+	"\aRecords\x12A\n" +
+	"\fAccessRecord\x12\x1c.test.v1.AccessRecordRequest\x1a\x11.test.v1.Response\"\x00\x12?\n" +
+	"\vActOnRecord\x12\x1b.test.v1.ActOnRecordRequest\x1a\x11.test.v1.Response\"\x00\x12A\n" +
+	"\fInvokeRecord\x12\x1c.test.v1.InvokeRecordRequest\x1a\x11.test.v1.Response\"\x00B\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_permission_fields_proto_rawDescOnce sync.Once
+	file_test_v1_permission_fields_proto_rawDescData []byte
+)
+
+func file_test_v1_permission_fields_proto_rawDescGZIP() []byte {
+	file_test_v1_permission_fields_proto_rawDescOnce.Do(func() {
+		file_test_v1_permission_fields_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_permission_fields_proto_rawDesc), len(file_test_v1_permission_fields_proto_rawDesc)))
+	})
+	return file_test_v1_permission_fields_proto_rawDescData
+}
+
+var file_test_v1_permission_fields_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_v1_permission_fields_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_v1_permission_fields_proto_goTypes = []any{
+	(Operation)(0),              // 0: test.v1.Operation
+	(*Record)(nil),              // 1: test.v1.Record
+	(*AccessRecordRequest)(nil), // 2: test.v1.AccessRecordRequest
+	(*RecordAction)(nil),        // 3: test.v1.RecordAction
+	(*ActOnRecordRequest)(nil),  // 4: test.v1.ActOnRecordRequest
+	(*InvokeRecordRequest)(nil), // 5: test.v1.InvokeRecordRequest
+	(*Response)(nil),            // 6: test.v1.Response
+}
+var file_test_v1_permission_fields_proto_depIdxs = []int32{
+	1, // 0: test.v1.AccessRecordRequest.record:type_name -> test.v1.Record
+	0, // 1: test.v1.AccessRecordRequest.operation:type_name -> test.v1.Operation
+	1, // 2: test.v1.ActOnRecordRequest.record:type_name -> test.v1.Record
+	3, // 3: test.v1.ActOnRecordRequest.action:type_name -> test.v1.RecordAction
+	1, // 4: test.v1.InvokeRecordRequest.record:type_name -> test.v1.Record
+	2, // 5: test.v1.Records.AccessRecord:input_type -> test.v1.AccessRecordRequest
+	4, // 6: test.v1.Records.ActOnRecord:input_type -> test.v1.ActOnRecordRequest
+	5, // 7: test.v1.Records.InvokeRecord:input_type -> test.v1.InvokeRecordRequest
+	6, // 8: test.v1.Records.AccessRecord:output_type -> test.v1.Response
+	6, // 9: test.v1.Records.ActOnRecord:output_type -> test.v1.Response
+	6, // 10: test.v1.Records.InvokeRecord:output_type -> test.v1.Response
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_permission_fields_proto_init() }
+func file_test_v1_permission_fields_proto_init() {
+	if File_test_v1_permission_fields_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_permission_fields_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_permission_fields_proto_rawDesc), len(file_test_v1_permission_fields_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_permission_fields_proto_goTypes,
+		DependencyIndexes: file_test_v1_permission_fields_proto_depIdxs,
+		EnumInfos:         file_test_v1_permission_fields_proto_enumTypes,
+		MessageInfos:      file_test_v1_permission_fields_proto_msgTypes,
+	}.Build()
+	File_test_v1_permission_fields_proto = out.File
+	file_test_v1_permission_fields_proto_goTypes = nil
+	file_test_v1_permission_fields_proto_depIdxs = nil
+}
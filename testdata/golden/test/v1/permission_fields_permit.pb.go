@@ -0,0 +1,133 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+func (req *AccessRecordRequest) GetChecks() pkg.CheckConfig {
+	var permission string
+	switch req.Operation {
+	case Operation_OPERATION_READ:
+		permission = "view"
+	case Operation_OPERATION_WRITE:
+		permission = "edit"
+	}
+	if permission == "" {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field operation selects no permission",
+						},
+					},
+				},
+			},
+		}
+	}
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Record
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Record",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *ActOnRecordRequest) GetChecks() pkg.CheckConfig {
+	var permission string
+	if req.Action != nil && req.Action.Name != nil {
+		switch *req.Action.Name {
+		case "archive":
+			permission = "edit"
+		case "share":
+			permission = "share"
+		}
+	}
+	if permission == "" {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field action.name selects no permission",
+						},
+					},
+				},
+			},
+		}
+	}
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Record
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Record",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *InvokeRecordRequest) GetChecks() pkg.CheckConfig {
+	permission := req.Permission
+	if permission == "" {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field permission selects no permission",
+						},
+					},
+				},
+			},
+		}
+	}
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Record
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Record",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
@@ -0,0 +1,33 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkRecordsAccessRecordGetChecks(b *testing.B) {
+	req := &AccessRecordRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkRecordsActOnRecordGetChecks(b *testing.B) {
+	req := &ActOnRecordRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkRecordsInvokeRecordGetChecks(b *testing.B) {
+	req := &InvokeRecordRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
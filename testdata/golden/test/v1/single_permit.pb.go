@@ -6,17 +6,15 @@ import (
 
 func (req *ResourceRequest) GetChecks() pkg.CheckConfig {
 	permission := "create"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	var id string
 	tenantId := "default"
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Flat",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Flat",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -28,7 +26,7 @@ func (req *ResourceRequest) GetChecks() pkg.CheckConfig {
 
 func (req *ResourceWithIdRequest) GetChecks() pkg.CheckConfig {
 	permission := "read"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
@@ -38,14 +36,12 @@ func (req *ResourceWithIdRequest) GetChecks() pkg.CheckConfig {
 	if resource.CompanyId != "" {
 		tenantId = resource.CompanyId
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Flat",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Flat",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -57,24 +53,22 @@ func (req *ResourceWithIdRequest) GetChecks() pkg.CheckConfig {
 
 func (req *NestedResourceRequest) GetChecks() pkg.CheckConfig {
 	permission := "edit"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req.Resource
 	var id string
-	if resource != nil && resource.NestedIds.Id != "" {
+	if resource != nil && resource.NestedIds != nil && resource.NestedIds.Id != "" {
 		id = resource.NestedIds.Id
 	}
 	tenantId := "default"
-	if resource != nil && resource.NestedIds.CompanyId != "" {
+	if resource != nil && resource.NestedIds != nil && resource.NestedIds.CompanyId != "" {
 		tenantId = resource.NestedIds.CompanyId
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "Nested",
-			ID:         id,
-			Attributes: attributes,
+			Type: "Nested",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
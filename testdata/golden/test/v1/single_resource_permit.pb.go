@@ -6,7 +6,7 @@ import (
 
 func (req *UpdateUserRequest) GetChecks() pkg.CheckConfig {
 	permission := "write"
-	var checks []pkg.Check
+	checks := make([]pkg.Check, 0, 1)
 	resource := req
 	var id string
 	if resource.Id != "" {
@@ -16,14 +16,12 @@ func (req *UpdateUserRequest) GetChecks() pkg.CheckConfig {
 	if resource.CompanyId != "" {
 		tenantId = resource.CompanyId
 	}
-	attributes := make(map[string]any)
 	check := pkg.Check{
 		TenantID:   tenantId,
 		Permission: permission,
 		Entity: &pkg.Resource{
-			Type:       "User",
-			ID:         id,
-			Attributes: attributes,
+			Type: "User",
+			ID:   id,
 		},
 	}
 	checks = append(checks, check)
@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/single_tenant.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Wallet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_test_v1_single_tenant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_single_tenant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_test_v1_single_tenant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Wallet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Wallet) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type AuditWalletsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallets       []*Wallet              `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditWalletsRequest) Reset() {
+	*x = AuditWalletsRequest{}
+	mi := &file_test_v1_single_tenant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditWalletsRequest) ProtoMessage() {}
+
+func (x *AuditWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_single_tenant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditWalletsRequest.ProtoReflect.Descriptor instead.
+func (*AuditWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_single_tenant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditWalletsRequest) GetWallets() []*Wallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+type CloseWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseWalletRequest) Reset() {
+	*x = CloseWalletRequest{}
+	mi := &file_test_v1_single_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseWalletRequest) ProtoMessage() {}
+
+func (x *CloseWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_single_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseWalletRequest.ProtoReflect.Descriptor instead.
+func (*CloseWalletRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_single_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CloseWalletRequest) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+var File_test_v1_single_tenant_proto protoreflect.FileDescriptor
+
+const file_test_v1_single_tenant_proto_rawDesc = "" +
+	"\n" +
+	"\x1btest/v1/single_tenant.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"M\n" +
+	"\x06Wallet\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\n" +
+	"»\x01\x06Wallet\"@\n" +
+	"\x13AuditWalletsRequest\x12)\n" +
+	"\awallets\x18\x01 \x03(\v2\x0f.test.v1.WalletR\awallets\"=\n" +
+	"\x12CloseWalletRequest\x12'\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x0f.test.v1.WalletR\x06wallet2\x9f\x01\n" +
+	"\aWallets\x12J\n" +
+	"\fAuditWallets\x12\x1c.test.v1.AuditWalletsRequest\x1a\x11.test.v1.Response\"\t»\x01\x05audit\x12H\n" +
+	"\vCloseWallet\x12\x1b.test.v1.CloseWalletRequest\x1a\x11.test.v1.Response\"\t»\x01\x05closeB\x14\xf0\xc1\x01\x01Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_single_tenant_proto_rawDescOnce sync.Once
+	file_test_v1_single_tenant_proto_rawDescData []byte
+)
+
+func file_test_v1_single_tenant_proto_rawDescGZIP() []byte {
+	file_test_v1_single_tenant_proto_rawDescOnce.Do(func() {
+		file_test_v1_single_tenant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_single_tenant_proto_rawDesc), len(file_test_v1_single_tenant_proto_rawDesc)))
+	})
+	return file_test_v1_single_tenant_proto_rawDescData
+}
+
+var file_test_v1_single_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_test_v1_single_tenant_proto_goTypes = []any{
+	(*Wallet)(nil),              // 0: test.v1.Wallet
+	(*AuditWalletsRequest)(nil), // 1: test.v1.AuditWalletsRequest
+	(*CloseWalletRequest)(nil),  // 2: test.v1.CloseWalletRequest
+	(*Response)(nil),            // 3: test.v1.Response
+}
+var file_test_v1_single_tenant_proto_depIdxs = []int32{
+	0, // 0: test.v1.AuditWalletsRequest.wallets:type_name -> test.v1.Wallet
+	0, // 1: test.v1.CloseWalletRequest.wallet:type_name -> test.v1.Wallet
+	1, // 2: test.v1.Wallets.AuditWallets:input_type -> test.v1.AuditWalletsRequest
+	2, // 3: test.v1.Wallets.CloseWallet:input_type -> test.v1.CloseWalletRequest
+	3, // 4: test.v1.Wallets.AuditWallets:output_type -> test.v1.Response
+	3, // 5: test.v1.Wallets.CloseWallet:output_type -> test.v1.Response
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_single_tenant_proto_init() }
+func file_test_v1_single_tenant_proto_init() {
+	if File_test_v1_single_tenant_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_single_tenant_proto_rawDesc), len(file_test_v1_single_tenant_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_single_tenant_proto_goTypes,
+		DependencyIndexes: file_test_v1_single_tenant_proto_depIdxs,
+		MessageInfos:      file_test_v1_single_tenant_proto_msgTypes,
+	}.Build()
+	File_test_v1_single_tenant_proto = out.File
+	file_test_v1_single_tenant_proto_goTypes = nil
+	file_test_v1_single_tenant_proto_depIdxs = nil
+}
@@ -0,0 +1,95 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+// GetChecks denies requests whose checks aren't all in the same tenant.
+func (req *AuditWalletsRequest) GetChecks() pkg.CheckConfig {
+	permission := "audit"
+	checks := make([]pkg.Check, 0, len(req.Wallets))
+	for _, v1 := range req.Wallets {
+		resource := v1
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Wallet",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	for _, check := range checks {
+		if check.TenantID != checks[0].TenantID {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "request spans more than one tenant",
+							},
+						},
+					},
+				},
+			}
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks denies requests whose checks aren't all in the same tenant.
+func (req *CloseWalletRequest) GetChecks() pkg.CheckConfig {
+	permission := "close"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Wallet
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	if resource.TenantId != "" {
+		tenantId = resource.TenantId
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Wallet",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	for _, check := range checks {
+		if check.TenantID != checks[0].TenantID {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "request spans more than one tenant",
+							},
+						},
+					},
+				},
+			}
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
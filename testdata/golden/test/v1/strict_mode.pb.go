@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/strict_mode.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ledger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ledger) Reset() {
+	*x = Ledger{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ledger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ledger) ProtoMessage() {}
+
+func (x *Ledger) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ledger.ProtoReflect.Descriptor instead.
+func (*Ledger) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ledger) GetId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Ledger) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ledger        *Ledger                `protobuf:"bytes,1,opt,name=ledger,proto3,oneof" json:"ledger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerRequest) Reset() {
+	*x = GetLedgerRequest{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerRequest) ProtoMessage() {}
+
+func (x *GetLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLedgerRequest) GetLedger() *Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+type Entry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memo          string                 `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Entry) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type ReconcileEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       map[string]*Entry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileEntriesRequest) Reset() {
+	*x = ReconcileEntriesRequest{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileEntriesRequest) ProtoMessage() {}
+
+func (x *ReconcileEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReconcileEntriesRequest) GetEntries() map[string]*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ReconcileEntriesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type LedgerDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerDraft) Reset() {
+	*x = LedgerDraft{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerDraft) ProtoMessage() {}
+
+func (x *LedgerDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerDraft.ProtoReflect.Descriptor instead.
+func (*LedgerDraft) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LedgerDraft) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerDraft) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type SaveDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *LedgerDraft           `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftRequest) ProtoMessage() {}
+
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SaveDraftRequest) GetDraft() *LedgerDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type NewLedger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewLedger) Reset() {
+	*x = NewLedger{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewLedger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewLedger) ProtoMessage() {}
+
+func (x *NewLedger) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewLedger.ProtoReflect.Descriptor instead.
+func (*NewLedger) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NewLedger) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *NewLedger) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type CreateLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ledger        *NewLedger             `protobuf:"bytes,1,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLedgerRequest) Reset() {
+	*x = CreateLedgerRequest{}
+	mi := &file_test_v1_strict_mode_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLedgerRequest) ProtoMessage() {}
+
+func (x *CreateLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_strict_mode_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLedgerRequest.ProtoReflect.Descriptor instead.
+func (*CreateLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_strict_mode_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateLedgerRequest) GetLedger() *NewLedger {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+var File_test_v1_strict_mode_proto protoreflect.FileDescriptor
+
+const file_test_v1_strict_mode_proto_rawDesc = "" +
+	"\n" +
+	"\x19test/v1/strict_mode.proto\x12\atest.v1\x1a\x1egoogle/protobuf/wrappers.proto\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"j\n" +
+	"\x06Ledger\x121\n" +
+	"\x02id\x18\x01 \x01(\v2\x1b.google.protobuf.Int64ValueB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\n" +
+	"»\x01\x06Ledger\"K\n" +
+	"\x10GetLedgerRequest\x12,\n" +
+	"\x06ledger\x18\x01 \x01(\v2\x0f.test.v1.LedgerH\x00R\x06ledger\x88\x01\x01B\t\n" +
+	"\a_ledger\"&\n" +
+	"\x05Entry\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo:\t»\x01\x05Entry\"\xd7\x01\n" +
+	"\x17ReconcileEntriesRequest\x12M\n" +
+	"\aentries\x18\x01 \x03(\v2-.test.v1.ReconcileEntriesRequest.EntriesEntryB\x04\xe8\xc1\x01\x01R\aentries\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId\x1aJ\n" +
+	"\fEntriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.test.v1.EntryR\x05value:\x028\x01\"[\n" +
+	"\vLedgerDraft\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\x13»\x01\vLedgerDraft\xe8\xc1\x01\x01\">\n" +
+	"\x10SaveDraftRequest\x12*\n" +
+	"\x05draft\x18\x01 \x01(\v2\x14.test.v1.LedgerDraftR\x05draft\"f\n" +
+	"\tNewLedger\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\tR\x06bookId\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\x1d»\x01\x06Ledger\xe2\xc1\x01\x0f\n" +
+	"\x04Book\x12\abook_id\"A\n" +
+	"\x13CreateLedgerRequest\x12*\n" +
+	"\x06ledger\x18\x01 \x01(\v2\x12.test.v1.NewLedgerR\x06ledger2\xb8\x02\n" +
+	"\aLedgers\x12C\n" +
+	"\tGetLedger\x12\x19.test.v1.GetLedgerRequest\x1a\x11.test.v1.Response\"\b»\x01\x04view\x12V\n" +
+	"\x10ReconcileEntries\x12 .test.v1.ReconcileEntriesRequest\x1a\x11.test.v1.Response\"\r»\x01\treconcile\x12C\n" +
+	"\tSaveDraft\x12\x19.test.v1.SaveDraftRequest\x1a\x11.test.v1.Response\"\b»\x01\x04edit\x12K\n" +
+	"\fCreateLedger\x12\x1c.test.v1.CreateLedgerRequest\x1a\x11.test.v1.Response\"\n" +
+	"»\x01\x06createB\x14\xe0\xc1\x01\x01Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_strict_mode_proto_rawDescOnce sync.Once
+	file_test_v1_strict_mode_proto_rawDescData []byte
+)
+
+func file_test_v1_strict_mode_proto_rawDescGZIP() []byte {
+	file_test_v1_strict_mode_proto_rawDescOnce.Do(func() {
+		file_test_v1_strict_mode_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_strict_mode_proto_rawDesc), len(file_test_v1_strict_mode_proto_rawDesc)))
+	})
+	return file_test_v1_strict_mode_proto_rawDescData
+}
+
+var file_test_v1_strict_mode_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_test_v1_strict_mode_proto_goTypes = []any{
+	(*Ledger)(nil),                  // 0: test.v1.Ledger
+	(*GetLedgerRequest)(nil),        // 1: test.v1.GetLedgerRequest
+	(*Entry)(nil),                   // 2: test.v1.Entry
+	(*ReconcileEntriesRequest)(nil), // 3: test.v1.ReconcileEntriesRequest
+	(*LedgerDraft)(nil),             // 4: test.v1.LedgerDraft
+	(*SaveDraftRequest)(nil),        // 5: test.v1.SaveDraftRequest
+	(*NewLedger)(nil),               // 6: test.v1.NewLedger
+	(*CreateLedgerRequest)(nil),     // 7: test.v1.CreateLedgerRequest
+	nil,                             // 8: test.v1.ReconcileEntriesRequest.EntriesEntry
+	(*wrapperspb.Int64Value)(nil),   // 9: google.protobuf.Int64Value
+	(*Response)(nil),                // 10: test.v1.Response
+}
+var file_test_v1_strict_mode_proto_depIdxs = []int32{
+	9,  // 0: test.v1.Ledger.id:type_name -> google.protobuf.Int64Value
+	0,  // 1: test.v1.GetLedgerRequest.ledger:type_name -> test.v1.Ledger
+	8,  // 2: test.v1.ReconcileEntriesRequest.entries:type_name -> test.v1.ReconcileEntriesRequest.EntriesEntry
+	4,  // 3: test.v1.SaveDraftRequest.draft:type_name -> test.v1.LedgerDraft
+	6,  // 4: test.v1.CreateLedgerRequest.ledger:type_name -> test.v1.NewLedger
+	2,  // 5: test.v1.ReconcileEntriesRequest.EntriesEntry.value:type_name -> test.v1.Entry
+	1,  // 6: test.v1.Ledgers.GetLedger:input_type -> test.v1.GetLedgerRequest
+	3,  // 7: test.v1.Ledgers.ReconcileEntries:input_type -> test.v1.ReconcileEntriesRequest
+	5,  // 8: test.v1.Ledgers.SaveDraft:input_type -> test.v1.SaveDraftRequest
+	7,  // 9: test.v1.Ledgers.CreateLedger:input_type -> test.v1.CreateLedgerRequest
+	10, // 10: test.v1.Ledgers.GetLedger:output_type -> test.v1.Response
+	10, // 11: test.v1.Ledgers.ReconcileEntries:output_type -> test.v1.Response
+	10, // 12: test.v1.Ledgers.SaveDraft:output_type -> test.v1.Response
+	10, // 13: test.v1.Ledgers.CreateLedger:output_type -> test.v1.Response
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_strict_mode_proto_init() }
+func file_test_v1_strict_mode_proto_init() {
+	if File_test_v1_strict_mode_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_strict_mode_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_strict_mode_proto_rawDesc), len(file_test_v1_strict_mode_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_strict_mode_proto_goTypes,
+		DependencyIndexes: file_test_v1_strict_mode_proto_depIdxs,
+		MessageInfos:      file_test_v1_strict_mode_proto_msgTypes,
+	}.Build()
+	File_test_v1_strict_mode_proto = out.File
+	file_test_v1_strict_mode_proto_goTypes = nil
+	file_test_v1_strict_mode_proto_depIdxs = nil
+}
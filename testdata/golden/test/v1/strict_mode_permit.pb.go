@@ -0,0 +1,231 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	maps "maps"
+	slices "slices"
+	strconv "strconv"
+)
+
+func (req *GetLedgerRequest) GetChecks() pkg.CheckConfig {
+	permission := "view"
+	checks := make([]pkg.Check, 0, 1)
+	if req.Ledger != nil {
+		resource := req.Ledger
+		var id string
+		if resource.Id != nil {
+			id = strconv.FormatInt(resource.Id.Value, 10)
+		}
+		if id == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "field ledger.id is not set",
+							},
+						},
+					},
+				},
+			}
+		}
+		var tenantId string
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		if tenantId == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "field ledger.tenant_id is not set",
+							},
+						},
+					},
+				},
+			}
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Ledger",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	} else {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field ledger is not set",
+						},
+					},
+				},
+			},
+		}
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks returns the checks of the request.
+// The tenant of Entry comes from inherited field test.v1.ReconcileEntriesRequest.tenant_id, then denied.
+func (req *ReconcileEntriesRequest) GetChecks() pkg.CheckConfig {
+	permission := "reconcile"
+	checks := make([]pkg.Check, 0, len(req.Entries))
+	for _, v1 := range slices.Sorted(maps.Keys(req.Entries)) {
+		var id string
+		if v1 != "" {
+			id = v1
+		}
+		if id == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "map entries has an empty key",
+							},
+						},
+					},
+				},
+			}
+		}
+		var tenantId string
+		if req.TenantId != "" {
+			tenantId = req.TenantId
+		}
+		if tenantId == "" {
+			return pkg.CheckConfig{
+				IsPublic: false,
+				Checks: []pkg.Check{
+					{
+						Entity: &pkg.Resource{
+							Attributes: map[string]any{
+								"deny_reason": "field tenant_id is not set",
+							},
+						},
+					},
+				},
+			}
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Entry",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *SaveDraftRequest) GetChecks() pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Draft
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	var tenantId string
+	if resource.TenantId != "" {
+		tenantId = resource.TenantId
+	}
+	if tenantId == "" {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field draft.tenant_id is not set",
+						},
+					},
+				},
+			},
+		}
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "LedgerDraft",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *CreateLedgerRequest) GetChecks() pkg.CheckConfig {
+	permission := "create"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Ledger
+	var tenantId string
+	if resource.TenantId != "" {
+		tenantId = resource.TenantId
+	}
+	if tenantId == "" {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field ledger.tenant_id is not set",
+						},
+					},
+				},
+			},
+		}
+	}
+	var parentId string
+	if resource.BookId != "" {
+		parentId = resource.BookId
+	}
+	if parentId == "" {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "field ledger.book_id is not set",
+						},
+					},
+				},
+			},
+		}
+	}
+	checks = append(checks, pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Book",
+			ID:   parentId,
+		},
+	})
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
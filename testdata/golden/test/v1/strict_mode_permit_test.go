@@ -0,0 +1,42 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkLedgersGetLedgerGetChecks(b *testing.B) {
+	req := &GetLedgerRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkLedgersReconcileEntriesGetChecks(b *testing.B) {
+	req := &ReconcileEntriesRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkLedgersSaveDraftGetChecks(b *testing.B) {
+	req := &SaveDraftRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkLedgersCreateLedgerGetChecks(b *testing.B) {
+	req := &CreateLedgerRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/subject_overrides.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ticket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_test_v1_subject_overrides_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ticket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CloseTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	ActingUserId  string                 `protobuf:"bytes,2,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseTicketRequest) Reset() {
+	*x = CloseTicketRequest{}
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTicketRequest) ProtoMessage() {}
+
+func (x *CloseTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseTicketRequest.ProtoReflect.Descriptor instead.
+func (*CloseTicketRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_subject_overrides_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CloseTicketRequest) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *CloseTicketRequest) GetActingUserId() string {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return ""
+}
+
+type Delegation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Delegation) Reset() {
+	*x = Delegation{}
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delegation) ProtoMessage() {}
+
+func (x *Delegation) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return file_test_v1_subject_overrides_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Delegation) GetGroupId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.GroupId
+	}
+	return nil
+}
+
+type AssignTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Delegation    *Delegation            `protobuf:"bytes,2,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTicketRequest) Reset() {
+	*x = AssignTicketRequest{}
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTicketRequest) ProtoMessage() {}
+
+func (x *AssignTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_subject_overrides_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTicketRequest.ProtoReflect.Descriptor instead.
+func (*AssignTicketRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_subject_overrides_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AssignTicketRequest) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *AssignTicketRequest) GetDelegation() *Delegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+var File_test_v1_subject_overrides_proto protoreflect.FileDescriptor
+
+const file_test_v1_subject_overrides_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftest/v1/subject_overrides.proto\x12\atest.v1\x1a\x1egoogle/protobuf/wrappers.proto\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"*\n" +
+	"\x06Ticket\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id:\n" +
+	"»\x01\x06Ticket\"o\n" +
+	"\x12CloseTicketRequest\x12'\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x0f.test.v1.TicketR\x06ticket\x120\n" +
+	"\x0eacting_user_id\x18\x02 \x01(\tB\n" +
+	"\x9a\xc2\x01\x06\n" +
+	"\x04userR\factingUserId\"Y\n" +
+	"\n" +
+	"Delegation\x12K\n" +
+	"\bgroup_id\x18\x01 \x01(\v2\x1b.google.protobuf.Int64ValueB\x13\x9a\xc2\x01\x0f\n" +
+	"\x05group\x12\x06memberR\agroupId\"s\n" +
+	"\x13AssignTicketRequest\x12'\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x0f.test.v1.TicketR\x06ticket\x123\n" +
+	"\n" +
+	"delegation\x18\x02 \x01(\v2\x13.test.v1.DelegationR\n" +
+	"delegation2\xa0\x01\n" +
+	"\aTickets\x12H\n" +
+	"\vCloseTicket\x12\x1b.test.v1.CloseTicketRequest\x1a\x11.test.v1.Response\"\t»\x01\x05close\x12K\n" +
+	"\fAssignTicket\x12\x1c.test.v1.AssignTicketRequest\x1a\x11.test.v1.Response\"\n" +
+	"»\x01\x06assignB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_subject_overrides_proto_rawDescOnce sync.Once
+	file_test_v1_subject_overrides_proto_rawDescData []byte
+)
+
+func file_test_v1_subject_overrides_proto_rawDescGZIP() []byte {
+	file_test_v1_subject_overrides_proto_rawDescOnce.Do(func() {
+		file_test_v1_subject_overrides_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_subject_overrides_proto_rawDesc), len(file_test_v1_subject_overrides_proto_rawDesc)))
+	})
+	return file_test_v1_subject_overrides_proto_rawDescData
+}
+
+var file_test_v1_subject_overrides_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_test_v1_subject_overrides_proto_goTypes = []any{
+	(*Ticket)(nil),                // 0: test.v1.Ticket
+	(*CloseTicketRequest)(nil),    // 1: test.v1.CloseTicketRequest
+	(*Delegation)(nil),            // 2: test.v1.Delegation
+	(*AssignTicketRequest)(nil),   // 3: test.v1.AssignTicketRequest
+	(*wrapperspb.Int64Value)(nil), // 4: google.protobuf.Int64Value
+	(*Response)(nil),              // 5: test.v1.Response
+}
+var file_test_v1_subject_overrides_proto_depIdxs = []int32{
+	0, // 0: test.v1.CloseTicketRequest.ticket:type_name -> test.v1.Ticket
+	4, // 1: test.v1.Delegation.group_id:type_name -> google.protobuf.Int64Value
+	0, // 2: test.v1.AssignTicketRequest.ticket:type_name -> test.v1.Ticket
+	2, // 3: test.v1.AssignTicketRequest.delegation:type_name -> test.v1.Delegation
+	1, // 4: test.v1.Tickets.CloseTicket:input_type -> test.v1.CloseTicketRequest
+	3, // 5: test.v1.Tickets.AssignTicket:input_type -> test.v1.AssignTicketRequest
+	5, // 6: test.v1.Tickets.CloseTicket:output_type -> test.v1.Response
+	5, // 7: test.v1.Tickets.AssignTicket:output_type -> test.v1.Response
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_subject_overrides_proto_init() }
+func file_test_v1_subject_overrides_proto_init() {
+	if File_test_v1_subject_overrides_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_subject_overrides_proto_rawDesc), len(file_test_v1_subject_overrides_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_subject_overrides_proto_goTypes,
+		DependencyIndexes: file_test_v1_subject_overrides_proto_depIdxs,
+		MessageInfos:      file_test_v1_subject_overrides_proto_msgTypes,
+	}.Build()
+	File_test_v1_subject_overrides_proto = out.File
+	file_test_v1_subject_overrides_proto_goTypes = nil
+	file_test_v1_subject_overrides_proto_depIdxs = nil
+}
@@ -0,0 +1,80 @@
+package testv1
+
+import (
+	v1 "buf.build/gen/go/permifyco/permify/protocolbuffers/go/base/v1"
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	strconv "strconv"
+)
+
+func (req *CloseTicketRequest) GetChecks() pkg.CheckConfig {
+	permission := "close"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Ticket
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Ticket",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetCheckSubject returns the subject of every check returned by GetChecks in place of the caller, or nil when
+// acting_user_id is unset.
+func (req *CloseTicketRequest) GetCheckSubject() *v1.Subject {
+	if req.ActingUserId != "" {
+		return v1.Subject_builder{
+			Type: "user",
+			Id:   req.ActingUserId,
+		}.Build()
+	}
+	return nil
+}
+
+func (req *AssignTicketRequest) GetChecks() pkg.CheckConfig {
+	permission := "assign"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Ticket
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Ticket",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetCheckSubject returns the subject of every check returned by GetChecks in place of the caller, or nil when
+// group_id is unset.
+func (req *AssignTicketRequest) GetCheckSubject() *v1.Subject {
+	if req.Delegation != nil && req.Delegation.GroupId != nil {
+		return v1.Subject_builder{
+			Type:     "group",
+			Id:       strconv.FormatInt(req.Delegation.GroupId.Value, 10),
+			Relation: "member",
+		}.Build()
+	}
+	return nil
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/tenant_guard.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Shift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shift) Reset() {
+	*x = Shift{}
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shift) ProtoMessage() {}
+
+func (x *Shift) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shift.ProtoReflect.Descriptor instead.
+func (*Shift) Descriptor() ([]byte, []int) {
+	return file_test_v1_tenant_guard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shift) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type SwapShiftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shifts        []*Shift               `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapShiftsRequest) Reset() {
+	*x = SwapShiftsRequest{}
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapShiftsRequest) ProtoMessage() {}
+
+func (x *SwapShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapShiftsRequest.ProtoReflect.Descriptor instead.
+func (*SwapShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_tenant_guard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SwapShiftsRequest) GetShifts() []*Shift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+type GetShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shift         *Shift                 `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShiftRequest) Reset() {
+	*x = GetShiftRequest{}
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftRequest) ProtoMessage() {}
+
+func (x *GetShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftRequest.ProtoReflect.Descriptor instead.
+func (*GetShiftRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_tenant_guard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetShiftRequest) GetShift() *Shift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type ListOpenShiftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenShiftsRequest) Reset() {
+	*x = ListOpenShiftsRequest{}
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenShiftsRequest) ProtoMessage() {}
+
+func (x *ListOpenShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_tenant_guard_proto_rawDescGZIP(), []int{3}
+}
+
+type DropShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shift         *Shift                 `protobuf:"bytes,1,opt,name=shift,proto3,oneof" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropShiftRequest) Reset() {
+	*x = DropShiftRequest{}
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropShiftRequest) ProtoMessage() {}
+
+func (x *DropShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_tenant_guard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropShiftRequest.ProtoReflect.Descriptor instead.
+func (*DropShiftRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_tenant_guard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DropShiftRequest) GetShift() *Shift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+var File_test_v1_tenant_guard_proto protoreflect.FileDescriptor
+
+const file_test_v1_tenant_guard_proto_rawDesc = "" +
+	"\n" +
+	"\x1atest/v1/tenant_guard.proto\x12\atest.v1\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"K\n" +
+	"\x05Shift\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\x04Ȼ\x01\x01R\btenantId:\t»\x01\x05Shift\";\n" +
+	"\x11SwapShiftsRequest\x12&\n" +
+	"\x06shifts\x18\x01 \x03(\v2\x0e.test.v1.ShiftR\x06shifts\"7\n" +
+	"\x0fGetShiftRequest\x12$\n" +
+	"\x05shift\x18\x01 \x01(\v2\x0e.test.v1.ShiftR\x05shift\"\x17\n" +
+	"\x15ListOpenShiftsRequest\"M\n" +
+	"\x10DropShiftRequest\x12/\n" +
+	"\x05shift\x18\x01 \x01(\v2\x0e.test.v1.ShiftB\x04\xe0\xc1\x01\x02H\x00R\x05shift\x88\x01\x01B\b\n" +
+	"\x06_shift2\xa2\x02\n" +
+	"\x06Shifts\x12E\n" +
+	"\n" +
+	"SwapShifts\x12\x1a.test.v1.SwapShiftsRequest\x1a\x11.test.v1.Response\"\b»\x01\x04swap\x12A\n" +
+	"\bGetShift\x12\x18.test.v1.GetShiftRequest\x1a\x11.test.v1.Response\"\b»\x01\x04view\x12C\n" +
+	"\tDropShift\x12\x19.test.v1.DropShiftRequest\x1a\x11.test.v1.Response\"\b»\x01\x04drop\x12I\n" +
+	"\x0eListOpenShifts\x12\x1e.test.v1.ListOpenShiftsRequest\x1a\x11.test.v1.Response\"\x04Ȼ\x01\x01B$\xfa\xc1\x01\x10\n" +
+	"\x06tenant\x12\x06memberZ\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_tenant_guard_proto_rawDescOnce sync.Once
+	file_test_v1_tenant_guard_proto_rawDescData []byte
+)
+
+func file_test_v1_tenant_guard_proto_rawDescGZIP() []byte {
+	file_test_v1_tenant_guard_proto_rawDescOnce.Do(func() {
+		file_test_v1_tenant_guard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_tenant_guard_proto_rawDesc), len(file_test_v1_tenant_guard_proto_rawDesc)))
+	})
+	return file_test_v1_tenant_guard_proto_rawDescData
+}
+
+var file_test_v1_tenant_guard_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_v1_tenant_guard_proto_goTypes = []any{
+	(*Shift)(nil),                 // 0: test.v1.Shift
+	(*SwapShiftsRequest)(nil),     // 1: test.v1.SwapShiftsRequest
+	(*GetShiftRequest)(nil),       // 2: test.v1.GetShiftRequest
+	(*ListOpenShiftsRequest)(nil), // 3: test.v1.ListOpenShiftsRequest
+	(*DropShiftRequest)(nil),      // 4: test.v1.DropShiftRequest
+	(*Response)(nil),              // 5: test.v1.Response
+}
+var file_test_v1_tenant_guard_proto_depIdxs = []int32{
+	0, // 0: test.v1.SwapShiftsRequest.shifts:type_name -> test.v1.Shift
+	0, // 1: test.v1.GetShiftRequest.shift:type_name -> test.v1.Shift
+	0, // 2: test.v1.DropShiftRequest.shift:type_name -> test.v1.Shift
+	1, // 3: test.v1.Shifts.SwapShifts:input_type -> test.v1.SwapShiftsRequest
+	2, // 4: test.v1.Shifts.GetShift:input_type -> test.v1.GetShiftRequest
+	4, // 5: test.v1.Shifts.DropShift:input_type -> test.v1.DropShiftRequest
+	3, // 6: test.v1.Shifts.ListOpenShifts:input_type -> test.v1.ListOpenShiftsRequest
+	5, // 7: test.v1.Shifts.SwapShifts:output_type -> test.v1.Response
+	5, // 8: test.v1.Shifts.GetShift:output_type -> test.v1.Response
+	5, // 9: test.v1.Shifts.DropShift:output_type -> test.v1.Response
+	5, // 10: test.v1.Shifts.ListOpenShifts:output_type -> test.v1.Response
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_tenant_guard_proto_init() }
+func file_test_v1_tenant_guard_proto_init() {
+	if File_test_v1_tenant_guard_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	file_test_v1_tenant_guard_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_tenant_guard_proto_rawDesc), len(file_test_v1_tenant_guard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_tenant_guard_proto_goTypes,
+		DependencyIndexes: file_test_v1_tenant_guard_proto_depIdxs,
+		MessageInfos:      file_test_v1_tenant_guard_proto_msgTypes,
+	}.Build()
+	File_test_v1_tenant_guard_proto = out.File
+	file_test_v1_tenant_guard_proto_goTypes = nil
+	file_test_v1_tenant_guard_proto_depIdxs = nil
+}
@@ -0,0 +1,196 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+)
+
+// GetChecks checks tenant#member on each tenant of its checks, ahead of them, and denies
+// requests without checks.
+func (req *SwapShiftsRequest) GetChecks() pkg.CheckConfig {
+	permission := "swap"
+	checks := make([]pkg.Check, 0, len(req.Shifts))
+	for _, v1 := range req.Shifts {
+		resource := v1
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Shift",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	tenants := make(map[string]struct{}, 1)
+	guards := make([]pkg.Check, 0, 1)
+	for _, check := range checks {
+		if _, ok := tenants[check.TenantID]; ok {
+			continue
+		}
+		tenants[check.TenantID] = struct{}{}
+		guards = append(guards, pkg.Check{
+			TenantID:   check.TenantID,
+			Permission: "member",
+			Entity: &pkg.Resource{
+				Type: "tenant",
+				ID:   check.TenantID,
+			},
+		})
+	}
+	if len(guards) == 0 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has no tenant to check the tenant guard on",
+						},
+					},
+				},
+			},
+		}
+	}
+	checks = append(guards, checks...)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks checks tenant#member on each tenant of its checks, ahead of them, and denies
+// requests without checks.
+func (req *GetShiftRequest) GetChecks() pkg.CheckConfig {
+	permission := "view"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Shift
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	if resource.TenantId != "" {
+		tenantId = resource.TenantId
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Shift",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	tenants := make(map[string]struct{}, 1)
+	guards := make([]pkg.Check, 0, 1)
+	for _, check := range checks {
+		if _, ok := tenants[check.TenantID]; ok {
+			continue
+		}
+		tenants[check.TenantID] = struct{}{}
+		guards = append(guards, pkg.Check{
+			TenantID:   check.TenantID,
+			Permission: "member",
+			Entity: &pkg.Resource{
+				Type: "tenant",
+				ID:   check.TenantID,
+			},
+		})
+	}
+	if len(guards) == 0 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has no tenant to check the tenant guard on",
+						},
+					},
+				},
+			},
+		}
+	}
+	checks = append(guards, checks...)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+// GetChecks checks tenant#member on each tenant of its checks, ahead of them, and denies
+// requests without checks.
+func (req *DropShiftRequest) GetChecks() pkg.CheckConfig {
+	permission := "drop"
+	checks := make([]pkg.Check, 0, 1)
+	if req.Shift != nil {
+		resource := req.Shift
+		var id string
+		if resource.Id != "" {
+			id = resource.Id
+		}
+		tenantId := "default"
+		if resource.TenantId != "" {
+			tenantId = resource.TenantId
+		}
+		check := pkg.Check{
+			TenantID:   tenantId,
+			Permission: permission,
+			Entity: &pkg.Resource{
+				Type: "Shift",
+				ID:   id,
+			},
+		}
+		checks = append(checks, check)
+	}
+	tenants := make(map[string]struct{}, 1)
+	guards := make([]pkg.Check, 0, 1)
+	for _, check := range checks {
+		if _, ok := tenants[check.TenantID]; ok {
+			continue
+		}
+		tenants[check.TenantID] = struct{}{}
+		guards = append(guards, pkg.Check{
+			TenantID:   check.TenantID,
+			Permission: "member",
+			Entity: &pkg.Resource{
+				Type: "tenant",
+				ID:   check.TenantID,
+			},
+		})
+	}
+	if len(guards) == 0 {
+		return pkg.CheckConfig{
+			IsPublic: false,
+			Checks: []pkg.Check{
+				{
+					Entity: &pkg.Resource{
+						Attributes: map[string]any{
+							"deny_reason": "request has no tenant to check the tenant guard on",
+						},
+					},
+				},
+			},
+		}
+	}
+	checks = append(guards, checks...)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *ListOpenShiftsRequest) GetChecks() pkg.CheckConfig {
+	return pkg.CheckConfig{
+		IsPublic: true,
+		Checks:   []pkg.Check{},
+	}
+}
@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/access_modes.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MembersName is the fully-qualified name of the Members service.
+	MembersName = "test.v1.Members"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MembersGetMyMemberProcedure is the fully-qualified name of the Members's GetMyMember RPC.
+	MembersGetMyMemberProcedure = "/test.v1.Members/GetMyMember"
+	// MembersLegacyExportProcedure is the fully-qualified name of the Members's LegacyExport RPC.
+	MembersLegacyExportProcedure = "/test.v1.Members/LegacyExport"
+	// MembersSyncMembersProcedure is the fully-qualified name of the Members's SyncMembers RPC.
+	MembersSyncMembersProcedure = "/test.v1.Members/SyncMembers"
+	// MembersGetPublicMemberProcedure is the fully-qualified name of the Members's GetPublicMember RPC.
+	MembersGetPublicMemberProcedure = "/test.v1.Members/GetPublicMember"
+	// MembersUpdateMemberProcedure is the fully-qualified name of the Members's UpdateMember RPC.
+	MembersUpdateMemberProcedure = "/test.v1.Members/UpdateMember"
+)
+
+// MembersClient is a client for the test.v1.Members service.
+type MembersClient interface {
+	GetMyMember(context.Context, *connect.Request[v1.GetMyMemberRequest]) (*connect.Response[v1.Response], error)
+	LegacyExport(context.Context, *connect.Request[v1.LegacyExportRequest]) (*connect.Response[v1.Response], error)
+	SyncMembers(context.Context, *connect.Request[v1.SyncMembersRequest]) (*connect.Response[v1.Response], error)
+	GetPublicMember(context.Context, *connect.Request[v1.GetPublicMemberRequest]) (*connect.Response[v1.Response], error)
+	UpdateMember(context.Context, *connect.Request[v1.UpdateMemberRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewMembersClient constructs a client for the test.v1.Members service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMembersClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MembersClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	membersMethods := v1.File_test_v1_access_modes_proto.Services().ByName("Members").Methods()
+	return &membersClient{
+		getMyMember: connect.NewClient[v1.GetMyMemberRequest, v1.Response](
+			httpClient,
+			baseURL+MembersGetMyMemberProcedure,
+			connect.WithSchema(membersMethods.ByName("GetMyMember")),
+			connect.WithClientOptions(opts...),
+		),
+		legacyExport: connect.NewClient[v1.LegacyExportRequest, v1.Response](
+			httpClient,
+			baseURL+MembersLegacyExportProcedure,
+			connect.WithSchema(membersMethods.ByName("LegacyExport")),
+			connect.WithClientOptions(opts...),
+		),
+		syncMembers: connect.NewClient[v1.SyncMembersRequest, v1.Response](
+			httpClient,
+			baseURL+MembersSyncMembersProcedure,
+			connect.WithSchema(membersMethods.ByName("SyncMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		getPublicMember: connect.NewClient[v1.GetPublicMemberRequest, v1.Response](
+			httpClient,
+			baseURL+MembersGetPublicMemberProcedure,
+			connect.WithSchema(membersMethods.ByName("GetPublicMember")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMember: connect.NewClient[v1.UpdateMemberRequest, v1.Response](
+			httpClient,
+			baseURL+MembersUpdateMemberProcedure,
+			connect.WithSchema(membersMethods.ByName("UpdateMember")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// membersClient implements MembersClient.
+type membersClient struct {
+	getMyMember     *connect.Client[v1.GetMyMemberRequest, v1.Response]
+	legacyExport    *connect.Client[v1.LegacyExportRequest, v1.Response]
+	syncMembers     *connect.Client[v1.SyncMembersRequest, v1.Response]
+	getPublicMember *connect.Client[v1.GetPublicMemberRequest, v1.Response]
+	updateMember    *connect.Client[v1.UpdateMemberRequest, v1.Response]
+}
+
+// GetMyMember calls test.v1.Members.GetMyMember.
+func (c *membersClient) GetMyMember(ctx context.Context, req *connect.Request[v1.GetMyMemberRequest]) (*connect.Response[v1.Response], error) {
+	return c.getMyMember.CallUnary(ctx, req)
+}
+
+// LegacyExport calls test.v1.Members.LegacyExport.
+func (c *membersClient) LegacyExport(ctx context.Context, req *connect.Request[v1.LegacyExportRequest]) (*connect.Response[v1.Response], error) {
+	return c.legacyExport.CallUnary(ctx, req)
+}
+
+// SyncMembers calls test.v1.Members.SyncMembers.
+func (c *membersClient) SyncMembers(ctx context.Context, req *connect.Request[v1.SyncMembersRequest]) (*connect.Response[v1.Response], error) {
+	return c.syncMembers.CallUnary(ctx, req)
+}
+
+// GetPublicMember calls test.v1.Members.GetPublicMember.
+func (c *membersClient) GetPublicMember(ctx context.Context, req *connect.Request[v1.GetPublicMemberRequest]) (*connect.Response[v1.Response], error) {
+	return c.getPublicMember.CallUnary(ctx, req)
+}
+
+// UpdateMember calls test.v1.Members.UpdateMember.
+func (c *membersClient) UpdateMember(ctx context.Context, req *connect.Request[v1.UpdateMemberRequest]) (*connect.Response[v1.Response], error) {
+	return c.updateMember.CallUnary(ctx, req)
+}
+
+// MembersHandler is an implementation of the test.v1.Members service.
+type MembersHandler interface {
+	GetMyMember(context.Context, *connect.Request[v1.GetMyMemberRequest]) (*connect.Response[v1.Response], error)
+	LegacyExport(context.Context, *connect.Request[v1.LegacyExportRequest]) (*connect.Response[v1.Response], error)
+	SyncMembers(context.Context, *connect.Request[v1.SyncMembersRequest]) (*connect.Response[v1.Response], error)
+	GetPublicMember(context.Context, *connect.Request[v1.GetPublicMemberRequest]) (*connect.Response[v1.Response], error)
+	UpdateMember(context.Context, *connect.Request[v1.UpdateMemberRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewMembersHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMembersHandler(svc MembersHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	membersMethods := v1.File_test_v1_access_modes_proto.Services().ByName("Members").Methods()
+	membersGetMyMemberHandler := connect.NewUnaryHandler(
+		MembersGetMyMemberProcedure,
+		svc.GetMyMember,
+		connect.WithSchema(membersMethods.ByName("GetMyMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	membersLegacyExportHandler := connect.NewUnaryHandler(
+		MembersLegacyExportProcedure,
+		svc.LegacyExport,
+		connect.WithSchema(membersMethods.ByName("LegacyExport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	membersSyncMembersHandler := connect.NewUnaryHandler(
+		MembersSyncMembersProcedure,
+		svc.SyncMembers,
+		connect.WithSchema(membersMethods.ByName("SyncMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	membersGetPublicMemberHandler := connect.NewUnaryHandler(
+		MembersGetPublicMemberProcedure,
+		svc.GetPublicMember,
+		connect.WithSchema(membersMethods.ByName("GetPublicMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	membersUpdateMemberHandler := connect.NewUnaryHandler(
+		MembersUpdateMemberProcedure,
+		svc.UpdateMember,
+		connect.WithSchema(membersMethods.ByName("UpdateMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Members/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MembersGetMyMemberProcedure:
+			membersGetMyMemberHandler.ServeHTTP(w, r)
+		case MembersLegacyExportProcedure:
+			membersLegacyExportHandler.ServeHTTP(w, r)
+		case MembersSyncMembersProcedure:
+			membersSyncMembersHandler.ServeHTTP(w, r)
+		case MembersGetPublicMemberProcedure:
+			membersGetPublicMemberHandler.ServeHTTP(w, r)
+		case MembersUpdateMemberProcedure:
+			membersUpdateMemberHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMembersHandler returns CodeUnimplemented from all methods.
+type UnimplementedMembersHandler struct{}
+
+func (UnimplementedMembersHandler) GetMyMember(context.Context, *connect.Request[v1.GetMyMemberRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Members.GetMyMember is not implemented"))
+}
+
+func (UnimplementedMembersHandler) LegacyExport(context.Context, *connect.Request[v1.LegacyExportRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Members.LegacyExport is not implemented"))
+}
+
+func (UnimplementedMembersHandler) SyncMembers(context.Context, *connect.Request[v1.SyncMembersRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Members.SyncMembers is not implemented"))
+}
+
+func (UnimplementedMembersHandler) GetPublicMember(context.Context, *connect.Request[v1.GetPublicMemberRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Members.GetPublicMember is not implemented"))
+}
+
+func (UnimplementedMembersHandler) UpdateMember(context.Context, *connect.Request[v1.UpdateMemberRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Members.UpdateMember is not implemented"))
+}
@@ -0,0 +1,108 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/attribute_presence.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PresenceName is the fully-qualified name of the Presence service.
+	PresenceName = "test.v1.Presence"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PresenceUpdateProjectProcedure is the fully-qualified name of the Presence's UpdateProject RPC.
+	PresenceUpdateProjectProcedure = "/test.v1.Presence/UpdateProject"
+)
+
+// PresenceClient is a client for the test.v1.Presence service.
+type PresenceClient interface {
+	UpdateProject(context.Context, *connect.Request[v1.PresenceRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewPresenceClient constructs a client for the test.v1.Presence service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPresenceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PresenceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	presenceMethods := v1.File_test_v1_attribute_presence_proto.Services().ByName("Presence").Methods()
+	return &presenceClient{
+		updateProject: connect.NewClient[v1.PresenceRequest, v1.Response](
+			httpClient,
+			baseURL+PresenceUpdateProjectProcedure,
+			connect.WithSchema(presenceMethods.ByName("UpdateProject")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// presenceClient implements PresenceClient.
+type presenceClient struct {
+	updateProject *connect.Client[v1.PresenceRequest, v1.Response]
+}
+
+// UpdateProject calls test.v1.Presence.UpdateProject.
+func (c *presenceClient) UpdateProject(ctx context.Context, req *connect.Request[v1.PresenceRequest]) (*connect.Response[v1.Response], error) {
+	return c.updateProject.CallUnary(ctx, req)
+}
+
+// PresenceHandler is an implementation of the test.v1.Presence service.
+type PresenceHandler interface {
+	UpdateProject(context.Context, *connect.Request[v1.PresenceRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewPresenceHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPresenceHandler(svc PresenceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	presenceMethods := v1.File_test_v1_attribute_presence_proto.Services().ByName("Presence").Methods()
+	presenceUpdateProjectHandler := connect.NewUnaryHandler(
+		PresenceUpdateProjectProcedure,
+		svc.UpdateProject,
+		connect.WithSchema(presenceMethods.ByName("UpdateProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Presence/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PresenceUpdateProjectProcedure:
+			presenceUpdateProjectHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPresenceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPresenceHandler struct{}
+
+func (UnimplementedPresenceHandler) UpdateProject(context.Context, *connect.Request[v1.PresenceRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Presence.UpdateProject is not implemented"))
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/attribute_types.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TypedAttributesName is the fully-qualified name of the TypedAttributes service.
+	TypedAttributesName = "test.v1.TypedAttributes"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TypedAttributesUpdateDocumentProcedure is the fully-qualified name of the TypedAttributes's
+	// UpdateDocument RPC.
+	TypedAttributesUpdateDocumentProcedure = "/test.v1.TypedAttributes/UpdateDocument"
+)
+
+// TypedAttributesClient is a client for the test.v1.TypedAttributes service.
+type TypedAttributesClient interface {
+	UpdateDocument(context.Context, *connect.Request[v1.TypedAttributesRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewTypedAttributesClient constructs a client for the test.v1.TypedAttributes service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTypedAttributesClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TypedAttributesClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	typedAttributesMethods := v1.File_test_v1_attribute_types_proto.Services().ByName("TypedAttributes").Methods()
+	return &typedAttributesClient{
+		updateDocument: connect.NewClient[v1.TypedAttributesRequest, v1.Response](
+			httpClient,
+			baseURL+TypedAttributesUpdateDocumentProcedure,
+			connect.WithSchema(typedAttributesMethods.ByName("UpdateDocument")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// typedAttributesClient implements TypedAttributesClient.
+type typedAttributesClient struct {
+	updateDocument *connect.Client[v1.TypedAttributesRequest, v1.Response]
+}
+
+// UpdateDocument calls test.v1.TypedAttributes.UpdateDocument.
+func (c *typedAttributesClient) UpdateDocument(ctx context.Context, req *connect.Request[v1.TypedAttributesRequest]) (*connect.Response[v1.Response], error) {
+	return c.updateDocument.CallUnary(ctx, req)
+}
+
+// TypedAttributesHandler is an implementation of the test.v1.TypedAttributes service.
+type TypedAttributesHandler interface {
+	UpdateDocument(context.Context, *connect.Request[v1.TypedAttributesRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewTypedAttributesHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTypedAttributesHandler(svc TypedAttributesHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	typedAttributesMethods := v1.File_test_v1_attribute_types_proto.Services().ByName("TypedAttributes").Methods()
+	typedAttributesUpdateDocumentHandler := connect.NewUnaryHandler(
+		TypedAttributesUpdateDocumentProcedure,
+		svc.UpdateDocument,
+		connect.WithSchema(typedAttributesMethods.ByName("UpdateDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.TypedAttributes/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TypedAttributesUpdateDocumentProcedure:
+			typedAttributesUpdateDocumentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTypedAttributesHandler returns CodeUnimplemented from all methods.
+type UnimplementedTypedAttributesHandler struct{}
+
+func (UnimplementedTypedAttributesHandler) UpdateDocument(context.Context, *connect.Request[v1.TypedAttributesRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.TypedAttributes.UpdateDocument is not implemented"))
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/check_limits.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ShippingName is the fully-qualified name of the Shipping service.
+	ShippingName = "test.v1.Shipping"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ShippingTrackShipmentsProcedure is the fully-qualified name of the Shipping's TrackShipments RPC.
+	ShippingTrackShipmentsProcedure = "/test.v1.Shipping/TrackShipments"
+	// ShippingLabelParcelsProcedure is the fully-qualified name of the Shipping's LabelParcels RPC.
+	ShippingLabelParcelsProcedure = "/test.v1.Shipping/LabelParcels"
+	// ShippingManifestProcedure is the fully-qualified name of the Shipping's Manifest RPC.
+	ShippingManifestProcedure = "/test.v1.Shipping/Manifest"
+)
+
+// ShippingClient is a client for the test.v1.Shipping service.
+type ShippingClient interface {
+	TrackShipments(context.Context, *connect.Request[v1.TrackShipmentsRequest]) (*connect.Response[v1.Response], error)
+	LabelParcels(context.Context, *connect.Request[v1.LabelParcelsRequest]) (*connect.Response[v1.Response], error)
+	Manifest(context.Context, *connect.Request[v1.ManifestRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewShippingClient constructs a client for the test.v1.Shipping service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewShippingClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ShippingClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	shippingMethods := v1.File_test_v1_check_limits_proto.Services().ByName("Shipping").Methods()
+	return &shippingClient{
+		trackShipments: connect.NewClient[v1.TrackShipmentsRequest, v1.Response](
+			httpClient,
+			baseURL+ShippingTrackShipmentsProcedure,
+			connect.WithSchema(shippingMethods.ByName("TrackShipments")),
+			connect.WithClientOptions(opts...),
+		),
+		labelParcels: connect.NewClient[v1.LabelParcelsRequest, v1.Response](
+			httpClient,
+			baseURL+ShippingLabelParcelsProcedure,
+			connect.WithSchema(shippingMethods.ByName("LabelParcels")),
+			connect.WithClientOptions(opts...),
+		),
+		manifest: connect.NewClient[v1.ManifestRequest, v1.Response](
+			httpClient,
+			baseURL+ShippingManifestProcedure,
+			connect.WithSchema(shippingMethods.ByName("Manifest")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// shippingClient implements ShippingClient.
+type shippingClient struct {
+	trackShipments *connect.Client[v1.TrackShipmentsRequest, v1.Response]
+	labelParcels   *connect.Client[v1.LabelParcelsRequest, v1.Response]
+	manifest       *connect.Client[v1.ManifestRequest, v1.Response]
+}
+
+// TrackShipments calls test.v1.Shipping.TrackShipments.
+func (c *shippingClient) TrackShipments(ctx context.Context, req *connect.Request[v1.TrackShipmentsRequest]) (*connect.Response[v1.Response], error) {
+	return c.trackShipments.CallUnary(ctx, req)
+}
+
+// LabelParcels calls test.v1.Shipping.LabelParcels.
+func (c *shippingClient) LabelParcels(ctx context.Context, req *connect.Request[v1.LabelParcelsRequest]) (*connect.Response[v1.Response], error) {
+	return c.labelParcels.CallUnary(ctx, req)
+}
+
+// Manifest calls test.v1.Shipping.Manifest.
+func (c *shippingClient) Manifest(ctx context.Context, req *connect.Request[v1.ManifestRequest]) (*connect.Response[v1.Response], error) {
+	return c.manifest.CallUnary(ctx, req)
+}
+
+// ShippingHandler is an implementation of the test.v1.Shipping service.
+type ShippingHandler interface {
+	TrackShipments(context.Context, *connect.Request[v1.TrackShipmentsRequest]) (*connect.Response[v1.Response], error)
+	LabelParcels(context.Context, *connect.Request[v1.LabelParcelsRequest]) (*connect.Response[v1.Response], error)
+	Manifest(context.Context, *connect.Request[v1.ManifestRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewShippingHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewShippingHandler(svc ShippingHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	shippingMethods := v1.File_test_v1_check_limits_proto.Services().ByName("Shipping").Methods()
+	shippingTrackShipmentsHandler := connect.NewUnaryHandler(
+		ShippingTrackShipmentsProcedure,
+		svc.TrackShipments,
+		connect.WithSchema(shippingMethods.ByName("TrackShipments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shippingLabelParcelsHandler := connect.NewUnaryHandler(
+		ShippingLabelParcelsProcedure,
+		svc.LabelParcels,
+		connect.WithSchema(shippingMethods.ByName("LabelParcels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shippingManifestHandler := connect.NewUnaryHandler(
+		ShippingManifestProcedure,
+		svc.Manifest,
+		connect.WithSchema(shippingMethods.ByName("Manifest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Shipping/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ShippingTrackShipmentsProcedure:
+			shippingTrackShipmentsHandler.ServeHTTP(w, r)
+		case ShippingLabelParcelsProcedure:
+			shippingLabelParcelsHandler.ServeHTTP(w, r)
+		case ShippingManifestProcedure:
+			shippingManifestHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedShippingHandler returns CodeUnimplemented from all methods.
+type UnimplementedShippingHandler struct{}
+
+func (UnimplementedShippingHandler) TrackShipments(context.Context, *connect.Request[v1.TrackShipmentsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Shipping.TrackShipments is not implemented"))
+}
+
+func (UnimplementedShippingHandler) LabelParcels(context.Context, *connect.Request[v1.LabelParcelsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Shipping.LabelParcels is not implemented"))
+}
+
+func (UnimplementedShippingHandler) Manifest(context.Context, *connect.Request[v1.ManifestRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Shipping.Manifest is not implemented"))
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/check_metadata.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InvoicesName is the fully-qualified name of the Invoices service.
+	InvoicesName = "test.v1.Invoices"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InvoicesGetInvoiceProcedure is the fully-qualified name of the Invoices's GetInvoice RPC.
+	InvoicesGetInvoiceProcedure = "/test.v1.Invoices/GetInvoice"
+	// InvoicesApproveInvoiceProcedure is the fully-qualified name of the Invoices's ApproveInvoice RPC.
+	InvoicesApproveInvoiceProcedure = "/test.v1.Invoices/ApproveInvoice"
+	// InvoicesAuditInvoiceProcedure is the fully-qualified name of the Invoices's AuditInvoice RPC.
+	InvoicesAuditInvoiceProcedure = "/test.v1.Invoices/AuditInvoice"
+)
+
+// InvoicesClient is a client for the test.v1.Invoices service.
+type InvoicesClient interface {
+	GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.Response], error)
+	ApproveInvoice(context.Context, *connect.Request[v1.ApproveInvoiceRequest]) (*connect.Response[v1.Response], error)
+	AuditInvoice(context.Context, *connect.Request[v1.AuditInvoiceRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewInvoicesClient constructs a client for the test.v1.Invoices service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInvoicesClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InvoicesClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	invoicesMethods := v1.File_test_v1_check_metadata_proto.Services().ByName("Invoices").Methods()
+	return &invoicesClient{
+		getInvoice: connect.NewClient[v1.GetInvoiceRequest, v1.Response](
+			httpClient,
+			baseURL+InvoicesGetInvoiceProcedure,
+			connect.WithSchema(invoicesMethods.ByName("GetInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		approveInvoice: connect.NewClient[v1.ApproveInvoiceRequest, v1.Response](
+			httpClient,
+			baseURL+InvoicesApproveInvoiceProcedure,
+			connect.WithSchema(invoicesMethods.ByName("ApproveInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		auditInvoice: connect.NewClient[v1.AuditInvoiceRequest, v1.Response](
+			httpClient,
+			baseURL+InvoicesAuditInvoiceProcedure,
+			connect.WithSchema(invoicesMethods.ByName("AuditInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// invoicesClient implements InvoicesClient.
+type invoicesClient struct {
+	getInvoice     *connect.Client[v1.GetInvoiceRequest, v1.Response]
+	approveInvoice *connect.Client[v1.ApproveInvoiceRequest, v1.Response]
+	auditInvoice   *connect.Client[v1.AuditInvoiceRequest, v1.Response]
+}
+
+// GetInvoice calls test.v1.Invoices.GetInvoice.
+func (c *invoicesClient) GetInvoice(ctx context.Context, req *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.Response], error) {
+	return c.getInvoice.CallUnary(ctx, req)
+}
+
+// ApproveInvoice calls test.v1.Invoices.ApproveInvoice.
+func (c *invoicesClient) ApproveInvoice(ctx context.Context, req *connect.Request[v1.ApproveInvoiceRequest]) (*connect.Response[v1.Response], error) {
+	return c.approveInvoice.CallUnary(ctx, req)
+}
+
+// AuditInvoice calls test.v1.Invoices.AuditInvoice.
+func (c *invoicesClient) AuditInvoice(ctx context.Context, req *connect.Request[v1.AuditInvoiceRequest]) (*connect.Response[v1.Response], error) {
+	return c.auditInvoice.CallUnary(ctx, req)
+}
+
+// InvoicesHandler is an implementation of the test.v1.Invoices service.
+type InvoicesHandler interface {
+	GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.Response], error)
+	ApproveInvoice(context.Context, *connect.Request[v1.ApproveInvoiceRequest]) (*connect.Response[v1.Response], error)
+	AuditInvoice(context.Context, *connect.Request[v1.AuditInvoiceRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewInvoicesHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInvoicesHandler(svc InvoicesHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	invoicesMethods := v1.File_test_v1_check_metadata_proto.Services().ByName("Invoices").Methods()
+	invoicesGetInvoiceHandler := connect.NewUnaryHandler(
+		InvoicesGetInvoiceProcedure,
+		svc.GetInvoice,
+		connect.WithSchema(invoicesMethods.ByName("GetInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invoicesApproveInvoiceHandler := connect.NewUnaryHandler(
+		InvoicesApproveInvoiceProcedure,
+		svc.ApproveInvoice,
+		connect.WithSchema(invoicesMethods.ByName("ApproveInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invoicesAuditInvoiceHandler := connect.NewUnaryHandler(
+		InvoicesAuditInvoiceProcedure,
+		svc.AuditInvoice,
+		connect.WithSchema(invoicesMethods.ByName("AuditInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Invoices/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InvoicesGetInvoiceProcedure:
+			invoicesGetInvoiceHandler.ServeHTTP(w, r)
+		case InvoicesApproveInvoiceProcedure:
+			invoicesApproveInvoiceHandler.ServeHTTP(w, r)
+		case InvoicesAuditInvoiceProcedure:
+			invoicesAuditInvoiceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInvoicesHandler returns CodeUnimplemented from all methods.
+type UnimplementedInvoicesHandler struct{}
+
+func (UnimplementedInvoicesHandler) GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Invoices.GetInvoice is not implemented"))
+}
+
+func (UnimplementedInvoicesHandler) ApproveInvoice(context.Context, *connect.Request[v1.ApproveInvoiceRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Invoices.ApproveInvoice is not implemented"))
+}
+
+func (UnimplementedInvoicesHandler) AuditInvoice(context.Context, *connect.Request[v1.AuditInvoiceRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Invoices.AuditInvoice is not implemented"))
+}
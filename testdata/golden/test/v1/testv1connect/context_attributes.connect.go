@@ -0,0 +1,108 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/context_attributes.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TransfersName is the fully-qualified name of the Transfers service.
+	TransfersName = "test.v1.Transfers"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TransfersTransferProcedure is the fully-qualified name of the Transfers's Transfer RPC.
+	TransfersTransferProcedure = "/test.v1.Transfers/Transfer"
+)
+
+// TransfersClient is a client for the test.v1.Transfers service.
+type TransfersClient interface {
+	Transfer(context.Context, *connect.Request[v1.TransferRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewTransfersClient constructs a client for the test.v1.Transfers service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTransfersClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TransfersClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	transfersMethods := v1.File_test_v1_context_attributes_proto.Services().ByName("Transfers").Methods()
+	return &transfersClient{
+		transfer: connect.NewClient[v1.TransferRequest, v1.Response](
+			httpClient,
+			baseURL+TransfersTransferProcedure,
+			connect.WithSchema(transfersMethods.ByName("Transfer")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// transfersClient implements TransfersClient.
+type transfersClient struct {
+	transfer *connect.Client[v1.TransferRequest, v1.Response]
+}
+
+// Transfer calls test.v1.Transfers.Transfer.
+func (c *transfersClient) Transfer(ctx context.Context, req *connect.Request[v1.TransferRequest]) (*connect.Response[v1.Response], error) {
+	return c.transfer.CallUnary(ctx, req)
+}
+
+// TransfersHandler is an implementation of the test.v1.Transfers service.
+type TransfersHandler interface {
+	Transfer(context.Context, *connect.Request[v1.TransferRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewTransfersHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTransfersHandler(svc TransfersHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	transfersMethods := v1.File_test_v1_context_attributes_proto.Services().ByName("Transfers").Methods()
+	transfersTransferHandler := connect.NewUnaryHandler(
+		TransfersTransferProcedure,
+		svc.Transfer,
+		connect.WithSchema(transfersMethods.ByName("Transfer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Transfers/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TransfersTransferProcedure:
+			transfersTransferHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTransfersHandler returns CodeUnimplemented from all methods.
+type UnimplementedTransfersHandler struct{}
+
+func (UnimplementedTransfersHandler) Transfer(context.Context, *connect.Request[v1.TransferRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Transfers.Transfer is not implemented"))
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/context_resolvers.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SpacesName is the fully-qualified name of the Spaces service.
+	SpacesName = "test.v1.Spaces"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SpacesGetSpaceProcedure is the fully-qualified name of the Spaces's GetSpace RPC.
+	SpacesGetSpaceProcedure = "/test.v1.Spaces/GetSpace"
+	// SpacesRenameDashboardsProcedure is the fully-qualified name of the Spaces's RenameDashboards RPC.
+	SpacesRenameDashboardsProcedure = "/test.v1.Spaces/RenameDashboards"
+	// SpacesArchiveSpaceProcedure is the fully-qualified name of the Spaces's ArchiveSpace RPC.
+	SpacesArchiveSpaceProcedure = "/test.v1.Spaces/ArchiveSpace"
+)
+
+// SpacesClient is a client for the test.v1.Spaces service.
+type SpacesClient interface {
+	GetSpace(context.Context, *connect.Request[v1.GetSpaceRequest]) (*connect.Response[v1.Response], error)
+	RenameDashboards(context.Context, *connect.Request[v1.RenameDashboardsRequest]) (*connect.Response[v1.Response], error)
+	ArchiveSpace(context.Context, *connect.Request[v1.ArchiveSpaceRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewSpacesClient constructs a client for the test.v1.Spaces service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSpacesClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SpacesClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	spacesMethods := v1.File_test_v1_context_resolvers_proto.Services().ByName("Spaces").Methods()
+	return &spacesClient{
+		getSpace: connect.NewClient[v1.GetSpaceRequest, v1.Response](
+			httpClient,
+			baseURL+SpacesGetSpaceProcedure,
+			connect.WithSchema(spacesMethods.ByName("GetSpace")),
+			connect.WithClientOptions(opts...),
+		),
+		renameDashboards: connect.NewClient[v1.RenameDashboardsRequest, v1.Response](
+			httpClient,
+			baseURL+SpacesRenameDashboardsProcedure,
+			connect.WithSchema(spacesMethods.ByName("RenameDashboards")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveSpace: connect.NewClient[v1.ArchiveSpaceRequest, v1.Response](
+			httpClient,
+			baseURL+SpacesArchiveSpaceProcedure,
+			connect.WithSchema(spacesMethods.ByName("ArchiveSpace")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// spacesClient implements SpacesClient.
+type spacesClient struct {
+	getSpace         *connect.Client[v1.GetSpaceRequest, v1.Response]
+	renameDashboards *connect.Client[v1.RenameDashboardsRequest, v1.Response]
+	archiveSpace     *connect.Client[v1.ArchiveSpaceRequest, v1.Response]
+}
+
+// GetSpace calls test.v1.Spaces.GetSpace.
+func (c *spacesClient) GetSpace(ctx context.Context, req *connect.Request[v1.GetSpaceRequest]) (*connect.Response[v1.Response], error) {
+	return c.getSpace.CallUnary(ctx, req)
+}
+
+// RenameDashboards calls test.v1.Spaces.RenameDashboards.
+func (c *spacesClient) RenameDashboards(ctx context.Context, req *connect.Request[v1.RenameDashboardsRequest]) (*connect.Response[v1.Response], error) {
+	return c.renameDashboards.CallUnary(ctx, req)
+}
+
+// ArchiveSpace calls test.v1.Spaces.ArchiveSpace.
+func (c *spacesClient) ArchiveSpace(ctx context.Context, req *connect.Request[v1.ArchiveSpaceRequest]) (*connect.Response[v1.Response], error) {
+	return c.archiveSpace.CallUnary(ctx, req)
+}
+
+// SpacesHandler is an implementation of the test.v1.Spaces service.
+type SpacesHandler interface {
+	GetSpace(context.Context, *connect.Request[v1.GetSpaceRequest]) (*connect.Response[v1.Response], error)
+	RenameDashboards(context.Context, *connect.Request[v1.RenameDashboardsRequest]) (*connect.Response[v1.Response], error)
+	ArchiveSpace(context.Context, *connect.Request[v1.ArchiveSpaceRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewSpacesHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSpacesHandler(svc SpacesHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	spacesMethods := v1.File_test_v1_context_resolvers_proto.Services().ByName("Spaces").Methods()
+	spacesGetSpaceHandler := connect.NewUnaryHandler(
+		SpacesGetSpaceProcedure,
+		svc.GetSpace,
+		connect.WithSchema(spacesMethods.ByName("GetSpace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	spacesRenameDashboardsHandler := connect.NewUnaryHandler(
+		SpacesRenameDashboardsProcedure,
+		svc.RenameDashboards,
+		connect.WithSchema(spacesMethods.ByName("RenameDashboards")),
+		connect.WithHandlerOptions(opts...),
+	)
+	spacesArchiveSpaceHandler := connect.NewUnaryHandler(
+		SpacesArchiveSpaceProcedure,
+		svc.ArchiveSpace,
+		connect.WithSchema(spacesMethods.ByName("ArchiveSpace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Spaces/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SpacesGetSpaceProcedure:
+			spacesGetSpaceHandler.ServeHTTP(w, r)
+		case SpacesRenameDashboardsProcedure:
+			spacesRenameDashboardsHandler.ServeHTTP(w, r)
+		case SpacesArchiveSpaceProcedure:
+			spacesArchiveSpaceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSpacesHandler returns CodeUnimplemented from all methods.
+type UnimplementedSpacesHandler struct{}
+
+func (UnimplementedSpacesHandler) GetSpace(context.Context, *connect.Request[v1.GetSpaceRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Spaces.GetSpace is not implemented"))
+}
+
+func (UnimplementedSpacesHandler) RenameDashboards(context.Context, *connect.Request[v1.RenameDashboardsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Spaces.RenameDashboards is not implemented"))
+}
+
+func (UnimplementedSpacesHandler) ArchiveSpace(context.Context, *connect.Request[v1.ArchiveSpaceRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Spaces.ArchiveSpace is not implemented"))
+}
@@ -0,0 +1,108 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/contextual_tuples.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DraftsName is the fully-qualified name of the Drafts service.
+	DraftsName = "test.v1.Drafts"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DraftsCreateDraftProcedure is the fully-qualified name of the Drafts's CreateDraft RPC.
+	DraftsCreateDraftProcedure = "/test.v1.Drafts/CreateDraft"
+)
+
+// DraftsClient is a client for the test.v1.Drafts service.
+type DraftsClient interface {
+	CreateDraft(context.Context, *connect.Request[v1.CreateDraftRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewDraftsClient constructs a client for the test.v1.Drafts service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDraftsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DraftsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	draftsMethods := v1.File_test_v1_contextual_tuples_proto.Services().ByName("Drafts").Methods()
+	return &draftsClient{
+		createDraft: connect.NewClient[v1.CreateDraftRequest, v1.Response](
+			httpClient,
+			baseURL+DraftsCreateDraftProcedure,
+			connect.WithSchema(draftsMethods.ByName("CreateDraft")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// draftsClient implements DraftsClient.
+type draftsClient struct {
+	createDraft *connect.Client[v1.CreateDraftRequest, v1.Response]
+}
+
+// CreateDraft calls test.v1.Drafts.CreateDraft.
+func (c *draftsClient) CreateDraft(ctx context.Context, req *connect.Request[v1.CreateDraftRequest]) (*connect.Response[v1.Response], error) {
+	return c.createDraft.CallUnary(ctx, req)
+}
+
+// DraftsHandler is an implementation of the test.v1.Drafts service.
+type DraftsHandler interface {
+	CreateDraft(context.Context, *connect.Request[v1.CreateDraftRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewDraftsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDraftsHandler(svc DraftsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	draftsMethods := v1.File_test_v1_contextual_tuples_proto.Services().ByName("Drafts").Methods()
+	draftsCreateDraftHandler := connect.NewUnaryHandler(
+		DraftsCreateDraftProcedure,
+		svc.CreateDraft,
+		connect.WithSchema(draftsMethods.ByName("CreateDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Drafts/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DraftsCreateDraftProcedure:
+			draftsCreateDraftHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDraftsHandler returns CodeUnimplemented from all methods.
+type UnimplementedDraftsHandler struct{}
+
+func (UnimplementedDraftsHandler) CreateDraft(context.Context, *connect.Request[v1.CreateDraftRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Drafts.CreateDraft is not implemented"))
+}
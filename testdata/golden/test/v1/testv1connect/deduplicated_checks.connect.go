@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/deduplicated_checks.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ItemsName is the fully-qualified name of the Items service.
+	ItemsName = "test.v1.Items"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ItemsMoveItemsProcedure is the fully-qualified name of the Items's MoveItems RPC.
+	ItemsMoveItemsProcedure = "/test.v1.Items/MoveItems"
+	// ItemsApplyTagsProcedure is the fully-qualified name of the Items's ApplyTags RPC.
+	ItemsApplyTagsProcedure = "/test.v1.Items/ApplyTags"
+)
+
+// ItemsClient is a client for the test.v1.Items service.
+type ItemsClient interface {
+	MoveItems(context.Context, *connect.Request[v1.MoveItemsRequest]) (*connect.Response[v1.Response], error)
+	ApplyTags(context.Context, *connect.Request[v1.ApplyTagsRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewItemsClient constructs a client for the test.v1.Items service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewItemsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ItemsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	itemsMethods := v1.File_test_v1_deduplicated_checks_proto.Services().ByName("Items").Methods()
+	return &itemsClient{
+		moveItems: connect.NewClient[v1.MoveItemsRequest, v1.Response](
+			httpClient,
+			baseURL+ItemsMoveItemsProcedure,
+			connect.WithSchema(itemsMethods.ByName("MoveItems")),
+			connect.WithClientOptions(opts...),
+		),
+		applyTags: connect.NewClient[v1.ApplyTagsRequest, v1.Response](
+			httpClient,
+			baseURL+ItemsApplyTagsProcedure,
+			connect.WithSchema(itemsMethods.ByName("ApplyTags")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// itemsClient implements ItemsClient.
+type itemsClient struct {
+	moveItems *connect.Client[v1.MoveItemsRequest, v1.Response]
+	applyTags *connect.Client[v1.ApplyTagsRequest, v1.Response]
+}
+
+// MoveItems calls test.v1.Items.MoveItems.
+func (c *itemsClient) MoveItems(ctx context.Context, req *connect.Request[v1.MoveItemsRequest]) (*connect.Response[v1.Response], error) {
+	return c.moveItems.CallUnary(ctx, req)
+}
+
+// ApplyTags calls test.v1.Items.ApplyTags.
+func (c *itemsClient) ApplyTags(ctx context.Context, req *connect.Request[v1.ApplyTagsRequest]) (*connect.Response[v1.Response], error) {
+	return c.applyTags.CallUnary(ctx, req)
+}
+
+// ItemsHandler is an implementation of the test.v1.Items service.
+type ItemsHandler interface {
+	MoveItems(context.Context, *connect.Request[v1.MoveItemsRequest]) (*connect.Response[v1.Response], error)
+	ApplyTags(context.Context, *connect.Request[v1.ApplyTagsRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewItemsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewItemsHandler(svc ItemsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	itemsMethods := v1.File_test_v1_deduplicated_checks_proto.Services().ByName("Items").Methods()
+	itemsMoveItemsHandler := connect.NewUnaryHandler(
+		ItemsMoveItemsProcedure,
+		svc.MoveItems,
+		connect.WithSchema(itemsMethods.ByName("MoveItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	itemsApplyTagsHandler := connect.NewUnaryHandler(
+		ItemsApplyTagsProcedure,
+		svc.ApplyTags,
+		connect.WithSchema(itemsMethods.ByName("ApplyTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Items/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ItemsMoveItemsProcedure:
+			itemsMoveItemsHandler.ServeHTTP(w, r)
+		case ItemsApplyTagsProcedure:
+			itemsApplyTagsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedItemsHandler returns CodeUnimplemented from all methods.
+type UnimplementedItemsHandler struct{}
+
+func (UnimplementedItemsHandler) MoveItems(context.Context, *connect.Request[v1.MoveItemsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Items.MoveItems is not implemented"))
+}
+
+func (UnimplementedItemsHandler) ApplyTags(context.Context, *connect.Request[v1.ApplyTagsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Items.ApplyTags is not implemented"))
+}
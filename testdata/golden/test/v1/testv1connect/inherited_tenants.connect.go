@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/inherited_tenants.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// IssuesName is the fully-qualified name of the Issues service.
+	IssuesName = "test.v1.Issues"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// IssuesListIssuesProcedure is the fully-qualified name of the Issues's ListIssues RPC.
+	IssuesListIssuesProcedure = "/test.v1.Issues/ListIssues"
+	// IssuesReopenIssueProcedure is the fully-qualified name of the Issues's ReopenIssue RPC.
+	IssuesReopenIssueProcedure = "/test.v1.Issues/ReopenIssue"
+	// IssuesMoveIssuesProcedure is the fully-qualified name of the Issues's MoveIssues RPC.
+	IssuesMoveIssuesProcedure = "/test.v1.Issues/MoveIssues"
+	// IssuesFileIssuesProcedure is the fully-qualified name of the Issues's FileIssues RPC.
+	IssuesFileIssuesProcedure = "/test.v1.Issues/FileIssues"
+	// IssuesRouteIssueProcedure is the fully-qualified name of the Issues's RouteIssue RPC.
+	IssuesRouteIssueProcedure = "/test.v1.Issues/RouteIssue"
+)
+
+// IssuesClient is a client for the test.v1.Issues service.
+type IssuesClient interface {
+	ListIssues(context.Context, *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.Response], error)
+	ReopenIssue(context.Context, *connect.Request[v1.ReopenIssueRequest]) (*connect.Response[v1.Response], error)
+	MoveIssues(context.Context, *connect.Request[v1.MoveIssuesRequest]) (*connect.Response[v1.Response], error)
+	FileIssues(context.Context, *connect.Request[v1.FileIssuesRequest]) (*connect.Response[v1.Response], error)
+	RouteIssue(context.Context, *connect.Request[v1.RouteIssueRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewIssuesClient constructs a client for the test.v1.Issues service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewIssuesClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) IssuesClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	issuesMethods := v1.File_test_v1_inherited_tenants_proto.Services().ByName("Issues").Methods()
+	return &issuesClient{
+		listIssues: connect.NewClient[v1.ListIssuesRequest, v1.Response](
+			httpClient,
+			baseURL+IssuesListIssuesProcedure,
+			connect.WithSchema(issuesMethods.ByName("ListIssues")),
+			connect.WithClientOptions(opts...),
+		),
+		reopenIssue: connect.NewClient[v1.ReopenIssueRequest, v1.Response](
+			httpClient,
+			baseURL+IssuesReopenIssueProcedure,
+			connect.WithSchema(issuesMethods.ByName("ReopenIssue")),
+			connect.WithClientOptions(opts...),
+		),
+		moveIssues: connect.NewClient[v1.MoveIssuesRequest, v1.Response](
+			httpClient,
+			baseURL+IssuesMoveIssuesProcedure,
+			connect.WithSchema(issuesMethods.ByName("MoveIssues")),
+			connect.WithClientOptions(opts...),
+		),
+		fileIssues: connect.NewClient[v1.FileIssuesRequest, v1.Response](
+			httpClient,
+			baseURL+IssuesFileIssuesProcedure,
+			connect.WithSchema(issuesMethods.ByName("FileIssues")),
+			connect.WithClientOptions(opts...),
+		),
+		routeIssue: connect.NewClient[v1.RouteIssueRequest, v1.Response](
+			httpClient,
+			baseURL+IssuesRouteIssueProcedure,
+			connect.WithSchema(issuesMethods.ByName("RouteIssue")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// issuesClient implements IssuesClient.
+type issuesClient struct {
+	listIssues  *connect.Client[v1.ListIssuesRequest, v1.Response]
+	reopenIssue *connect.Client[v1.ReopenIssueRequest, v1.Response]
+	moveIssues  *connect.Client[v1.MoveIssuesRequest, v1.Response]
+	fileIssues  *connect.Client[v1.FileIssuesRequest, v1.Response]
+	routeIssue  *connect.Client[v1.RouteIssueRequest, v1.Response]
+}
+
+// ListIssues calls test.v1.Issues.ListIssues.
+func (c *issuesClient) ListIssues(ctx context.Context, req *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.Response], error) {
+	return c.listIssues.CallUnary(ctx, req)
+}
+
+// ReopenIssue calls test.v1.Issues.ReopenIssue.
+func (c *issuesClient) ReopenIssue(ctx context.Context, req *connect.Request[v1.ReopenIssueRequest]) (*connect.Response[v1.Response], error) {
+	return c.reopenIssue.CallUnary(ctx, req)
+}
+
+// MoveIssues calls test.v1.Issues.MoveIssues.
+func (c *issuesClient) MoveIssues(ctx context.Context, req *connect.Request[v1.MoveIssuesRequest]) (*connect.Response[v1.Response], error) {
+	return c.moveIssues.CallUnary(ctx, req)
+}
+
+// FileIssues calls test.v1.Issues.FileIssues.
+func (c *issuesClient) FileIssues(ctx context.Context, req *connect.Request[v1.FileIssuesRequest]) (*connect.Response[v1.Response], error) {
+	return c.fileIssues.CallUnary(ctx, req)
+}
+
+// RouteIssue calls test.v1.Issues.RouteIssue.
+func (c *issuesClient) RouteIssue(ctx context.Context, req *connect.Request[v1.RouteIssueRequest]) (*connect.Response[v1.Response], error) {
+	return c.routeIssue.CallUnary(ctx, req)
+}
+
+// IssuesHandler is an implementation of the test.v1.Issues service.
+type IssuesHandler interface {
+	ListIssues(context.Context, *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.Response], error)
+	ReopenIssue(context.Context, *connect.Request[v1.ReopenIssueRequest]) (*connect.Response[v1.Response], error)
+	MoveIssues(context.Context, *connect.Request[v1.MoveIssuesRequest]) (*connect.Response[v1.Response], error)
+	FileIssues(context.Context, *connect.Request[v1.FileIssuesRequest]) (*connect.Response[v1.Response], error)
+	RouteIssue(context.Context, *connect.Request[v1.RouteIssueRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewIssuesHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewIssuesHandler(svc IssuesHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	issuesMethods := v1.File_test_v1_inherited_tenants_proto.Services().ByName("Issues").Methods()
+	issuesListIssuesHandler := connect.NewUnaryHandler(
+		IssuesListIssuesProcedure,
+		svc.ListIssues,
+		connect.WithSchema(issuesMethods.ByName("ListIssues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issuesReopenIssueHandler := connect.NewUnaryHandler(
+		IssuesReopenIssueProcedure,
+		svc.ReopenIssue,
+		connect.WithSchema(issuesMethods.ByName("ReopenIssue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issuesMoveIssuesHandler := connect.NewUnaryHandler(
+		IssuesMoveIssuesProcedure,
+		svc.MoveIssues,
+		connect.WithSchema(issuesMethods.ByName("MoveIssues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issuesFileIssuesHandler := connect.NewUnaryHandler(
+		IssuesFileIssuesProcedure,
+		svc.FileIssues,
+		connect.WithSchema(issuesMethods.ByName("FileIssues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issuesRouteIssueHandler := connect.NewUnaryHandler(
+		IssuesRouteIssueProcedure,
+		svc.RouteIssue,
+		connect.WithSchema(issuesMethods.ByName("RouteIssue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Issues/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case IssuesListIssuesProcedure:
+			issuesListIssuesHandler.ServeHTTP(w, r)
+		case IssuesReopenIssueProcedure:
+			issuesReopenIssueHandler.ServeHTTP(w, r)
+		case IssuesMoveIssuesProcedure:
+			issuesMoveIssuesHandler.ServeHTTP(w, r)
+		case IssuesFileIssuesProcedure:
+			issuesFileIssuesHandler.ServeHTTP(w, r)
+		case IssuesRouteIssueProcedure:
+			issuesRouteIssueHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedIssuesHandler returns CodeUnimplemented from all methods.
+type UnimplementedIssuesHandler struct{}
+
+func (UnimplementedIssuesHandler) ListIssues(context.Context, *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Issues.ListIssues is not implemented"))
+}
+
+func (UnimplementedIssuesHandler) ReopenIssue(context.Context, *connect.Request[v1.ReopenIssueRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Issues.ReopenIssue is not implemented"))
+}
+
+func (UnimplementedIssuesHandler) MoveIssues(context.Context, *connect.Request[v1.MoveIssuesRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Issues.MoveIssues is not implemented"))
+}
+
+func (UnimplementedIssuesHandler) FileIssues(context.Context, *connect.Request[v1.FileIssuesRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Issues.FileIssues is not implemented"))
+}
+
+func (UnimplementedIssuesHandler) RouteIssue(context.Context, *connect.Request[v1.RouteIssueRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Issues.RouteIssue is not implemented"))
+}
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/map_keys.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MapKeyServiceName is the fully-qualified name of the MapKeyService service.
+	MapKeyServiceName = "test.v1.MapKeyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MapKeyServiceUpdateFoldersProcedure is the fully-qualified name of the MapKeyService's
+	// UpdateFolders RPC.
+	MapKeyServiceUpdateFoldersProcedure = "/test.v1.MapKeyService/UpdateFolders"
+	// MapKeyServiceUpdateProjectsProcedure is the fully-qualified name of the MapKeyService's
+	// UpdateProjects RPC.
+	MapKeyServiceUpdateProjectsProcedure = "/test.v1.MapKeyService/UpdateProjects"
+	// MapKeyServiceUpdateDocumentsProcedure is the fully-qualified name of the MapKeyService's
+	// UpdateDocuments RPC.
+	MapKeyServiceUpdateDocumentsProcedure = "/test.v1.MapKeyService/UpdateDocuments"
+	// MapKeyServiceUpdatePinnedDocumentsProcedure is the fully-qualified name of the MapKeyService's
+	// UpdatePinnedDocuments RPC.
+	MapKeyServiceUpdatePinnedDocumentsProcedure = "/test.v1.MapKeyService/UpdatePinnedDocuments"
+)
+
+// MapKeyServiceClient is a client for the test.v1.MapKeyService service.
+type MapKeyServiceClient interface {
+	UpdateFolders(context.Context, *connect.Request[v1.KeyedFolderRequest]) (*connect.Response[v1.Response], error)
+	UpdateProjects(context.Context, *connect.Request[v1.KeyedProjectRequest]) (*connect.Response[v1.Response], error)
+	UpdateDocuments(context.Context, *connect.Request[v1.KeyedDocumentRequest]) (*connect.Response[v1.Response], error)
+	UpdatePinnedDocuments(context.Context, *connect.Request[v1.PinnedDocumentRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewMapKeyServiceClient constructs a client for the test.v1.MapKeyService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMapKeyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MapKeyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	mapKeyServiceMethods := v1.File_test_v1_map_keys_proto.Services().ByName("MapKeyService").Methods()
+	return &mapKeyServiceClient{
+		updateFolders: connect.NewClient[v1.KeyedFolderRequest, v1.Response](
+			httpClient,
+			baseURL+MapKeyServiceUpdateFoldersProcedure,
+			connect.WithSchema(mapKeyServiceMethods.ByName("UpdateFolders")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProjects: connect.NewClient[v1.KeyedProjectRequest, v1.Response](
+			httpClient,
+			baseURL+MapKeyServiceUpdateProjectsProcedure,
+			connect.WithSchema(mapKeyServiceMethods.ByName("UpdateProjects")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDocuments: connect.NewClient[v1.KeyedDocumentRequest, v1.Response](
+			httpClient,
+			baseURL+MapKeyServiceUpdateDocumentsProcedure,
+			connect.WithSchema(mapKeyServiceMethods.ByName("UpdateDocuments")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePinnedDocuments: connect.NewClient[v1.PinnedDocumentRequest, v1.Response](
+			httpClient,
+			baseURL+MapKeyServiceUpdatePinnedDocumentsProcedure,
+			connect.WithSchema(mapKeyServiceMethods.ByName("UpdatePinnedDocuments")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// mapKeyServiceClient implements MapKeyServiceClient.
+type mapKeyServiceClient struct {
+	updateFolders         *connect.Client[v1.KeyedFolderRequest, v1.Response]
+	updateProjects        *connect.Client[v1.KeyedProjectRequest, v1.Response]
+	updateDocuments       *connect.Client[v1.KeyedDocumentRequest, v1.Response]
+	updatePinnedDocuments *connect.Client[v1.PinnedDocumentRequest, v1.Response]
+}
+
+// UpdateFolders calls test.v1.MapKeyService.UpdateFolders.
+func (c *mapKeyServiceClient) UpdateFolders(ctx context.Context, req *connect.Request[v1.KeyedFolderRequest]) (*connect.Response[v1.Response], error) {
+	return c.updateFolders.CallUnary(ctx, req)
+}
+
+// UpdateProjects calls test.v1.MapKeyService.UpdateProjects.
+func (c *mapKeyServiceClient) UpdateProjects(ctx context.Context, req *connect.Request[v1.KeyedProjectRequest]) (*connect.Response[v1.Response], error) {
+	return c.updateProjects.CallUnary(ctx, req)
+}
+
+// UpdateDocuments calls test.v1.MapKeyService.UpdateDocuments.
+func (c *mapKeyServiceClient) UpdateDocuments(ctx context.Context, req *connect.Request[v1.KeyedDocumentRequest]) (*connect.Response[v1.Response], error) {
+	return c.updateDocuments.CallUnary(ctx, req)
+}
+
+// UpdatePinnedDocuments calls test.v1.MapKeyService.UpdatePinnedDocuments.
+func (c *mapKeyServiceClient) UpdatePinnedDocuments(ctx context.Context, req *connect.Request[v1.PinnedDocumentRequest]) (*connect.Response[v1.Response], error) {
+	return c.updatePinnedDocuments.CallUnary(ctx, req)
+}
+
+// MapKeyServiceHandler is an implementation of the test.v1.MapKeyService service.
+type MapKeyServiceHandler interface {
+	UpdateFolders(context.Context, *connect.Request[v1.KeyedFolderRequest]) (*connect.Response[v1.Response], error)
+	UpdateProjects(context.Context, *connect.Request[v1.KeyedProjectRequest]) (*connect.Response[v1.Response], error)
+	UpdateDocuments(context.Context, *connect.Request[v1.KeyedDocumentRequest]) (*connect.Response[v1.Response], error)
+	UpdatePinnedDocuments(context.Context, *connect.Request[v1.PinnedDocumentRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewMapKeyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMapKeyServiceHandler(svc MapKeyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	mapKeyServiceMethods := v1.File_test_v1_map_keys_proto.Services().ByName("MapKeyService").Methods()
+	mapKeyServiceUpdateFoldersHandler := connect.NewUnaryHandler(
+		MapKeyServiceUpdateFoldersProcedure,
+		svc.UpdateFolders,
+		connect.WithSchema(mapKeyServiceMethods.ByName("UpdateFolders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mapKeyServiceUpdateProjectsHandler := connect.NewUnaryHandler(
+		MapKeyServiceUpdateProjectsProcedure,
+		svc.UpdateProjects,
+		connect.WithSchema(mapKeyServiceMethods.ByName("UpdateProjects")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mapKeyServiceUpdateDocumentsHandler := connect.NewUnaryHandler(
+		MapKeyServiceUpdateDocumentsProcedure,
+		svc.UpdateDocuments,
+		connect.WithSchema(mapKeyServiceMethods.ByName("UpdateDocuments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mapKeyServiceUpdatePinnedDocumentsHandler := connect.NewUnaryHandler(
+		MapKeyServiceUpdatePinnedDocumentsProcedure,
+		svc.UpdatePinnedDocuments,
+		connect.WithSchema(mapKeyServiceMethods.ByName("UpdatePinnedDocuments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.MapKeyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MapKeyServiceUpdateFoldersProcedure:
+			mapKeyServiceUpdateFoldersHandler.ServeHTTP(w, r)
+		case MapKeyServiceUpdateProjectsProcedure:
+			mapKeyServiceUpdateProjectsHandler.ServeHTTP(w, r)
+		case MapKeyServiceUpdateDocumentsProcedure:
+			mapKeyServiceUpdateDocumentsHandler.ServeHTTP(w, r)
+		case MapKeyServiceUpdatePinnedDocumentsProcedure:
+			mapKeyServiceUpdatePinnedDocumentsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMapKeyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMapKeyServiceHandler struct{}
+
+func (UnimplementedMapKeyServiceHandler) UpdateFolders(context.Context, *connect.Request[v1.KeyedFolderRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.MapKeyService.UpdateFolders is not implemented"))
+}
+
+func (UnimplementedMapKeyServiceHandler) UpdateProjects(context.Context, *connect.Request[v1.KeyedProjectRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.MapKeyService.UpdateProjects is not implemented"))
+}
+
+func (UnimplementedMapKeyServiceHandler) UpdateDocuments(context.Context, *connect.Request[v1.KeyedDocumentRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.MapKeyService.UpdateDocuments is not implemented"))
+}
+
+func (UnimplementedMapKeyServiceHandler) UpdatePinnedDocuments(context.Context, *connect.Request[v1.PinnedDocumentRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.MapKeyService.UpdatePinnedDocuments is not implemented"))
+}
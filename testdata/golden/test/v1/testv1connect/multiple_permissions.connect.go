@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/multiple_permissions.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// VaultsName is the fully-qualified name of the Vaults service.
+	VaultsName = "test.v1.Vaults"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// VaultsTransferOwnershipProcedure is the fully-qualified name of the Vaults's TransferOwnership
+	// RPC.
+	VaultsTransferOwnershipProcedure = "/test.v1.Vaults/TransferOwnership"
+	// VaultsPurgeVaultsProcedure is the fully-qualified name of the Vaults's PurgeVaults RPC.
+	VaultsPurgeVaultsProcedure = "/test.v1.Vaults/PurgeVaults"
+	// VaultsCreateVaultProcedure is the fully-qualified name of the Vaults's CreateVault RPC.
+	VaultsCreateVaultProcedure = "/test.v1.Vaults/CreateVault"
+	// VaultsAuditProcedure is the fully-qualified name of the Vaults's Audit RPC.
+	VaultsAuditProcedure = "/test.v1.Vaults/Audit"
+	// VaultsViewVaultProcedure is the fully-qualified name of the Vaults's ViewVault RPC.
+	VaultsViewVaultProcedure = "/test.v1.Vaults/ViewVault"
+)
+
+// VaultsClient is a client for the test.v1.Vaults service.
+type VaultsClient interface {
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.Response], error)
+	PurgeVaults(context.Context, *connect.Request[v1.PurgeVaultsRequest]) (*connect.Response[v1.Response], error)
+	CreateVault(context.Context, *connect.Request[v1.CreateVaultRequest]) (*connect.Response[v1.Response], error)
+	Audit(context.Context, *connect.Request[v1.AuditRequest]) (*connect.Response[v1.Response], error)
+	ViewVault(context.Context, *connect.Request[v1.ViewVaultRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewVaultsClient constructs a client for the test.v1.Vaults service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewVaultsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VaultsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	vaultsMethods := v1.File_test_v1_multiple_permissions_proto.Services().ByName("Vaults").Methods()
+	return &vaultsClient{
+		transferOwnership: connect.NewClient[v1.TransferOwnershipRequest, v1.Response](
+			httpClient,
+			baseURL+VaultsTransferOwnershipProcedure,
+			connect.WithSchema(vaultsMethods.ByName("TransferOwnership")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeVaults: connect.NewClient[v1.PurgeVaultsRequest, v1.Response](
+			httpClient,
+			baseURL+VaultsPurgeVaultsProcedure,
+			connect.WithSchema(vaultsMethods.ByName("PurgeVaults")),
+			connect.WithClientOptions(opts...),
+		),
+		createVault: connect.NewClient[v1.CreateVaultRequest, v1.Response](
+			httpClient,
+			baseURL+VaultsCreateVaultProcedure,
+			connect.WithSchema(vaultsMethods.ByName("CreateVault")),
+			connect.WithClientOptions(opts...),
+		),
+		audit: connect.NewClient[v1.AuditRequest, v1.Response](
+			httpClient,
+			baseURL+VaultsAuditProcedure,
+			connect.WithSchema(vaultsMethods.ByName("Audit")),
+			connect.WithClientOptions(opts...),
+		),
+		viewVault: connect.NewClient[v1.ViewVaultRequest, v1.Response](
+			httpClient,
+			baseURL+VaultsViewVaultProcedure,
+			connect.WithSchema(vaultsMethods.ByName("ViewVault")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// vaultsClient implements VaultsClient.
+type vaultsClient struct {
+	transferOwnership *connect.Client[v1.TransferOwnershipRequest, v1.Response]
+	purgeVaults       *connect.Client[v1.PurgeVaultsRequest, v1.Response]
+	createVault       *connect.Client[v1.CreateVaultRequest, v1.Response]
+	audit             *connect.Client[v1.AuditRequest, v1.Response]
+	viewVault         *connect.Client[v1.ViewVaultRequest, v1.Response]
+}
+
+// TransferOwnership calls test.v1.Vaults.TransferOwnership.
+func (c *vaultsClient) TransferOwnership(ctx context.Context, req *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.Response], error) {
+	return c.transferOwnership.CallUnary(ctx, req)
+}
+
+// PurgeVaults calls test.v1.Vaults.PurgeVaults.
+func (c *vaultsClient) PurgeVaults(ctx context.Context, req *connect.Request[v1.PurgeVaultsRequest]) (*connect.Response[v1.Response], error) {
+	return c.purgeVaults.CallUnary(ctx, req)
+}
+
+// CreateVault calls test.v1.Vaults.CreateVault.
+func (c *vaultsClient) CreateVault(ctx context.Context, req *connect.Request[v1.CreateVaultRequest]) (*connect.Response[v1.Response], error) {
+	return c.createVault.CallUnary(ctx, req)
+}
+
+// Audit calls test.v1.Vaults.Audit.
+func (c *vaultsClient) Audit(ctx context.Context, req *connect.Request[v1.AuditRequest]) (*connect.Response[v1.Response], error) {
+	return c.audit.CallUnary(ctx, req)
+}
+
+// ViewVault calls test.v1.Vaults.ViewVault.
+func (c *vaultsClient) ViewVault(ctx context.Context, req *connect.Request[v1.ViewVaultRequest]) (*connect.Response[v1.Response], error) {
+	return c.viewVault.CallUnary(ctx, req)
+}
+
+// VaultsHandler is an implementation of the test.v1.Vaults service.
+type VaultsHandler interface {
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.Response], error)
+	PurgeVaults(context.Context, *connect.Request[v1.PurgeVaultsRequest]) (*connect.Response[v1.Response], error)
+	CreateVault(context.Context, *connect.Request[v1.CreateVaultRequest]) (*connect.Response[v1.Response], error)
+	Audit(context.Context, *connect.Request[v1.AuditRequest]) (*connect.Response[v1.Response], error)
+	ViewVault(context.Context, *connect.Request[v1.ViewVaultRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewVaultsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewVaultsHandler(svc VaultsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	vaultsMethods := v1.File_test_v1_multiple_permissions_proto.Services().ByName("Vaults").Methods()
+	vaultsTransferOwnershipHandler := connect.NewUnaryHandler(
+		VaultsTransferOwnershipProcedure,
+		svc.TransferOwnership,
+		connect.WithSchema(vaultsMethods.ByName("TransferOwnership")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultsPurgeVaultsHandler := connect.NewUnaryHandler(
+		VaultsPurgeVaultsProcedure,
+		svc.PurgeVaults,
+		connect.WithSchema(vaultsMethods.ByName("PurgeVaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultsCreateVaultHandler := connect.NewUnaryHandler(
+		VaultsCreateVaultProcedure,
+		svc.CreateVault,
+		connect.WithSchema(vaultsMethods.ByName("CreateVault")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultsAuditHandler := connect.NewUnaryHandler(
+		VaultsAuditProcedure,
+		svc.Audit,
+		connect.WithSchema(vaultsMethods.ByName("Audit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultsViewVaultHandler := connect.NewUnaryHandler(
+		VaultsViewVaultProcedure,
+		svc.ViewVault,
+		connect.WithSchema(vaultsMethods.ByName("ViewVault")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Vaults/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case VaultsTransferOwnershipProcedure:
+			vaultsTransferOwnershipHandler.ServeHTTP(w, r)
+		case VaultsPurgeVaultsProcedure:
+			vaultsPurgeVaultsHandler.ServeHTTP(w, r)
+		case VaultsCreateVaultProcedure:
+			vaultsCreateVaultHandler.ServeHTTP(w, r)
+		case VaultsAuditProcedure:
+			vaultsAuditHandler.ServeHTTP(w, r)
+		case VaultsViewVaultProcedure:
+			vaultsViewVaultHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedVaultsHandler returns CodeUnimplemented from all methods.
+type UnimplementedVaultsHandler struct{}
+
+func (UnimplementedVaultsHandler) TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Vaults.TransferOwnership is not implemented"))
+}
+
+func (UnimplementedVaultsHandler) PurgeVaults(context.Context, *connect.Request[v1.PurgeVaultsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Vaults.PurgeVaults is not implemented"))
+}
+
+func (UnimplementedVaultsHandler) CreateVault(context.Context, *connect.Request[v1.CreateVaultRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Vaults.CreateVault is not implemented"))
+}
+
+func (UnimplementedVaultsHandler) Audit(context.Context, *connect.Request[v1.AuditRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Vaults.Audit is not implemented"))
+}
+
+func (UnimplementedVaultsHandler) ViewVault(context.Context, *connect.Request[v1.ViewVaultRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Vaults.ViewVault is not implemented"))
+}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/oneof_resources.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ShareServiceName is the fully-qualified name of the ShareService service.
+	ShareServiceName = "test.v1.ShareService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ShareServiceShareProcedure is the fully-qualified name of the ShareService's Share RPC.
+	ShareServiceShareProcedure = "/test.v1.ShareService/Share"
+	// ShareServiceShareRequiredProcedure is the fully-qualified name of the ShareService's
+	// ShareRequired RPC.
+	ShareServiceShareRequiredProcedure = "/test.v1.ShareService/ShareRequired"
+	// ShareServiceBatchShareProcedure is the fully-qualified name of the ShareService's BatchShare RPC.
+	ShareServiceBatchShareProcedure = "/test.v1.ShareService/BatchShare"
+	// ShareServiceShareLinkProcedure is the fully-qualified name of the ShareService's ShareLink RPC.
+	ShareServiceShareLinkProcedure = "/test.v1.ShareService/ShareLink"
+	// ShareServiceShareDeniedProcedure is the fully-qualified name of the ShareService's ShareDenied
+	// RPC.
+	ShareServiceShareDeniedProcedure = "/test.v1.ShareService/ShareDenied"
+)
+
+// ShareServiceClient is a client for the test.v1.ShareService service.
+type ShareServiceClient interface {
+	Share(context.Context, *connect.Request[v1.ShareRequest]) (*connect.Response[v1.Response], error)
+	ShareRequired(context.Context, *connect.Request[v1.RequiredShareRequest]) (*connect.Response[v1.Response], error)
+	BatchShare(context.Context, *connect.Request[v1.BatchShareRequest]) (*connect.Response[v1.Response], error)
+	ShareLink(context.Context, *connect.Request[v1.LinkShareRequest]) (*connect.Response[v1.Response], error)
+	ShareDenied(context.Context, *connect.Request[v1.DeniedShareRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewShareServiceClient constructs a client for the test.v1.ShareService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewShareServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ShareServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	shareServiceMethods := v1.File_test_v1_oneof_resources_proto.Services().ByName("ShareService").Methods()
+	return &shareServiceClient{
+		share: connect.NewClient[v1.ShareRequest, v1.Response](
+			httpClient,
+			baseURL+ShareServiceShareProcedure,
+			connect.WithSchema(shareServiceMethods.ByName("Share")),
+			connect.WithClientOptions(opts...),
+		),
+		shareRequired: connect.NewClient[v1.RequiredShareRequest, v1.Response](
+			httpClient,
+			baseURL+ShareServiceShareRequiredProcedure,
+			connect.WithSchema(shareServiceMethods.ByName("ShareRequired")),
+			connect.WithClientOptions(opts...),
+		),
+		batchShare: connect.NewClient[v1.BatchShareRequest, v1.Response](
+			httpClient,
+			baseURL+ShareServiceBatchShareProcedure,
+			connect.WithSchema(shareServiceMethods.ByName("BatchShare")),
+			connect.WithClientOptions(opts...),
+		),
+		shareLink: connect.NewClient[v1.LinkShareRequest, v1.Response](
+			httpClient,
+			baseURL+ShareServiceShareLinkProcedure,
+			connect.WithSchema(shareServiceMethods.ByName("ShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		shareDenied: connect.NewClient[v1.DeniedShareRequest, v1.Response](
+			httpClient,
+			baseURL+ShareServiceShareDeniedProcedure,
+			connect.WithSchema(shareServiceMethods.ByName("ShareDenied")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// shareServiceClient implements ShareServiceClient.
+type shareServiceClient struct {
+	share         *connect.Client[v1.ShareRequest, v1.Response]
+	shareRequired *connect.Client[v1.RequiredShareRequest, v1.Response]
+	batchShare    *connect.Client[v1.BatchShareRequest, v1.Response]
+	shareLink     *connect.Client[v1.LinkShareRequest, v1.Response]
+	shareDenied   *connect.Client[v1.DeniedShareRequest, v1.Response]
+}
+
+// Share calls test.v1.ShareService.Share.
+func (c *shareServiceClient) Share(ctx context.Context, req *connect.Request[v1.ShareRequest]) (*connect.Response[v1.Response], error) {
+	return c.share.CallUnary(ctx, req)
+}
+
+// ShareRequired calls test.v1.ShareService.ShareRequired.
+func (c *shareServiceClient) ShareRequired(ctx context.Context, req *connect.Request[v1.RequiredShareRequest]) (*connect.Response[v1.Response], error) {
+	return c.shareRequired.CallUnary(ctx, req)
+}
+
+// BatchShare calls test.v1.ShareService.BatchShare.
+func (c *shareServiceClient) BatchShare(ctx context.Context, req *connect.Request[v1.BatchShareRequest]) (*connect.Response[v1.Response], error) {
+	return c.batchShare.CallUnary(ctx, req)
+}
+
+// ShareLink calls test.v1.ShareService.ShareLink.
+func (c *shareServiceClient) ShareLink(ctx context.Context, req *connect.Request[v1.LinkShareRequest]) (*connect.Response[v1.Response], error) {
+	return c.shareLink.CallUnary(ctx, req)
+}
+
+// ShareDenied calls test.v1.ShareService.ShareDenied.
+func (c *shareServiceClient) ShareDenied(ctx context.Context, req *connect.Request[v1.DeniedShareRequest]) (*connect.Response[v1.Response], error) {
+	return c.shareDenied.CallUnary(ctx, req)
+}
+
+// ShareServiceHandler is an implementation of the test.v1.ShareService service.
+type ShareServiceHandler interface {
+	Share(context.Context, *connect.Request[v1.ShareRequest]) (*connect.Response[v1.Response], error)
+	ShareRequired(context.Context, *connect.Request[v1.RequiredShareRequest]) (*connect.Response[v1.Response], error)
+	BatchShare(context.Context, *connect.Request[v1.BatchShareRequest]) (*connect.Response[v1.Response], error)
+	ShareLink(context.Context, *connect.Request[v1.LinkShareRequest]) (*connect.Response[v1.Response], error)
+	ShareDenied(context.Context, *connect.Request[v1.DeniedShareRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewShareServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewShareServiceHandler(svc ShareServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	shareServiceMethods := v1.File_test_v1_oneof_resources_proto.Services().ByName("ShareService").Methods()
+	shareServiceShareHandler := connect.NewUnaryHandler(
+		ShareServiceShareProcedure,
+		svc.Share,
+		connect.WithSchema(shareServiceMethods.ByName("Share")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shareServiceShareRequiredHandler := connect.NewUnaryHandler(
+		ShareServiceShareRequiredProcedure,
+		svc.ShareRequired,
+		connect.WithSchema(shareServiceMethods.ByName("ShareRequired")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shareServiceBatchShareHandler := connect.NewUnaryHandler(
+		ShareServiceBatchShareProcedure,
+		svc.BatchShare,
+		connect.WithSchema(shareServiceMethods.ByName("BatchShare")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shareServiceShareLinkHandler := connect.NewUnaryHandler(
+		ShareServiceShareLinkProcedure,
+		svc.ShareLink,
+		connect.WithSchema(shareServiceMethods.ByName("ShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shareServiceShareDeniedHandler := connect.NewUnaryHandler(
+		ShareServiceShareDeniedProcedure,
+		svc.ShareDenied,
+		connect.WithSchema(shareServiceMethods.ByName("ShareDenied")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.ShareService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ShareServiceShareProcedure:
+			shareServiceShareHandler.ServeHTTP(w, r)
+		case ShareServiceShareRequiredProcedure:
+			shareServiceShareRequiredHandler.ServeHTTP(w, r)
+		case ShareServiceBatchShareProcedure:
+			shareServiceBatchShareHandler.ServeHTTP(w, r)
+		case ShareServiceShareLinkProcedure:
+			shareServiceShareLinkHandler.ServeHTTP(w, r)
+		case ShareServiceShareDeniedProcedure:
+			shareServiceShareDeniedHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedShareServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedShareServiceHandler struct{}
+
+func (UnimplementedShareServiceHandler) Share(context.Context, *connect.Request[v1.ShareRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.ShareService.Share is not implemented"))
+}
+
+func (UnimplementedShareServiceHandler) ShareRequired(context.Context, *connect.Request[v1.RequiredShareRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.ShareService.ShareRequired is not implemented"))
+}
+
+func (UnimplementedShareServiceHandler) BatchShare(context.Context, *connect.Request[v1.BatchShareRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.ShareService.BatchShare is not implemented"))
+}
+
+func (UnimplementedShareServiceHandler) ShareLink(context.Context, *connect.Request[v1.LinkShareRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.ShareService.ShareLink is not implemented"))
+}
+
+func (UnimplementedShareServiceHandler) ShareDenied(context.Context, *connect.Request[v1.DeniedShareRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.ShareService.ShareDenied is not implemented"))
+}
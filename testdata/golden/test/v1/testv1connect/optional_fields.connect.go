@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/optional_fields.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OptionalFieldServiceName is the fully-qualified name of the OptionalFieldService service.
+	OptionalFieldServiceName = "test.v1.OptionalFieldService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OptionalFieldServiceGetDocumentProcedure is the fully-qualified name of the
+	// OptionalFieldService's GetDocument RPC.
+	OptionalFieldServiceGetDocumentProcedure = "/test.v1.OptionalFieldService/GetDocument"
+	// OptionalFieldServiceGetDocumentIfPresentProcedure is the fully-qualified name of the
+	// OptionalFieldService's GetDocumentIfPresent RPC.
+	OptionalFieldServiceGetDocumentIfPresentProcedure = "/test.v1.OptionalFieldService/GetDocumentIfPresent"
+	// OptionalFieldServiceGetRequiredDocumentProcedure is the fully-qualified name of the
+	// OptionalFieldService's GetRequiredDocument RPC.
+	OptionalFieldServiceGetRequiredDocumentProcedure = "/test.v1.OptionalFieldService/GetRequiredDocument"
+	// OptionalFieldServiceGetNestedDocumentProcedure is the fully-qualified name of the
+	// OptionalFieldService's GetNestedDocument RPC.
+	OptionalFieldServiceGetNestedDocumentProcedure = "/test.v1.OptionalFieldService/GetNestedDocument"
+	// OptionalFieldServiceGetHistoryProcedure is the fully-qualified name of the OptionalFieldService's
+	// GetHistory RPC.
+	OptionalFieldServiceGetHistoryProcedure = "/test.v1.OptionalFieldService/GetHistory"
+)
+
+// OptionalFieldServiceClient is a client for the test.v1.OptionalFieldService service.
+type OptionalFieldServiceClient interface {
+	GetDocument(context.Context, *connect.Request[v1.OptionalResourceRequest]) (*connect.Response[v1.Response], error)
+	GetDocumentIfPresent(context.Context, *connect.Request[v1.SkipMissingRequest]) (*connect.Response[v1.Response], error)
+	GetRequiredDocument(context.Context, *connect.Request[v1.DenyMissingRequest]) (*connect.Response[v1.Response], error)
+	GetNestedDocument(context.Context, *connect.Request[v1.NestedOptionalRequest]) (*connect.Response[v1.Response], error)
+	GetHistory(context.Context, *connect.Request[v1.OptionalHistory]) (*connect.Response[v1.Response], error)
+}
+
+// NewOptionalFieldServiceClient constructs a client for the test.v1.OptionalFieldService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOptionalFieldServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OptionalFieldServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	optionalFieldServiceMethods := v1.File_test_v1_optional_fields_proto.Services().ByName("OptionalFieldService").Methods()
+	return &optionalFieldServiceClient{
+		getDocument: connect.NewClient[v1.OptionalResourceRequest, v1.Response](
+			httpClient,
+			baseURL+OptionalFieldServiceGetDocumentProcedure,
+			connect.WithSchema(optionalFieldServiceMethods.ByName("GetDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		getDocumentIfPresent: connect.NewClient[v1.SkipMissingRequest, v1.Response](
+			httpClient,
+			baseURL+OptionalFieldServiceGetDocumentIfPresentProcedure,
+			connect.WithSchema(optionalFieldServiceMethods.ByName("GetDocumentIfPresent")),
+			connect.WithClientOptions(opts...),
+		),
+		getRequiredDocument: connect.NewClient[v1.DenyMissingRequest, v1.Response](
+			httpClient,
+			baseURL+OptionalFieldServiceGetRequiredDocumentProcedure,
+			connect.WithSchema(optionalFieldServiceMethods.ByName("GetRequiredDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		getNestedDocument: connect.NewClient[v1.NestedOptionalRequest, v1.Response](
+			httpClient,
+			baseURL+OptionalFieldServiceGetNestedDocumentProcedure,
+			connect.WithSchema(optionalFieldServiceMethods.ByName("GetNestedDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		getHistory: connect.NewClient[v1.OptionalHistory, v1.Response](
+			httpClient,
+			baseURL+OptionalFieldServiceGetHistoryProcedure,
+			connect.WithSchema(optionalFieldServiceMethods.ByName("GetHistory")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// optionalFieldServiceClient implements OptionalFieldServiceClient.
+type optionalFieldServiceClient struct {
+	getDocument          *connect.Client[v1.OptionalResourceRequest, v1.Response]
+	getDocumentIfPresent *connect.Client[v1.SkipMissingRequest, v1.Response]
+	getRequiredDocument  *connect.Client[v1.DenyMissingRequest, v1.Response]
+	getNestedDocument    *connect.Client[v1.NestedOptionalRequest, v1.Response]
+	getHistory           *connect.Client[v1.OptionalHistory, v1.Response]
+}
+
+// GetDocument calls test.v1.OptionalFieldService.GetDocument.
+func (c *optionalFieldServiceClient) GetDocument(ctx context.Context, req *connect.Request[v1.OptionalResourceRequest]) (*connect.Response[v1.Response], error) {
+	return c.getDocument.CallUnary(ctx, req)
+}
+
+// GetDocumentIfPresent calls test.v1.OptionalFieldService.GetDocumentIfPresent.
+func (c *optionalFieldServiceClient) GetDocumentIfPresent(ctx context.Context, req *connect.Request[v1.SkipMissingRequest]) (*connect.Response[v1.Response], error) {
+	return c.getDocumentIfPresent.CallUnary(ctx, req)
+}
+
+// GetRequiredDocument calls test.v1.OptionalFieldService.GetRequiredDocument.
+func (c *optionalFieldServiceClient) GetRequiredDocument(ctx context.Context, req *connect.Request[v1.DenyMissingRequest]) (*connect.Response[v1.Response], error) {
+	return c.getRequiredDocument.CallUnary(ctx, req)
+}
+
+// GetNestedDocument calls test.v1.OptionalFieldService.GetNestedDocument.
+func (c *optionalFieldServiceClient) GetNestedDocument(ctx context.Context, req *connect.Request[v1.NestedOptionalRequest]) (*connect.Response[v1.Response], error) {
+	return c.getNestedDocument.CallUnary(ctx, req)
+}
+
+// GetHistory calls test.v1.OptionalFieldService.GetHistory.
+func (c *optionalFieldServiceClient) GetHistory(ctx context.Context, req *connect.Request[v1.OptionalHistory]) (*connect.Response[v1.Response], error) {
+	return c.getHistory.CallUnary(ctx, req)
+}
+
+// OptionalFieldServiceHandler is an implementation of the test.v1.OptionalFieldService service.
+type OptionalFieldServiceHandler interface {
+	GetDocument(context.Context, *connect.Request[v1.OptionalResourceRequest]) (*connect.Response[v1.Response], error)
+	GetDocumentIfPresent(context.Context, *connect.Request[v1.SkipMissingRequest]) (*connect.Response[v1.Response], error)
+	GetRequiredDocument(context.Context, *connect.Request[v1.DenyMissingRequest]) (*connect.Response[v1.Response], error)
+	GetNestedDocument(context.Context, *connect.Request[v1.NestedOptionalRequest]) (*connect.Response[v1.Response], error)
+	GetHistory(context.Context, *connect.Request[v1.OptionalHistory]) (*connect.Response[v1.Response], error)
+}
+
+// NewOptionalFieldServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOptionalFieldServiceHandler(svc OptionalFieldServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	optionalFieldServiceMethods := v1.File_test_v1_optional_fields_proto.Services().ByName("OptionalFieldService").Methods()
+	optionalFieldServiceGetDocumentHandler := connect.NewUnaryHandler(
+		OptionalFieldServiceGetDocumentProcedure,
+		svc.GetDocument,
+		connect.WithSchema(optionalFieldServiceMethods.ByName("GetDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionalFieldServiceGetDocumentIfPresentHandler := connect.NewUnaryHandler(
+		OptionalFieldServiceGetDocumentIfPresentProcedure,
+		svc.GetDocumentIfPresent,
+		connect.WithSchema(optionalFieldServiceMethods.ByName("GetDocumentIfPresent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionalFieldServiceGetRequiredDocumentHandler := connect.NewUnaryHandler(
+		OptionalFieldServiceGetRequiredDocumentProcedure,
+		svc.GetRequiredDocument,
+		connect.WithSchema(optionalFieldServiceMethods.ByName("GetRequiredDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionalFieldServiceGetNestedDocumentHandler := connect.NewUnaryHandler(
+		OptionalFieldServiceGetNestedDocumentProcedure,
+		svc.GetNestedDocument,
+		connect.WithSchema(optionalFieldServiceMethods.ByName("GetNestedDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionalFieldServiceGetHistoryHandler := connect.NewUnaryHandler(
+		OptionalFieldServiceGetHistoryProcedure,
+		svc.GetHistory,
+		connect.WithSchema(optionalFieldServiceMethods.ByName("GetHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.OptionalFieldService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OptionalFieldServiceGetDocumentProcedure:
+			optionalFieldServiceGetDocumentHandler.ServeHTTP(w, r)
+		case OptionalFieldServiceGetDocumentIfPresentProcedure:
+			optionalFieldServiceGetDocumentIfPresentHandler.ServeHTTP(w, r)
+		case OptionalFieldServiceGetRequiredDocumentProcedure:
+			optionalFieldServiceGetRequiredDocumentHandler.ServeHTTP(w, r)
+		case OptionalFieldServiceGetNestedDocumentProcedure:
+			optionalFieldServiceGetNestedDocumentHandler.ServeHTTP(w, r)
+		case OptionalFieldServiceGetHistoryProcedure:
+			optionalFieldServiceGetHistoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOptionalFieldServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOptionalFieldServiceHandler struct{}
+
+func (UnimplementedOptionalFieldServiceHandler) GetDocument(context.Context, *connect.Request[v1.OptionalResourceRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.OptionalFieldService.GetDocument is not implemented"))
+}
+
+func (UnimplementedOptionalFieldServiceHandler) GetDocumentIfPresent(context.Context, *connect.Request[v1.SkipMissingRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.OptionalFieldService.GetDocumentIfPresent is not implemented"))
+}
+
+func (UnimplementedOptionalFieldServiceHandler) GetRequiredDocument(context.Context, *connect.Request[v1.DenyMissingRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.OptionalFieldService.GetRequiredDocument is not implemented"))
+}
+
+func (UnimplementedOptionalFieldServiceHandler) GetNestedDocument(context.Context, *connect.Request[v1.NestedOptionalRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.OptionalFieldService.GetNestedDocument is not implemented"))
+}
+
+func (UnimplementedOptionalFieldServiceHandler) GetHistory(context.Context, *connect.Request[v1.OptionalHistory]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.OptionalFieldService.GetHistory is not implemented"))
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/parent_resources.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PagesName is the fully-qualified name of the Pages service.
+	PagesName = "test.v1.Pages"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PagesCreatePageProcedure is the fully-qualified name of the Pages's CreatePage RPC.
+	PagesCreatePageProcedure = "/test.v1.Pages/CreatePage"
+	// PagesUpsertPageProcedure is the fully-qualified name of the Pages's UpsertPage RPC.
+	PagesUpsertPageProcedure = "/test.v1.Pages/UpsertPage"
+	// PagesMovePageProcedure is the fully-qualified name of the Pages's MovePage RPC.
+	PagesMovePageProcedure = "/test.v1.Pages/MovePage"
+)
+
+// PagesClient is a client for the test.v1.Pages service.
+type PagesClient interface {
+	CreatePage(context.Context, *connect.Request[v1.CreatePageRequest]) (*connect.Response[v1.Response], error)
+	UpsertPage(context.Context, *connect.Request[v1.UpsertPageRequest]) (*connect.Response[v1.Response], error)
+	MovePage(context.Context, *connect.Request[v1.MovePageRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewPagesClient constructs a client for the test.v1.Pages service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPagesClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PagesClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	pagesMethods := v1.File_test_v1_parent_resources_proto.Services().ByName("Pages").Methods()
+	return &pagesClient{
+		createPage: connect.NewClient[v1.CreatePageRequest, v1.Response](
+			httpClient,
+			baseURL+PagesCreatePageProcedure,
+			connect.WithSchema(pagesMethods.ByName("CreatePage")),
+			connect.WithClientOptions(opts...),
+		),
+		upsertPage: connect.NewClient[v1.UpsertPageRequest, v1.Response](
+			httpClient,
+			baseURL+PagesUpsertPageProcedure,
+			connect.WithSchema(pagesMethods.ByName("UpsertPage")),
+			connect.WithClientOptions(opts...),
+		),
+		movePage: connect.NewClient[v1.MovePageRequest, v1.Response](
+			httpClient,
+			baseURL+PagesMovePageProcedure,
+			connect.WithSchema(pagesMethods.ByName("MovePage")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// pagesClient implements PagesClient.
+type pagesClient struct {
+	createPage *connect.Client[v1.CreatePageRequest, v1.Response]
+	upsertPage *connect.Client[v1.UpsertPageRequest, v1.Response]
+	movePage   *connect.Client[v1.MovePageRequest, v1.Response]
+}
+
+// CreatePage calls test.v1.Pages.CreatePage.
+func (c *pagesClient) CreatePage(ctx context.Context, req *connect.Request[v1.CreatePageRequest]) (*connect.Response[v1.Response], error) {
+	return c.createPage.CallUnary(ctx, req)
+}
+
+// UpsertPage calls test.v1.Pages.UpsertPage.
+func (c *pagesClient) UpsertPage(ctx context.Context, req *connect.Request[v1.UpsertPageRequest]) (*connect.Response[v1.Response], error) {
+	return c.upsertPage.CallUnary(ctx, req)
+}
+
+// MovePage calls test.v1.Pages.MovePage.
+func (c *pagesClient) MovePage(ctx context.Context, req *connect.Request[v1.MovePageRequest]) (*connect.Response[v1.Response], error) {
+	return c.movePage.CallUnary(ctx, req)
+}
+
+// PagesHandler is an implementation of the test.v1.Pages service.
+type PagesHandler interface {
+	CreatePage(context.Context, *connect.Request[v1.CreatePageRequest]) (*connect.Response[v1.Response], error)
+	UpsertPage(context.Context, *connect.Request[v1.UpsertPageRequest]) (*connect.Response[v1.Response], error)
+	MovePage(context.Context, *connect.Request[v1.MovePageRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewPagesHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPagesHandler(svc PagesHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	pagesMethods := v1.File_test_v1_parent_resources_proto.Services().ByName("Pages").Methods()
+	pagesCreatePageHandler := connect.NewUnaryHandler(
+		PagesCreatePageProcedure,
+		svc.CreatePage,
+		connect.WithSchema(pagesMethods.ByName("CreatePage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pagesUpsertPageHandler := connect.NewUnaryHandler(
+		PagesUpsertPageProcedure,
+		svc.UpsertPage,
+		connect.WithSchema(pagesMethods.ByName("UpsertPage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pagesMovePageHandler := connect.NewUnaryHandler(
+		PagesMovePageProcedure,
+		svc.MovePage,
+		connect.WithSchema(pagesMethods.ByName("MovePage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Pages/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PagesCreatePageProcedure:
+			pagesCreatePageHandler.ServeHTTP(w, r)
+		case PagesUpsertPageProcedure:
+			pagesUpsertPageHandler.ServeHTTP(w, r)
+		case PagesMovePageProcedure:
+			pagesMovePageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPagesHandler returns CodeUnimplemented from all methods.
+type UnimplementedPagesHandler struct{}
+
+func (UnimplementedPagesHandler) CreatePage(context.Context, *connect.Request[v1.CreatePageRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Pages.CreatePage is not implemented"))
+}
+
+func (UnimplementedPagesHandler) UpsertPage(context.Context, *connect.Request[v1.UpsertPageRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Pages.UpsertPage is not implemented"))
+}
+
+func (UnimplementedPagesHandler) MovePage(context.Context, *connect.Request[v1.MovePageRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Pages.MovePage is not implemented"))
+}
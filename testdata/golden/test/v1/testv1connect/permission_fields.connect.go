@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/permission_fields.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RecordsName is the fully-qualified name of the Records service.
+	RecordsName = "test.v1.Records"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RecordsAccessRecordProcedure is the fully-qualified name of the Records's AccessRecord RPC.
+	RecordsAccessRecordProcedure = "/test.v1.Records/AccessRecord"
+	// RecordsActOnRecordProcedure is the fully-qualified name of the Records's ActOnRecord RPC.
+	RecordsActOnRecordProcedure = "/test.v1.Records/ActOnRecord"
+	// RecordsInvokeRecordProcedure is the fully-qualified name of the Records's InvokeRecord RPC.
+	RecordsInvokeRecordProcedure = "/test.v1.Records/InvokeRecord"
+)
+
+// RecordsClient is a client for the test.v1.Records service.
+type RecordsClient interface {
+	AccessRecord(context.Context, *connect.Request[v1.AccessRecordRequest]) (*connect.Response[v1.Response], error)
+	ActOnRecord(context.Context, *connect.Request[v1.ActOnRecordRequest]) (*connect.Response[v1.Response], error)
+	InvokeRecord(context.Context, *connect.Request[v1.InvokeRecordRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewRecordsClient constructs a client for the test.v1.Records service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRecordsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RecordsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	recordsMethods := v1.File_test_v1_permission_fields_proto.Services().ByName("Records").Methods()
+	return &recordsClient{
+		accessRecord: connect.NewClient[v1.AccessRecordRequest, v1.Response](
+			httpClient,
+			baseURL+RecordsAccessRecordProcedure,
+			connect.WithSchema(recordsMethods.ByName("AccessRecord")),
+			connect.WithClientOptions(opts...),
+		),
+		actOnRecord: connect.NewClient[v1.ActOnRecordRequest, v1.Response](
+			httpClient,
+			baseURL+RecordsActOnRecordProcedure,
+			connect.WithSchema(recordsMethods.ByName("ActOnRecord")),
+			connect.WithClientOptions(opts...),
+		),
+		invokeRecord: connect.NewClient[v1.InvokeRecordRequest, v1.Response](
+			httpClient,
+			baseURL+RecordsInvokeRecordProcedure,
+			connect.WithSchema(recordsMethods.ByName("InvokeRecord")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// recordsClient implements RecordsClient.
+type recordsClient struct {
+	accessRecord *connect.Client[v1.AccessRecordRequest, v1.Response]
+	actOnRecord  *connect.Client[v1.ActOnRecordRequest, v1.Response]
+	invokeRecord *connect.Client[v1.InvokeRecordRequest, v1.Response]
+}
+
+// AccessRecord calls test.v1.Records.AccessRecord.
+func (c *recordsClient) AccessRecord(ctx context.Context, req *connect.Request[v1.AccessRecordRequest]) (*connect.Response[v1.Response], error) {
+	return c.accessRecord.CallUnary(ctx, req)
+}
+
+// ActOnRecord calls test.v1.Records.ActOnRecord.
+func (c *recordsClient) ActOnRecord(ctx context.Context, req *connect.Request[v1.ActOnRecordRequest]) (*connect.Response[v1.Response], error) {
+	return c.actOnRecord.CallUnary(ctx, req)
+}
+
+// InvokeRecord calls test.v1.Records.InvokeRecord.
+func (c *recordsClient) InvokeRecord(ctx context.Context, req *connect.Request[v1.InvokeRecordRequest]) (*connect.Response[v1.Response], error) {
+	return c.invokeRecord.CallUnary(ctx, req)
+}
+
+// RecordsHandler is an implementation of the test.v1.Records service.
+type RecordsHandler interface {
+	AccessRecord(context.Context, *connect.Request[v1.AccessRecordRequest]) (*connect.Response[v1.Response], error)
+	ActOnRecord(context.Context, *connect.Request[v1.ActOnRecordRequest]) (*connect.Response[v1.Response], error)
+	InvokeRecord(context.Context, *connect.Request[v1.InvokeRecordRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewRecordsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRecordsHandler(svc RecordsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	recordsMethods := v1.File_test_v1_permission_fields_proto.Services().ByName("Records").Methods()
+	recordsAccessRecordHandler := connect.NewUnaryHandler(
+		RecordsAccessRecordProcedure,
+		svc.AccessRecord,
+		connect.WithSchema(recordsMethods.ByName("AccessRecord")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordsActOnRecordHandler := connect.NewUnaryHandler(
+		RecordsActOnRecordProcedure,
+		svc.ActOnRecord,
+		connect.WithSchema(recordsMethods.ByName("ActOnRecord")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordsInvokeRecordHandler := connect.NewUnaryHandler(
+		RecordsInvokeRecordProcedure,
+		svc.InvokeRecord,
+		connect.WithSchema(recordsMethods.ByName("InvokeRecord")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Records/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RecordsAccessRecordProcedure:
+			recordsAccessRecordHandler.ServeHTTP(w, r)
+		case RecordsActOnRecordProcedure:
+			recordsActOnRecordHandler.ServeHTTP(w, r)
+		case RecordsInvokeRecordProcedure:
+			recordsInvokeRecordHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRecordsHandler returns CodeUnimplemented from all methods.
+type UnimplementedRecordsHandler struct{}
+
+func (UnimplementedRecordsHandler) AccessRecord(context.Context, *connect.Request[v1.AccessRecordRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Records.AccessRecord is not implemented"))
+}
+
+func (UnimplementedRecordsHandler) ActOnRecord(context.Context, *connect.Request[v1.ActOnRecordRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Records.ActOnRecord is not implemented"))
+}
+
+func (UnimplementedRecordsHandler) InvokeRecord(context.Context, *connect.Request[v1.InvokeRecordRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Records.InvokeRecord is not implemented"))
+}
@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/single_tenant.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WalletsName is the fully-qualified name of the Wallets service.
+	WalletsName = "test.v1.Wallets"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WalletsAuditWalletsProcedure is the fully-qualified name of the Wallets's AuditWallets RPC.
+	WalletsAuditWalletsProcedure = "/test.v1.Wallets/AuditWallets"
+	// WalletsCloseWalletProcedure is the fully-qualified name of the Wallets's CloseWallet RPC.
+	WalletsCloseWalletProcedure = "/test.v1.Wallets/CloseWallet"
+)
+
+// WalletsClient is a client for the test.v1.Wallets service.
+type WalletsClient interface {
+	AuditWallets(context.Context, *connect.Request[v1.AuditWalletsRequest]) (*connect.Response[v1.Response], error)
+	CloseWallet(context.Context, *connect.Request[v1.CloseWalletRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewWalletsClient constructs a client for the test.v1.Wallets service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWalletsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WalletsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	walletsMethods := v1.File_test_v1_single_tenant_proto.Services().ByName("Wallets").Methods()
+	return &walletsClient{
+		auditWallets: connect.NewClient[v1.AuditWalletsRequest, v1.Response](
+			httpClient,
+			baseURL+WalletsAuditWalletsProcedure,
+			connect.WithSchema(walletsMethods.ByName("AuditWallets")),
+			connect.WithClientOptions(opts...),
+		),
+		closeWallet: connect.NewClient[v1.CloseWalletRequest, v1.Response](
+			httpClient,
+			baseURL+WalletsCloseWalletProcedure,
+			connect.WithSchema(walletsMethods.ByName("CloseWallet")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// walletsClient implements WalletsClient.
+type walletsClient struct {
+	auditWallets *connect.Client[v1.AuditWalletsRequest, v1.Response]
+	closeWallet  *connect.Client[v1.CloseWalletRequest, v1.Response]
+}
+
+// AuditWallets calls test.v1.Wallets.AuditWallets.
+func (c *walletsClient) AuditWallets(ctx context.Context, req *connect.Request[v1.AuditWalletsRequest]) (*connect.Response[v1.Response], error) {
+	return c.auditWallets.CallUnary(ctx, req)
+}
+
+// CloseWallet calls test.v1.Wallets.CloseWallet.
+func (c *walletsClient) CloseWallet(ctx context.Context, req *connect.Request[v1.CloseWalletRequest]) (*connect.Response[v1.Response], error) {
+	return c.closeWallet.CallUnary(ctx, req)
+}
+
+// WalletsHandler is an implementation of the test.v1.Wallets service.
+type WalletsHandler interface {
+	AuditWallets(context.Context, *connect.Request[v1.AuditWalletsRequest]) (*connect.Response[v1.Response], error)
+	CloseWallet(context.Context, *connect.Request[v1.CloseWalletRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewWalletsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWalletsHandler(svc WalletsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	walletsMethods := v1.File_test_v1_single_tenant_proto.Services().ByName("Wallets").Methods()
+	walletsAuditWalletsHandler := connect.NewUnaryHandler(
+		WalletsAuditWalletsProcedure,
+		svc.AuditWallets,
+		connect.WithSchema(walletsMethods.ByName("AuditWallets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	walletsCloseWalletHandler := connect.NewUnaryHandler(
+		WalletsCloseWalletProcedure,
+		svc.CloseWallet,
+		connect.WithSchema(walletsMethods.ByName("CloseWallet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Wallets/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WalletsAuditWalletsProcedure:
+			walletsAuditWalletsHandler.ServeHTTP(w, r)
+		case WalletsCloseWalletProcedure:
+			walletsCloseWalletHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWalletsHandler returns CodeUnimplemented from all methods.
+type UnimplementedWalletsHandler struct{}
+
+func (UnimplementedWalletsHandler) AuditWallets(context.Context, *connect.Request[v1.AuditWalletsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Wallets.AuditWallets is not implemented"))
+}
+
+func (UnimplementedWalletsHandler) CloseWallet(context.Context, *connect.Request[v1.CloseWalletRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Wallets.CloseWallet is not implemented"))
+}
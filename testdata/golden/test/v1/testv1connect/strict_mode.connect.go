@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/strict_mode.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LedgersName is the fully-qualified name of the Ledgers service.
+	LedgersName = "test.v1.Ledgers"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LedgersGetLedgerProcedure is the fully-qualified name of the Ledgers's GetLedger RPC.
+	LedgersGetLedgerProcedure = "/test.v1.Ledgers/GetLedger"
+	// LedgersReconcileEntriesProcedure is the fully-qualified name of the Ledgers's ReconcileEntries
+	// RPC.
+	LedgersReconcileEntriesProcedure = "/test.v1.Ledgers/ReconcileEntries"
+	// LedgersSaveDraftProcedure is the fully-qualified name of the Ledgers's SaveDraft RPC.
+	LedgersSaveDraftProcedure = "/test.v1.Ledgers/SaveDraft"
+	// LedgersCreateLedgerProcedure is the fully-qualified name of the Ledgers's CreateLedger RPC.
+	LedgersCreateLedgerProcedure = "/test.v1.Ledgers/CreateLedger"
+)
+
+// LedgersClient is a client for the test.v1.Ledgers service.
+type LedgersClient interface {
+	GetLedger(context.Context, *connect.Request[v1.GetLedgerRequest]) (*connect.Response[v1.Response], error)
+	ReconcileEntries(context.Context, *connect.Request[v1.ReconcileEntriesRequest]) (*connect.Response[v1.Response], error)
+	SaveDraft(context.Context, *connect.Request[v1.SaveDraftRequest]) (*connect.Response[v1.Response], error)
+	CreateLedger(context.Context, *connect.Request[v1.CreateLedgerRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewLedgersClient constructs a client for the test.v1.Ledgers service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLedgersClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LedgersClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	ledgersMethods := v1.File_test_v1_strict_mode_proto.Services().ByName("Ledgers").Methods()
+	return &ledgersClient{
+		getLedger: connect.NewClient[v1.GetLedgerRequest, v1.Response](
+			httpClient,
+			baseURL+LedgersGetLedgerProcedure,
+			connect.WithSchema(ledgersMethods.ByName("GetLedger")),
+			connect.WithClientOptions(opts...),
+		),
+		reconcileEntries: connect.NewClient[v1.ReconcileEntriesRequest, v1.Response](
+			httpClient,
+			baseURL+LedgersReconcileEntriesProcedure,
+			connect.WithSchema(ledgersMethods.ByName("ReconcileEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		saveDraft: connect.NewClient[v1.SaveDraftRequest, v1.Response](
+			httpClient,
+			baseURL+LedgersSaveDraftProcedure,
+			connect.WithSchema(ledgersMethods.ByName("SaveDraft")),
+			connect.WithClientOptions(opts...),
+		),
+		createLedger: connect.NewClient[v1.CreateLedgerRequest, v1.Response](
+			httpClient,
+			baseURL+LedgersCreateLedgerProcedure,
+			connect.WithSchema(ledgersMethods.ByName("CreateLedger")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ledgersClient implements LedgersClient.
+type ledgersClient struct {
+	getLedger        *connect.Client[v1.GetLedgerRequest, v1.Response]
+	reconcileEntries *connect.Client[v1.ReconcileEntriesRequest, v1.Response]
+	saveDraft        *connect.Client[v1.SaveDraftRequest, v1.Response]
+	createLedger     *connect.Client[v1.CreateLedgerRequest, v1.Response]
+}
+
+// GetLedger calls test.v1.Ledgers.GetLedger.
+func (c *ledgersClient) GetLedger(ctx context.Context, req *connect.Request[v1.GetLedgerRequest]) (*connect.Response[v1.Response], error) {
+	return c.getLedger.CallUnary(ctx, req)
+}
+
+// ReconcileEntries calls test.v1.Ledgers.ReconcileEntries.
+func (c *ledgersClient) ReconcileEntries(ctx context.Context, req *connect.Request[v1.ReconcileEntriesRequest]) (*connect.Response[v1.Response], error) {
+	return c.reconcileEntries.CallUnary(ctx, req)
+}
+
+// SaveDraft calls test.v1.Ledgers.SaveDraft.
+func (c *ledgersClient) SaveDraft(ctx context.Context, req *connect.Request[v1.SaveDraftRequest]) (*connect.Response[v1.Response], error) {
+	return c.saveDraft.CallUnary(ctx, req)
+}
+
+// CreateLedger calls test.v1.Ledgers.CreateLedger.
+func (c *ledgersClient) CreateLedger(ctx context.Context, req *connect.Request[v1.CreateLedgerRequest]) (*connect.Response[v1.Response], error) {
+	return c.createLedger.CallUnary(ctx, req)
+}
+
+// LedgersHandler is an implementation of the test.v1.Ledgers service.
+type LedgersHandler interface {
+	GetLedger(context.Context, *connect.Request[v1.GetLedgerRequest]) (*connect.Response[v1.Response], error)
+	ReconcileEntries(context.Context, *connect.Request[v1.ReconcileEntriesRequest]) (*connect.Response[v1.Response], error)
+	SaveDraft(context.Context, *connect.Request[v1.SaveDraftRequest]) (*connect.Response[v1.Response], error)
+	CreateLedger(context.Context, *connect.Request[v1.CreateLedgerRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewLedgersHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLedgersHandler(svc LedgersHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ledgersMethods := v1.File_test_v1_strict_mode_proto.Services().ByName("Ledgers").Methods()
+	ledgersGetLedgerHandler := connect.NewUnaryHandler(
+		LedgersGetLedgerProcedure,
+		svc.GetLedger,
+		connect.WithSchema(ledgersMethods.ByName("GetLedger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ledgersReconcileEntriesHandler := connect.NewUnaryHandler(
+		LedgersReconcileEntriesProcedure,
+		svc.ReconcileEntries,
+		connect.WithSchema(ledgersMethods.ByName("ReconcileEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ledgersSaveDraftHandler := connect.NewUnaryHandler(
+		LedgersSaveDraftProcedure,
+		svc.SaveDraft,
+		connect.WithSchema(ledgersMethods.ByName("SaveDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ledgersCreateLedgerHandler := connect.NewUnaryHandler(
+		LedgersCreateLedgerProcedure,
+		svc.CreateLedger,
+		connect.WithSchema(ledgersMethods.ByName("CreateLedger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Ledgers/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LedgersGetLedgerProcedure:
+			ledgersGetLedgerHandler.ServeHTTP(w, r)
+		case LedgersReconcileEntriesProcedure:
+			ledgersReconcileEntriesHandler.ServeHTTP(w, r)
+		case LedgersSaveDraftProcedure:
+			ledgersSaveDraftHandler.ServeHTTP(w, r)
+		case LedgersCreateLedgerProcedure:
+			ledgersCreateLedgerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLedgersHandler returns CodeUnimplemented from all methods.
+type UnimplementedLedgersHandler struct{}
+
+func (UnimplementedLedgersHandler) GetLedger(context.Context, *connect.Request[v1.GetLedgerRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Ledgers.GetLedger is not implemented"))
+}
+
+func (UnimplementedLedgersHandler) ReconcileEntries(context.Context, *connect.Request[v1.ReconcileEntriesRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Ledgers.ReconcileEntries is not implemented"))
+}
+
+func (UnimplementedLedgersHandler) SaveDraft(context.Context, *connect.Request[v1.SaveDraftRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Ledgers.SaveDraft is not implemented"))
+}
+
+func (UnimplementedLedgersHandler) CreateLedger(context.Context, *connect.Request[v1.CreateLedgerRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Ledgers.CreateLedger is not implemented"))
+}
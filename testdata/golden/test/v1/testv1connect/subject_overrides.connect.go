@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/subject_overrides.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TicketsName is the fully-qualified name of the Tickets service.
+	TicketsName = "test.v1.Tickets"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TicketsCloseTicketProcedure is the fully-qualified name of the Tickets's CloseTicket RPC.
+	TicketsCloseTicketProcedure = "/test.v1.Tickets/CloseTicket"
+	// TicketsAssignTicketProcedure is the fully-qualified name of the Tickets's AssignTicket RPC.
+	TicketsAssignTicketProcedure = "/test.v1.Tickets/AssignTicket"
+)
+
+// TicketsClient is a client for the test.v1.Tickets service.
+type TicketsClient interface {
+	CloseTicket(context.Context, *connect.Request[v1.CloseTicketRequest]) (*connect.Response[v1.Response], error)
+	AssignTicket(context.Context, *connect.Request[v1.AssignTicketRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewTicketsClient constructs a client for the test.v1.Tickets service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTicketsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TicketsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	ticketsMethods := v1.File_test_v1_subject_overrides_proto.Services().ByName("Tickets").Methods()
+	return &ticketsClient{
+		closeTicket: connect.NewClient[v1.CloseTicketRequest, v1.Response](
+			httpClient,
+			baseURL+TicketsCloseTicketProcedure,
+			connect.WithSchema(ticketsMethods.ByName("CloseTicket")),
+			connect.WithClientOptions(opts...),
+		),
+		assignTicket: connect.NewClient[v1.AssignTicketRequest, v1.Response](
+			httpClient,
+			baseURL+TicketsAssignTicketProcedure,
+			connect.WithSchema(ticketsMethods.ByName("AssignTicket")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ticketsClient implements TicketsClient.
+type ticketsClient struct {
+	closeTicket  *connect.Client[v1.CloseTicketRequest, v1.Response]
+	assignTicket *connect.Client[v1.AssignTicketRequest, v1.Response]
+}
+
+// CloseTicket calls test.v1.Tickets.CloseTicket.
+func (c *ticketsClient) CloseTicket(ctx context.Context, req *connect.Request[v1.CloseTicketRequest]) (*connect.Response[v1.Response], error) {
+	return c.closeTicket.CallUnary(ctx, req)
+}
+
+// AssignTicket calls test.v1.Tickets.AssignTicket.
+func (c *ticketsClient) AssignTicket(ctx context.Context, req *connect.Request[v1.AssignTicketRequest]) (*connect.Response[v1.Response], error) {
+	return c.assignTicket.CallUnary(ctx, req)
+}
+
+// TicketsHandler is an implementation of the test.v1.Tickets service.
+type TicketsHandler interface {
+	CloseTicket(context.Context, *connect.Request[v1.CloseTicketRequest]) (*connect.Response[v1.Response], error)
+	AssignTicket(context.Context, *connect.Request[v1.AssignTicketRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewTicketsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTicketsHandler(svc TicketsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ticketsMethods := v1.File_test_v1_subject_overrides_proto.Services().ByName("Tickets").Methods()
+	ticketsCloseTicketHandler := connect.NewUnaryHandler(
+		TicketsCloseTicketProcedure,
+		svc.CloseTicket,
+		connect.WithSchema(ticketsMethods.ByName("CloseTicket")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketsAssignTicketHandler := connect.NewUnaryHandler(
+		TicketsAssignTicketProcedure,
+		svc.AssignTicket,
+		connect.WithSchema(ticketsMethods.ByName("AssignTicket")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Tickets/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TicketsCloseTicketProcedure:
+			ticketsCloseTicketHandler.ServeHTTP(w, r)
+		case TicketsAssignTicketProcedure:
+			ticketsAssignTicketHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTicketsHandler returns CodeUnimplemented from all methods.
+type UnimplementedTicketsHandler struct{}
+
+func (UnimplementedTicketsHandler) CloseTicket(context.Context, *connect.Request[v1.CloseTicketRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Tickets.CloseTicket is not implemented"))
+}
+
+func (UnimplementedTicketsHandler) AssignTicket(context.Context, *connect.Request[v1.AssignTicketRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Tickets.AssignTicket is not implemented"))
+}
@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/tenant_guard.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ShiftsName is the fully-qualified name of the Shifts service.
+	ShiftsName = "test.v1.Shifts"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ShiftsSwapShiftsProcedure is the fully-qualified name of the Shifts's SwapShifts RPC.
+	ShiftsSwapShiftsProcedure = "/test.v1.Shifts/SwapShifts"
+	// ShiftsGetShiftProcedure is the fully-qualified name of the Shifts's GetShift RPC.
+	ShiftsGetShiftProcedure = "/test.v1.Shifts/GetShift"
+	// ShiftsDropShiftProcedure is the fully-qualified name of the Shifts's DropShift RPC.
+	ShiftsDropShiftProcedure = "/test.v1.Shifts/DropShift"
+	// ShiftsListOpenShiftsProcedure is the fully-qualified name of the Shifts's ListOpenShifts RPC.
+	ShiftsListOpenShiftsProcedure = "/test.v1.Shifts/ListOpenShifts"
+)
+
+// ShiftsClient is a client for the test.v1.Shifts service.
+type ShiftsClient interface {
+	SwapShifts(context.Context, *connect.Request[v1.SwapShiftsRequest]) (*connect.Response[v1.Response], error)
+	GetShift(context.Context, *connect.Request[v1.GetShiftRequest]) (*connect.Response[v1.Response], error)
+	DropShift(context.Context, *connect.Request[v1.DropShiftRequest]) (*connect.Response[v1.Response], error)
+	ListOpenShifts(context.Context, *connect.Request[v1.ListOpenShiftsRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewShiftsClient constructs a client for the test.v1.Shifts service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewShiftsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ShiftsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	shiftsMethods := v1.File_test_v1_tenant_guard_proto.Services().ByName("Shifts").Methods()
+	return &shiftsClient{
+		swapShifts: connect.NewClient[v1.SwapShiftsRequest, v1.Response](
+			httpClient,
+			baseURL+ShiftsSwapShiftsProcedure,
+			connect.WithSchema(shiftsMethods.ByName("SwapShifts")),
+			connect.WithClientOptions(opts...),
+		),
+		getShift: connect.NewClient[v1.GetShiftRequest, v1.Response](
+			httpClient,
+			baseURL+ShiftsGetShiftProcedure,
+			connect.WithSchema(shiftsMethods.ByName("GetShift")),
+			connect.WithClientOptions(opts...),
+		),
+		dropShift: connect.NewClient[v1.DropShiftRequest, v1.Response](
+			httpClient,
+			baseURL+ShiftsDropShiftProcedure,
+			connect.WithSchema(shiftsMethods.ByName("DropShift")),
+			connect.WithClientOptions(opts...),
+		),
+		listOpenShifts: connect.NewClient[v1.ListOpenShiftsRequest, v1.Response](
+			httpClient,
+			baseURL+ShiftsListOpenShiftsProcedure,
+			connect.WithSchema(shiftsMethods.ByName("ListOpenShifts")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// shiftsClient implements ShiftsClient.
+type shiftsClient struct {
+	swapShifts     *connect.Client[v1.SwapShiftsRequest, v1.Response]
+	getShift       *connect.Client[v1.GetShiftRequest, v1.Response]
+	dropShift      *connect.Client[v1.DropShiftRequest, v1.Response]
+	listOpenShifts *connect.Client[v1.ListOpenShiftsRequest, v1.Response]
+}
+
+// SwapShifts calls test.v1.Shifts.SwapShifts.
+func (c *shiftsClient) SwapShifts(ctx context.Context, req *connect.Request[v1.SwapShiftsRequest]) (*connect.Response[v1.Response], error) {
+	return c.swapShifts.CallUnary(ctx, req)
+}
+
+// GetShift calls test.v1.Shifts.GetShift.
+func (c *shiftsClient) GetShift(ctx context.Context, req *connect.Request[v1.GetShiftRequest]) (*connect.Response[v1.Response], error) {
+	return c.getShift.CallUnary(ctx, req)
+}
+
+// DropShift calls test.v1.Shifts.DropShift.
+func (c *shiftsClient) DropShift(ctx context.Context, req *connect.Request[v1.DropShiftRequest]) (*connect.Response[v1.Response], error) {
+	return c.dropShift.CallUnary(ctx, req)
+}
+
+// ListOpenShifts calls test.v1.Shifts.ListOpenShifts.
+func (c *shiftsClient) ListOpenShifts(ctx context.Context, req *connect.Request[v1.ListOpenShiftsRequest]) (*connect.Response[v1.Response], error) {
+	return c.listOpenShifts.CallUnary(ctx, req)
+}
+
+// ShiftsHandler is an implementation of the test.v1.Shifts service.
+type ShiftsHandler interface {
+	SwapShifts(context.Context, *connect.Request[v1.SwapShiftsRequest]) (*connect.Response[v1.Response], error)
+	GetShift(context.Context, *connect.Request[v1.GetShiftRequest]) (*connect.Response[v1.Response], error)
+	DropShift(context.Context, *connect.Request[v1.DropShiftRequest]) (*connect.Response[v1.Response], error)
+	ListOpenShifts(context.Context, *connect.Request[v1.ListOpenShiftsRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewShiftsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewShiftsHandler(svc ShiftsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	shiftsMethods := v1.File_test_v1_tenant_guard_proto.Services().ByName("Shifts").Methods()
+	shiftsSwapShiftsHandler := connect.NewUnaryHandler(
+		ShiftsSwapShiftsProcedure,
+		svc.SwapShifts,
+		connect.WithSchema(shiftsMethods.ByName("SwapShifts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shiftsGetShiftHandler := connect.NewUnaryHandler(
+		ShiftsGetShiftProcedure,
+		svc.GetShift,
+		connect.WithSchema(shiftsMethods.ByName("GetShift")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shiftsDropShiftHandler := connect.NewUnaryHandler(
+		ShiftsDropShiftProcedure,
+		svc.DropShift,
+		connect.WithSchema(shiftsMethods.ByName("DropShift")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shiftsListOpenShiftsHandler := connect.NewUnaryHandler(
+		ShiftsListOpenShiftsProcedure,
+		svc.ListOpenShifts,
+		connect.WithSchema(shiftsMethods.ByName("ListOpenShifts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Shifts/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ShiftsSwapShiftsProcedure:
+			shiftsSwapShiftsHandler.ServeHTTP(w, r)
+		case ShiftsGetShiftProcedure:
+			shiftsGetShiftHandler.ServeHTTP(w, r)
+		case ShiftsDropShiftProcedure:
+			shiftsDropShiftHandler.ServeHTTP(w, r)
+		case ShiftsListOpenShiftsProcedure:
+			shiftsListOpenShiftsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedShiftsHandler returns CodeUnimplemented from all methods.
+type UnimplementedShiftsHandler struct{}
+
+func (UnimplementedShiftsHandler) SwapShifts(context.Context, *connect.Request[v1.SwapShiftsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Shifts.SwapShifts is not implemented"))
+}
+
+func (UnimplementedShiftsHandler) GetShift(context.Context, *connect.Request[v1.GetShiftRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Shifts.GetShift is not implemented"))
+}
+
+func (UnimplementedShiftsHandler) DropShift(context.Context, *connect.Request[v1.DropShiftRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Shifts.DropShift is not implemented"))
+}
+
+func (UnimplementedShiftsHandler) ListOpenShifts(context.Context, *connect.Request[v1.ListOpenShiftsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Shifts.ListOpenShifts is not implemented"))
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/well_known_attributes.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SubscriptionsName is the fully-qualified name of the Subscriptions service.
+	SubscriptionsName = "test.v1.Subscriptions"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SubscriptionsUpdateSubscriptionProcedure is the fully-qualified name of the Subscriptions's
+	// UpdateSubscription RPC.
+	SubscriptionsUpdateSubscriptionProcedure = "/test.v1.Subscriptions/UpdateSubscription"
+	// SubscriptionsPatchSubscriptionsProcedure is the fully-qualified name of the Subscriptions's
+	// PatchSubscriptions RPC.
+	SubscriptionsPatchSubscriptionsProcedure = "/test.v1.Subscriptions/PatchSubscriptions"
+)
+
+// SubscriptionsClient is a client for the test.v1.Subscriptions service.
+type SubscriptionsClient interface {
+	UpdateSubscription(context.Context, *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.Response], error)
+	PatchSubscriptions(context.Context, *connect.Request[v1.PatchSubscriptionsRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewSubscriptionsClient constructs a client for the test.v1.Subscriptions service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSubscriptionsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SubscriptionsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	subscriptionsMethods := v1.File_test_v1_well_known_attributes_proto.Services().ByName("Subscriptions").Methods()
+	return &subscriptionsClient{
+		updateSubscription: connect.NewClient[v1.UpdateSubscriptionRequest, v1.Response](
+			httpClient,
+			baseURL+SubscriptionsUpdateSubscriptionProcedure,
+			connect.WithSchema(subscriptionsMethods.ByName("UpdateSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		patchSubscriptions: connect.NewClient[v1.PatchSubscriptionsRequest, v1.Response](
+			httpClient,
+			baseURL+SubscriptionsPatchSubscriptionsProcedure,
+			connect.WithSchema(subscriptionsMethods.ByName("PatchSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// subscriptionsClient implements SubscriptionsClient.
+type subscriptionsClient struct {
+	updateSubscription *connect.Client[v1.UpdateSubscriptionRequest, v1.Response]
+	patchSubscriptions *connect.Client[v1.PatchSubscriptionsRequest, v1.Response]
+}
+
+// UpdateSubscription calls test.v1.Subscriptions.UpdateSubscription.
+func (c *subscriptionsClient) UpdateSubscription(ctx context.Context, req *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.Response], error) {
+	return c.updateSubscription.CallUnary(ctx, req)
+}
+
+// PatchSubscriptions calls test.v1.Subscriptions.PatchSubscriptions.
+func (c *subscriptionsClient) PatchSubscriptions(ctx context.Context, req *connect.Request[v1.PatchSubscriptionsRequest]) (*connect.Response[v1.Response], error) {
+	return c.patchSubscriptions.CallUnary(ctx, req)
+}
+
+// SubscriptionsHandler is an implementation of the test.v1.Subscriptions service.
+type SubscriptionsHandler interface {
+	UpdateSubscription(context.Context, *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.Response], error)
+	PatchSubscriptions(context.Context, *connect.Request[v1.PatchSubscriptionsRequest]) (*connect.Response[v1.Response], error)
+}
+
+// NewSubscriptionsHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSubscriptionsHandler(svc SubscriptionsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	subscriptionsMethods := v1.File_test_v1_well_known_attributes_proto.Services().ByName("Subscriptions").Methods()
+	subscriptionsUpdateSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionsUpdateSubscriptionProcedure,
+		svc.UpdateSubscription,
+		connect.WithSchema(subscriptionsMethods.ByName("UpdateSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionsPatchSubscriptionsHandler := connect.NewUnaryHandler(
+		SubscriptionsPatchSubscriptionsProcedure,
+		svc.PatchSubscriptions,
+		connect.WithSchema(subscriptionsMethods.ByName("PatchSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.Subscriptions/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SubscriptionsUpdateSubscriptionProcedure:
+			subscriptionsUpdateSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionsPatchSubscriptionsProcedure:
+			subscriptionsPatchSubscriptionsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSubscriptionsHandler returns CodeUnimplemented from all methods.
+type UnimplementedSubscriptionsHandler struct{}
+
+func (UnimplementedSubscriptionsHandler) UpdateSubscription(context.Context, *connect.Request[v1.UpdateSubscriptionRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Subscriptions.UpdateSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionsHandler) PatchSubscriptions(context.Context, *connect.Request[v1.PatchSubscriptionsRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.Subscriptions.PatchSubscriptions is not implemented"))
+}
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: test/v1/wrapper_ids.proto
+
+package testv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+	v1 "test/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WrapperIdServiceName is the fully-qualified name of the WrapperIdService service.
+	WrapperIdServiceName = "test.v1.WrapperIdService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WrapperIdServiceGetDocumentProcedure is the fully-qualified name of the WrapperIdService's
+	// GetDocument RPC.
+	WrapperIdServiceGetDocumentProcedure = "/test.v1.WrapperIdService/GetDocument"
+	// WrapperIdServiceGetAccountProcedure is the fully-qualified name of the WrapperIdService's
+	// GetAccount RPC.
+	WrapperIdServiceGetAccountProcedure = "/test.v1.WrapperIdService/GetAccount"
+	// WrapperIdServiceGetFolderProcedure is the fully-qualified name of the WrapperIdService's
+	// GetFolder RPC.
+	WrapperIdServiceGetFolderProcedure = "/test.v1.WrapperIdService/GetFolder"
+	// WrapperIdServiceGetProjectProcedure is the fully-qualified name of the WrapperIdService's
+	// GetProject RPC.
+	WrapperIdServiceGetProjectProcedure = "/test.v1.WrapperIdService/GetProject"
+)
+
+// WrapperIdServiceClient is a client for the test.v1.WrapperIdService service.
+type WrapperIdServiceClient interface {
+	GetDocument(context.Context, *connect.Request[v1.StringWrapperResource]) (*connect.Response[v1.Response], error)
+	GetAccount(context.Context, *connect.Request[v1.IntWrapperResource]) (*connect.Response[v1.Response], error)
+	GetFolder(context.Context, *connect.Request[v1.NestedWrapperRequest]) (*connect.Response[v1.Response], error)
+	GetProject(context.Context, *connect.Request[v1.ScalarIntResource]) (*connect.Response[v1.Response], error)
+}
+
+// NewWrapperIdServiceClient constructs a client for the test.v1.WrapperIdService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWrapperIdServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WrapperIdServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	wrapperIdServiceMethods := v1.File_test_v1_wrapper_ids_proto.Services().ByName("WrapperIdService").Methods()
+	return &wrapperIdServiceClient{
+		getDocument: connect.NewClient[v1.StringWrapperResource, v1.Response](
+			httpClient,
+			baseURL+WrapperIdServiceGetDocumentProcedure,
+			connect.WithSchema(wrapperIdServiceMethods.ByName("GetDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccount: connect.NewClient[v1.IntWrapperResource, v1.Response](
+			httpClient,
+			baseURL+WrapperIdServiceGetAccountProcedure,
+			connect.WithSchema(wrapperIdServiceMethods.ByName("GetAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getFolder: connect.NewClient[v1.NestedWrapperRequest, v1.Response](
+			httpClient,
+			baseURL+WrapperIdServiceGetFolderProcedure,
+			connect.WithSchema(wrapperIdServiceMethods.ByName("GetFolder")),
+			connect.WithClientOptions(opts...),
+		),
+		getProject: connect.NewClient[v1.ScalarIntResource, v1.Response](
+			httpClient,
+			baseURL+WrapperIdServiceGetProjectProcedure,
+			connect.WithSchema(wrapperIdServiceMethods.ByName("GetProject")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// wrapperIdServiceClient implements WrapperIdServiceClient.
+type wrapperIdServiceClient struct {
+	getDocument *connect.Client[v1.StringWrapperResource, v1.Response]
+	getAccount  *connect.Client[v1.IntWrapperResource, v1.Response]
+	getFolder   *connect.Client[v1.NestedWrapperRequest, v1.Response]
+	getProject  *connect.Client[v1.ScalarIntResource, v1.Response]
+}
+
+// GetDocument calls test.v1.WrapperIdService.GetDocument.
+func (c *wrapperIdServiceClient) GetDocument(ctx context.Context, req *connect.Request[v1.StringWrapperResource]) (*connect.Response[v1.Response], error) {
+	return c.getDocument.CallUnary(ctx, req)
+}
+
+// GetAccount calls test.v1.WrapperIdService.GetAccount.
+func (c *wrapperIdServiceClient) GetAccount(ctx context.Context, req *connect.Request[v1.IntWrapperResource]) (*connect.Response[v1.Response], error) {
+	return c.getAccount.CallUnary(ctx, req)
+}
+
+// GetFolder calls test.v1.WrapperIdService.GetFolder.
+func (c *wrapperIdServiceClient) GetFolder(ctx context.Context, req *connect.Request[v1.NestedWrapperRequest]) (*connect.Response[v1.Response], error) {
+	return c.getFolder.CallUnary(ctx, req)
+}
+
+// GetProject calls test.v1.WrapperIdService.GetProject.
+func (c *wrapperIdServiceClient) GetProject(ctx context.Context, req *connect.Request[v1.ScalarIntResource]) (*connect.Response[v1.Response], error) {
+	return c.getProject.CallUnary(ctx, req)
+}
+
+// WrapperIdServiceHandler is an implementation of the test.v1.WrapperIdService service.
+type WrapperIdServiceHandler interface {
+	GetDocument(context.Context, *connect.Request[v1.StringWrapperResource]) (*connect.Response[v1.Response], error)
+	GetAccount(context.Context, *connect.Request[v1.IntWrapperResource]) (*connect.Response[v1.Response], error)
+	GetFolder(context.Context, *connect.Request[v1.NestedWrapperRequest]) (*connect.Response[v1.Response], error)
+	GetProject(context.Context, *connect.Request[v1.ScalarIntResource]) (*connect.Response[v1.Response], error)
+}
+
+// NewWrapperIdServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWrapperIdServiceHandler(svc WrapperIdServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	wrapperIdServiceMethods := v1.File_test_v1_wrapper_ids_proto.Services().ByName("WrapperIdService").Methods()
+	wrapperIdServiceGetDocumentHandler := connect.NewUnaryHandler(
+		WrapperIdServiceGetDocumentProcedure,
+		svc.GetDocument,
+		connect.WithSchema(wrapperIdServiceMethods.ByName("GetDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wrapperIdServiceGetAccountHandler := connect.NewUnaryHandler(
+		WrapperIdServiceGetAccountProcedure,
+		svc.GetAccount,
+		connect.WithSchema(wrapperIdServiceMethods.ByName("GetAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wrapperIdServiceGetFolderHandler := connect.NewUnaryHandler(
+		WrapperIdServiceGetFolderProcedure,
+		svc.GetFolder,
+		connect.WithSchema(wrapperIdServiceMethods.ByName("GetFolder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wrapperIdServiceGetProjectHandler := connect.NewUnaryHandler(
+		WrapperIdServiceGetProjectProcedure,
+		svc.GetProject,
+		connect.WithSchema(wrapperIdServiceMethods.ByName("GetProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/test.v1.WrapperIdService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WrapperIdServiceGetDocumentProcedure:
+			wrapperIdServiceGetDocumentHandler.ServeHTTP(w, r)
+		case WrapperIdServiceGetAccountProcedure:
+			wrapperIdServiceGetAccountHandler.ServeHTTP(w, r)
+		case WrapperIdServiceGetFolderProcedure:
+			wrapperIdServiceGetFolderHandler.ServeHTTP(w, r)
+		case WrapperIdServiceGetProjectProcedure:
+			wrapperIdServiceGetProjectHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWrapperIdServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWrapperIdServiceHandler struct{}
+
+func (UnimplementedWrapperIdServiceHandler) GetDocument(context.Context, *connect.Request[v1.StringWrapperResource]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.WrapperIdService.GetDocument is not implemented"))
+}
+
+func (UnimplementedWrapperIdServiceHandler) GetAccount(context.Context, *connect.Request[v1.IntWrapperResource]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.WrapperIdService.GetAccount is not implemented"))
+}
+
+func (UnimplementedWrapperIdServiceHandler) GetFolder(context.Context, *connect.Request[v1.NestedWrapperRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.WrapperIdService.GetFolder is not implemented"))
+}
+
+func (UnimplementedWrapperIdServiceHandler) GetProject(context.Context, *connect.Request[v1.ScalarIntResource]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("test.v1.WrapperIdService.GetProject is not implemented"))
+}
@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/well_known_attributes.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	_ "github.com/nrf110/protoc-gen-connectrpc-permify/gen/nrf110/permify/plugin/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_test_v1_well_known_attributes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reminder) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Id            string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp          `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp          `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	GracePeriod   *durationpb.Duration            `protobuf:"bytes,4,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	BillingCycle  *durationpb.Duration            `protobuf:"bytes,5,opt,name=billing_cycle,json=billingCycle,proto3" json:"billing_cycle,omitempty"`
+	AutoRenew     *wrapperspb.BoolValue           `protobuf:"bytes,6,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	Plan          *wrapperspb.StringValue         `protobuf:"bytes,7,opt,name=plan,proto3" json:"plan,omitempty"`
+	Seats         *wrapperspb.Int64Value          `protobuf:"bytes,8,opt,name=seats,proto3" json:"seats,omitempty"`
+	Metadata      *structpb.Struct                `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Priority      *structpb.Value                 `protobuf:"bytes,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	Reminders     []*Reminder                     `protobuf:"bytes,11,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	Limits        map[string]*durationpb.Duration `protobuf:"bytes,12,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_test_v1_well_known_attributes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *Subscription) GetBillingCycle() *durationpb.Duration {
+	if x != nil {
+		return x.BillingCycle
+	}
+	return nil
+}
+
+func (x *Subscription) GetAutoRenew() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return nil
+}
+
+func (x *Subscription) GetPlan() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *Subscription) GetSeats() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *Subscription) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Subscription) GetPriority() *structpb.Value {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *Subscription) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *Subscription) GetLimits() map[string]*durationpb.Duration {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type UpdateSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionRequest) Reset() {
+	*x = UpdateSubscriptionRequest{}
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_well_known_attributes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateSubscriptionRequest) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *UpdateSubscriptionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type PatchSubscriptionsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask   `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Masks         []*fieldmaskpb.FieldMask `protobuf:"bytes,3,rep,name=masks,proto3" json:"masks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchSubscriptionsRequest) Reset() {
+	*x = PatchSubscriptionsRequest{}
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSubscriptionsRequest) ProtoMessage() {}
+
+func (x *PatchSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_well_known_attributes_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*PatchSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_well_known_attributes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PatchSubscriptionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchSubscriptionsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PatchSubscriptionsRequest) GetMasks() []*fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Masks
+	}
+	return nil
+}
+
+var File_test_v1_well_known_attributes_proto protoreflect.FileDescriptor
+
+const file_test_v1_well_known_attributes_proto_rawDesc = "" +
+	"\n" +
+	"#test/v1/well_known_attributes.proto\x12\atest.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a&nrf110/permify/plugin/v1/options.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"L\n" +
+	"\bReminder\x12@\n" +
+	"\x06due_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\rһ\x01\tremindersR\x05dueAt\"\xa9\a\n" +
+	"\fSubscription\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12I\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x0eһ\x01\n" +
+	"created_atR\tcreatedAt\x12M\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x12һ\x01\n" +
+	"expires_at\xf0\xc1\x01\x02R\texpiresAt\x12N\n" +
+	"\fgrace_period\x18\x04 \x01(\v2\x19.google.protobuf.DurationB\x10һ\x01\fgrace_periodR\vgracePeriod\x12U\n" +
+	"\rbilling_cycle\x18\x05 \x01(\v2\x19.google.protobuf.DurationB\x15һ\x01\rbilling_cycle\xf0\xc1\x01\x02R\fbillingCycle\x12I\n" +
+	"\n" +
+	"auto_renew\x18\x06 \x01(\v2\x1a.google.protobuf.BoolValueB\x0eһ\x01\n" +
+	"auto_renewR\tautoRenew\x12:\n" +
+	"\x04plan\x18\a \x01(\v2\x1c.google.protobuf.StringValueB\bһ\x01\x04planR\x04plan\x12@\n" +
+	"\x05seats\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueB\rһ\x01\x05seats\xf0\xc1\x01\x04R\x05seats\x12A\n" +
+	"\bmetadata\x18\t \x01(\v2\x17.google.protobuf.StructB\fһ\x01\bmetadataR\bmetadata\x12D\n" +
+	"\bpriority\x18\n" +
+	" \x01(\v2\x16.google.protobuf.ValueB\x10һ\x01\bpriority\xf0\xc1\x01\x04R\bpriority\x12/\n" +
+	"\treminders\x18\v \x03(\v2\x11.test.v1.ReminderR\treminders\x12W\n" +
+	"\x06limits\x18\f \x03(\v2!.test.v1.Subscription.LimitsEntryB\x1cһ\x01\x06limits\xfa\xc1\x01\x0elimitAttributeR\x06limits\x1aT\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05value:\x028\x01:\x10»\x01\fSubscription\"\x93\x01\n" +
+	"\x19UpdateSubscriptionRequest\x129\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x15.test.v1.SubscriptionR\fsubscription\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xce\x01\n" +
+	"\x19PatchSubscriptionsRequest\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\xc0\xbb\x01\x01R\x02id\x12L\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x0fһ\x01\vupdate_maskR\n" +
+	"updateMask\x12;\n" +
+	"\x05masks\x18\x03 \x03(\v2\x1a.google.protobuf.FieldMaskB\tһ\x01\x05masksR\x05masks:\x10»\x01\fSubscription2\xbd\x01\n" +
+	"\rSubscriptions\x12U\n" +
+	"\x12UpdateSubscription\x12\".test.v1.UpdateSubscriptionRequest\x1a\x11.test.v1.Response\"\b»\x01\x04edit\x12U\n" +
+	"\x12PatchSubscriptions\x12\".test.v1.PatchSubscriptionsRequest\x1a\x11.test.v1.Response\"\b»\x01\x04editB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_well_known_attributes_proto_rawDescOnce sync.Once
+	file_test_v1_well_known_attributes_proto_rawDescData []byte
+)
+
+func file_test_v1_well_known_attributes_proto_rawDescGZIP() []byte {
+	file_test_v1_well_known_attributes_proto_rawDescOnce.Do(func() {
+		file_test_v1_well_known_attributes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_well_known_attributes_proto_rawDesc), len(file_test_v1_well_known_attributes_proto_rawDesc)))
+	})
+	return file_test_v1_well_known_attributes_proto_rawDescData
+}
+
+var file_test_v1_well_known_attributes_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_v1_well_known_attributes_proto_goTypes = []any{
+	(*Reminder)(nil),                  // 0: test.v1.Reminder
+	(*Subscription)(nil),              // 1: test.v1.Subscription
+	(*UpdateSubscriptionRequest)(nil), // 2: test.v1.UpdateSubscriptionRequest
+	(*PatchSubscriptionsRequest)(nil), // 3: test.v1.PatchSubscriptionsRequest
+	nil,                               // 4: test.v1.Subscription.LimitsEntry
+	(*timestamppb.Timestamp)(nil),     // 5: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 6: google.protobuf.Duration
+	(*wrapperspb.BoolValue)(nil),      // 7: google.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil),    // 8: google.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),     // 9: google.protobuf.Int64Value
+	(*structpb.Struct)(nil),           // 10: google.protobuf.Struct
+	(*structpb.Value)(nil),            // 11: google.protobuf.Value
+	(*fieldmaskpb.FieldMask)(nil),     // 12: google.protobuf.FieldMask
+	(*Response)(nil),                  // 13: test.v1.Response
+}
+var file_test_v1_well_known_attributes_proto_depIdxs = []int32{
+	5,  // 0: test.v1.Reminder.due_at:type_name -> google.protobuf.Timestamp
+	5,  // 1: test.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 2: test.v1.Subscription.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: test.v1.Subscription.grace_period:type_name -> google.protobuf.Duration
+	6,  // 4: test.v1.Subscription.billing_cycle:type_name -> google.protobuf.Duration
+	7,  // 5: test.v1.Subscription.auto_renew:type_name -> google.protobuf.BoolValue
+	8,  // 6: test.v1.Subscription.plan:type_name -> google.protobuf.StringValue
+	9,  // 7: test.v1.Subscription.seats:type_name -> google.protobuf.Int64Value
+	10, // 8: test.v1.Subscription.metadata:type_name -> google.protobuf.Struct
+	11, // 9: test.v1.Subscription.priority:type_name -> google.protobuf.Value
+	0,  // 10: test.v1.Subscription.reminders:type_name -> test.v1.Reminder
+	4,  // 11: test.v1.Subscription.limits:type_name -> test.v1.Subscription.LimitsEntry
+	1,  // 12: test.v1.UpdateSubscriptionRequest.subscription:type_name -> test.v1.Subscription
+	12, // 13: test.v1.UpdateSubscriptionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	12, // 14: test.v1.PatchSubscriptionsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	12, // 15: test.v1.PatchSubscriptionsRequest.masks:type_name -> google.protobuf.FieldMask
+	6,  // 16: test.v1.Subscription.LimitsEntry.value:type_name -> google.protobuf.Duration
+	2,  // 17: test.v1.Subscriptions.UpdateSubscription:input_type -> test.v1.UpdateSubscriptionRequest
+	3,  // 18: test.v1.Subscriptions.PatchSubscriptions:input_type -> test.v1.PatchSubscriptionsRequest
+	13, // 19: test.v1.Subscriptions.UpdateSubscription:output_type -> test.v1.Response
+	13, // 20: test.v1.Subscriptions.PatchSubscriptions:output_type -> test.v1.Response
+	19, // [19:21] is the sub-list for method output_type
+	17, // [17:19] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_well_known_attributes_proto_init() }
+func file_test_v1_well_known_attributes_proto_init() {
+	if File_test_v1_well_known_attributes_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_well_known_attributes_proto_rawDesc), len(file_test_v1_well_known_attributes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_well_known_attributes_proto_goTypes,
+		DependencyIndexes: file_test_v1_well_known_attributes_proto_depIdxs,
+		MessageInfos:      file_test_v1_well_known_attributes_proto_msgTypes,
+	}.Build()
+	File_test_v1_well_known_attributes_proto = out.File
+	file_test_v1_well_known_attributes_proto_goTypes = nil
+	file_test_v1_well_known_attributes_proto_depIdxs = nil
+}
@@ -0,0 +1,115 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	maps "maps"
+	slices "slices"
+	time "time"
+)
+
+func (req *UpdateSubscriptionRequest) GetChecks() pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Subscription
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	attributes := make(map[string]any, 11)
+	if resource.AutoRenew != nil {
+		attributes["auto_renew"] = resource.AutoRenew.GetValue()
+	}
+	if resource.BillingCycle != nil {
+		attributes["billing_cycle"] = resource.BillingCycle.AsDuration().String()
+	}
+	if resource.CreatedAt != nil {
+		attributes["created_at"] = float64(resource.CreatedAt.GetSeconds())
+	}
+	if resource.ExpiresAt != nil {
+		attributes["expires_at"] = resource.ExpiresAt.AsTime().Format(time.RFC3339)
+	}
+	if resource.GracePeriod != nil {
+		attributes["grace_period"] = resource.GracePeriod.AsDuration().Seconds()
+	}
+	limitsValues := make([]any, 0, len(resource.Limits))
+	for _, v1 := range slices.Sorted(maps.Keys(resource.Limits)) {
+		v2 := resource.Limits[v1]
+		limitsValues = append(limitsValues, limitAttribute(v1, v2))
+	}
+	if len(limitsValues) > 0 {
+		attributes["limits"] = limitsValues
+	}
+	if resource.Metadata != nil {
+		attributes["metadata"] = protojson.Format(resource.Metadata)
+	}
+	if resource.Plan != nil {
+		attributes["plan"] = resource.Plan.GetValue()
+	}
+	if resource.Priority != nil {
+		attributes["priority"] = resource.Priority.GetNumberValue()
+	}
+	remindersValues := make([]float64, 0, len(resource.Reminders))
+	for _, v3 := range resource.Reminders {
+		if v3.DueAt != nil {
+			remindersValues = append(remindersValues, float64(v3.DueAt.GetSeconds()))
+		}
+	}
+	if len(remindersValues) > 0 {
+		attributes["reminders"] = remindersValues
+	}
+	if resource.Seats != nil {
+		attributes["seats"] = float64(resource.Seats.GetValue())
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type:       "Subscription",
+			ID:         id,
+			Attributes: attributes,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *PatchSubscriptionsRequest) GetChecks() pkg.CheckConfig {
+	permission := "edit"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req
+	var id string
+	if resource.Id != "" {
+		id = resource.Id
+	}
+	tenantId := "default"
+	attributes := make(map[string]any, 2)
+	masksValues := make([]string, 0, len(resource.Masks))
+	for _, v4 := range resource.Masks {
+		masksValues = append(masksValues, v4.GetPaths()...)
+	}
+	if len(masksValues) > 0 {
+		attributes["masks"] = masksValues
+	}
+	if resource.UpdateMask != nil {
+		attributes["update_mask"] = resource.UpdateMask.GetPaths()
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type:       "Subscription",
+			ID:         id,
+			Attributes: attributes,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
@@ -0,0 +1,24 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkSubscriptionsUpdateSubscriptionGetChecks(b *testing.B) {
+	req := &UpdateSubscriptionRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkSubscriptionsPatchSubscriptionsGetChecks(b *testing.B) {
+	req := &PatchSubscriptionsRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
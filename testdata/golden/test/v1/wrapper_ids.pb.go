@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: test/v1/wrapper_ids.proto
+
+package testv1
+
+import (
+	_ "github.com/nrf110/connectrpc-permify/gen/nrf110/permify/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StringWrapperResource struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringWrapperResource) Reset() {
+	*x = StringWrapperResource{}
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringWrapperResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringWrapperResource) ProtoMessage() {}
+
+func (x *StringWrapperResource) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringWrapperResource.ProtoReflect.Descriptor instead.
+func (*StringWrapperResource) Descriptor() ([]byte, []int) {
+	return file_test_v1_wrapper_ids_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StringWrapperResource) GetId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *StringWrapperResource) GetTenantId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type IntWrapperResource struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            *wrapperspb.Int64Value  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntWrapperResource) Reset() {
+	*x = IntWrapperResource{}
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntWrapperResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntWrapperResource) ProtoMessage() {}
+
+func (x *IntWrapperResource) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntWrapperResource.ProtoReflect.Descriptor instead.
+func (*IntWrapperResource) Descriptor() ([]byte, []int) {
+	return file_test_v1_wrapper_ids_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IntWrapperResource) GetId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *IntWrapperResource) GetTenantId() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type WrapperIds struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            *wrapperspb.Int32Value  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WrapperIds) Reset() {
+	*x = WrapperIds{}
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WrapperIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WrapperIds) ProtoMessage() {}
+
+func (x *WrapperIds) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WrapperIds.ProtoReflect.Descriptor instead.
+func (*WrapperIds) Descriptor() ([]byte, []int) {
+	return file_test_v1_wrapper_ids_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WrapperIds) GetId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *WrapperIds) GetTenantId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type NestedWrapperResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           *WrapperIds            `protobuf:"bytes,1,opt,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedWrapperResource) Reset() {
+	*x = NestedWrapperResource{}
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedWrapperResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedWrapperResource) ProtoMessage() {}
+
+func (x *NestedWrapperResource) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedWrapperResource.ProtoReflect.Descriptor instead.
+func (*NestedWrapperResource) Descriptor() ([]byte, []int) {
+	return file_test_v1_wrapper_ids_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NestedWrapperResource) GetIds() *WrapperIds {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ScalarIntResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScalarIntResource) Reset() {
+	*x = ScalarIntResource{}
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalarIntResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalarIntResource) ProtoMessage() {}
+
+func (x *ScalarIntResource) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalarIntResource.ProtoReflect.Descriptor instead.
+func (*ScalarIntResource) Descriptor() ([]byte, []int) {
+	return file_test_v1_wrapper_ids_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScalarIntResource) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScalarIntResource) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type NestedWrapperRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *NestedWrapperResource `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedWrapperRequest) Reset() {
+	*x = NestedWrapperRequest{}
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedWrapperRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedWrapperRequest) ProtoMessage() {}
+
+func (x *NestedWrapperRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_v1_wrapper_ids_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedWrapperRequest.ProtoReflect.Descriptor instead.
+func (*NestedWrapperRequest) Descriptor() ([]byte, []int) {
+	return file_test_v1_wrapper_ids_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NestedWrapperRequest) GetFolder() *NestedWrapperResource {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+var File_test_v1_wrapper_ids_proto protoreflect.FileDescriptor
+
+const file_test_v1_wrapper_ids_proto_rawDesc = "" +
+	"\n" +
+	"\x19test/v1/wrapper_ids.proto\x12\atest.v1\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1fnrf110/permify/v1/permify.proto\x1a\x14test/v1/common.proto\"\x9a\x01\n" +
+	"\x15StringWrapperResource\x122\n" +
+	"\x02id\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueB\x04\xc0\xbb\x01\x01R\x02id\x12?\n" +
+	"\ttenant_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueB\x04Ȼ\x01\x01R\btenantId:\f»\x01\bDocument\"\x95\x01\n" +
+	"\x12IntWrapperResource\x121\n" +
+	"\x02id\x18\x01 \x01(\v2\x1b.google.protobuf.Int64ValueB\x04\xc0\xbb\x01\x01R\x02id\x12?\n" +
+	"\ttenant_id\x18\x02 \x01(\v2\x1c.google.protobuf.UInt32ValueB\x04Ȼ\x01\x01R\btenantId:\v»\x01\aAccount\"\x80\x01\n" +
+	"\n" +
+	"WrapperIds\x121\n" +
+	"\x02id\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueB\x04\xc0\xbb\x01\x01R\x02id\x12?\n" +
+	"\ttenant_id\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueB\x04Ȼ\x01\x01R\btenantId\"J\n" +
+	"\x15NestedWrapperResource\x12%\n" +
+	"\x03ids\x18\x01 \x01(\v2\x13.test.v1.WrapperIdsR\x03ids:\n" +
+	"»\x01\x06Folder\"Y\n" +
+	"\x11ScalarIntResource\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\x03B\x04\xc0\xbb\x01\x01R\x02id\x12!\n" +
+	"\ttenant_id\x18\x02 \x01(\rB\x04Ȼ\x01\x01R\btenantId:\v»\x01\aProject\"N\n" +
+	"\x14NestedWrapperRequest\x126\n" +
+	"\x06folder\x18\x01 \x01(\v2\x1e.test.v1.NestedWrapperResourceR\x06folder2\xb6\x02\n" +
+	"\x10WrapperIdService\x12J\n" +
+	"\vGetDocument\x12\x1e.test.v1.StringWrapperResource\x1a\x11.test.v1.Response\"\b»\x01\x04read\x12F\n" +
+	"\n" +
+	"GetAccount\x12\x1b.test.v1.IntWrapperResource\x1a\x11.test.v1.Response\"\b»\x01\x04read\x12G\n" +
+	"\tGetFolder\x12\x1d.test.v1.NestedWrapperRequest\x1a\x11.test.v1.Response\"\b»\x01\x04read\x12E\n" +
+	"\n" +
+	"GetProject\x12\x1a.test.v1.ScalarIntResource\x1a\x11.test.v1.Response\"\b»\x01\x04readB\x10Z\x0etest/v1;testv1b\x06proto3"
+
+var (
+	file_test_v1_wrapper_ids_proto_rawDescOnce sync.Once
+	file_test_v1_wrapper_ids_proto_rawDescData []byte
+)
+
+func file_test_v1_wrapper_ids_proto_rawDescGZIP() []byte {
+	file_test_v1_wrapper_ids_proto_rawDescOnce.Do(func() {
+		file_test_v1_wrapper_ids_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_v1_wrapper_ids_proto_rawDesc), len(file_test_v1_wrapper_ids_proto_rawDesc)))
+	})
+	return file_test_v1_wrapper_ids_proto_rawDescData
+}
+
+var file_test_v1_wrapper_ids_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_test_v1_wrapper_ids_proto_goTypes = []any{
+	(*StringWrapperResource)(nil),  // 0: test.v1.StringWrapperResource
+	(*IntWrapperResource)(nil),     // 1: test.v1.IntWrapperResource
+	(*WrapperIds)(nil),             // 2: test.v1.WrapperIds
+	(*NestedWrapperResource)(nil),  // 3: test.v1.NestedWrapperResource
+	(*ScalarIntResource)(nil),      // 4: test.v1.ScalarIntResource
+	(*NestedWrapperRequest)(nil),   // 5: test.v1.NestedWrapperRequest
+	(*wrapperspb.StringValue)(nil), // 6: google.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),  // 7: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil), // 8: google.protobuf.UInt32Value
+	(*wrapperspb.Int32Value)(nil),  // 9: google.protobuf.Int32Value
+	(*wrapperspb.UInt64Value)(nil), // 10: google.protobuf.UInt64Value
+	(*Response)(nil),               // 11: test.v1.Response
+}
+var file_test_v1_wrapper_ids_proto_depIdxs = []int32{
+	6,  // 0: test.v1.StringWrapperResource.id:type_name -> google.protobuf.StringValue
+	6,  // 1: test.v1.StringWrapperResource.tenant_id:type_name -> google.protobuf.StringValue
+	7,  // 2: test.v1.IntWrapperResource.id:type_name -> google.protobuf.Int64Value
+	8,  // 3: test.v1.IntWrapperResource.tenant_id:type_name -> google.protobuf.UInt32Value
+	9,  // 4: test.v1.WrapperIds.id:type_name -> google.protobuf.Int32Value
+	10, // 5: test.v1.WrapperIds.tenant_id:type_name -> google.protobuf.UInt64Value
+	2,  // 6: test.v1.NestedWrapperResource.ids:type_name -> test.v1.WrapperIds
+	3,  // 7: test.v1.NestedWrapperRequest.folder:type_name -> test.v1.NestedWrapperResource
+	0,  // 8: test.v1.WrapperIdService.GetDocument:input_type -> test.v1.StringWrapperResource
+	1,  // 9: test.v1.WrapperIdService.GetAccount:input_type -> test.v1.IntWrapperResource
+	5,  // 10: test.v1.WrapperIdService.GetFolder:input_type -> test.v1.NestedWrapperRequest
+	4,  // 11: test.v1.WrapperIdService.GetProject:input_type -> test.v1.ScalarIntResource
+	11, // 12: test.v1.WrapperIdService.GetDocument:output_type -> test.v1.Response
+	11, // 13: test.v1.WrapperIdService.GetAccount:output_type -> test.v1.Response
+	11, // 14: test.v1.WrapperIdService.GetFolder:output_type -> test.v1.Response
+	11, // 15: test.v1.WrapperIdService.GetProject:output_type -> test.v1.Response
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_test_v1_wrapper_ids_proto_init() }
+func file_test_v1_wrapper_ids_proto_init() {
+	if File_test_v1_wrapper_ids_proto != nil {
+		return
+	}
+	file_test_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_v1_wrapper_ids_proto_rawDesc), len(file_test_v1_wrapper_ids_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_test_v1_wrapper_ids_proto_goTypes,
+		DependencyIndexes: file_test_v1_wrapper_ids_proto_depIdxs,
+		MessageInfos:      file_test_v1_wrapper_ids_proto_msgTypes,
+	}.Build()
+	File_test_v1_wrapper_ids_proto = out.File
+	file_test_v1_wrapper_ids_proto_goTypes = nil
+	file_test_v1_wrapper_ids_proto_depIdxs = nil
+}
@@ -0,0 +1,114 @@
+package testv1
+
+import (
+	pkg "github.com/nrf110/connectrpc-permify/pkg"
+	strconv "strconv"
+)
+
+func (req *StringWrapperResource) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req
+	var id string
+	if resource.Id != nil {
+		id = resource.Id.Value
+	}
+	tenantId := "default"
+	if resource.TenantId != nil {
+		tenantId = resource.TenantId.Value
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Document",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *IntWrapperResource) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req
+	var id string
+	if resource.Id != nil {
+		id = strconv.FormatInt(resource.Id.Value, 10)
+	}
+	tenantId := "default"
+	if resource.TenantId != nil {
+		tenantId = strconv.FormatUint(uint64(resource.TenantId.Value), 10)
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Account",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *NestedWrapperRequest) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req.Folder
+	var id string
+	if resource != nil && resource.Ids != nil && resource.Ids.Id != nil {
+		id = strconv.FormatInt(int64(resource.Ids.Id.Value), 10)
+	}
+	tenantId := "default"
+	if resource != nil && resource.Ids != nil && resource.Ids.TenantId != nil {
+		tenantId = strconv.FormatUint(resource.Ids.TenantId.Value, 10)
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Folder",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
+
+func (req *ScalarIntResource) GetChecks() pkg.CheckConfig {
+	permission := "read"
+	checks := make([]pkg.Check, 0, 1)
+	resource := req
+	var id string
+	if resource.Id != 0 {
+		id = strconv.FormatInt(resource.Id, 10)
+	}
+	tenantId := "default"
+	if resource.TenantId != 0 {
+		tenantId = strconv.FormatUint(uint64(resource.TenantId), 10)
+	}
+	check := pkg.Check{
+		TenantID:   tenantId,
+		Permission: permission,
+		Entity: &pkg.Resource{
+			Type: "Project",
+			ID:   id,
+		},
+	}
+	checks = append(checks, check)
+	return pkg.CheckConfig{
+		IsPublic: false,
+		Checks:   checks,
+	}
+}
@@ -0,0 +1,42 @@
+package testv1
+
+import (
+	permifytest "github.com/nrf110/protoc-gen-connectrpc-permify/permifytest"
+	testing "testing"
+)
+
+func BenchmarkWrapperIdServiceGetDocumentGetChecks(b *testing.B) {
+	req := &StringWrapperResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkWrapperIdServiceGetAccountGetChecks(b *testing.B) {
+	req := &IntWrapperResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkWrapperIdServiceGetFolderGetChecks(b *testing.B) {
+	req := &NestedWrapperRequest{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}
+
+func BenchmarkWrapperIdServiceGetProjectGetChecks(b *testing.B) {
+	req := &ScalarIntResource{}
+	permifytest.Populate(req)
+	b.ReportAllocs()
+	for b.Loop() {
+		req.GetChecks()
+	}
+}